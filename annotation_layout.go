@@ -0,0 +1,116 @@
+package chart
+
+import "sort"
+
+// annotationPlacement is an annotation positioned on the canvas.
+//
+// `AnchorX` and `AnchorY` are the data point the annotation labels, `X` and `Y` are where the tip
+// of the annotation is drawn; if they differ the annotation is drawn with a leader line back to the anchor.
+type annotationPlacement struct {
+	Label string
+	Style Style
+
+	AnchorX, AnchorY int
+	X, Y             int
+
+	// Fixed placements are drawn at their anchor and are not moved by the layout.
+	Fixed bool
+}
+
+// IsMoved returns if the annotation has been moved away from its anchor.
+func (ap annotationPlacement) IsMoved() bool {
+	return ap.X != ap.AnchorX || ap.Y != ap.AnchorY
+}
+
+// Measure returns the bounds of the annotation, including any leader line.
+func (ap annotationPlacement) Measure(r Renderer, canvasBox Box) Box {
+	box := Draw.MeasureAnnotation(r, canvasBox, ap.Style, ap.X, ap.Y, ap.Label)
+	if ap.IsMoved() {
+		box.Left = MinInt(box.Left, ap.AnchorX)
+	}
+	return box
+}
+
+// Render draws the annotation.
+func (ap annotationPlacement) Render(r Renderer, canvasBox Box) {
+	if ap.IsMoved() {
+		Draw.AnnotationLeader(r, ap.Style, ap.AnchorX, ap.AnchorY, ap.X, ap.Y)
+	}
+	Draw.Annotation(r, canvasBox, ap.Style, ap.X, ap.Y, ap.Label)
+}
+
+// layoutAnnotations moves overlapping annotations vertically until they no longer overlap,
+// keeping moved annotations within the canvas.
+//
+// Annotations are placed top to bottom in order of their anchors; an annotation that overlaps
+// one placed before it is pushed down below it. If that pushes annotations past the bottom of the canvas
+// they are pushed back up, bottom to top. Moved annotations are offset to the right of their anchor by
+// `DefaultAnnotationLeaderWidth` to leave room for the leader line.
+func layoutAnnotations(r Renderer, canvasBox Box, placements []annotationPlacement) {
+	var order []int
+	for index := range placements {
+		if !placements[index].Fixed {
+			order = append(order, index)
+		}
+	}
+	if len(order) < 2 {
+		return
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := placements[order[i]], placements[order[j]]
+		if a.AnchorY == b.AnchorY {
+			return a.AnchorX < b.AnchorX
+		}
+		return a.AnchorY < b.AnchorY
+	})
+
+	// measure each annotation as if it were moved, so the horizontal overlap test
+	// doesn't change depending on which annotations end up being moved.
+	boxes := make([]Box, len(placements))
+	original := make([]Box, len(placements))
+	for _, index := range order {
+		p := placements[index]
+		original[index] = Draw.MeasureAnnotation(r, canvasBox, p.Style, p.AnchorX, p.AnchorY, p.Label)
+		boxes[index] = original[index]
+		boxes[index].Right += DefaultAnnotationLeaderWidth
+	}
+
+	overlapsHorizontally := func(a, b Box) bool {
+		return a.Left < b.Right && b.Left < a.Right
+	}
+
+	for i, index := range order {
+		top := boxes[index].Top
+		for _, placed := range order[:i] {
+			if overlapsHorizontally(boxes[index], boxes[placed]) {
+				top = MaxInt(top, boxes[placed].Bottom+DefaultAnnotationSpacing)
+			}
+		}
+		boxes[index] = boxes[index].Shift(0, top-boxes[index].Top)
+	}
+
+	for i := len(order) - 1; i >= 0; i-- {
+		index := order[i]
+		bottom := boxes[index].Bottom
+		if boxes[index].Top != original[index].Top {
+			bottom = MinInt(bottom, canvasBox.Bottom)
+		}
+		for _, placed := range order[i+1:] {
+			if overlapsHorizontally(boxes[index], boxes[placed]) {
+				bottom = MinInt(bottom, boxes[placed].Top-DefaultAnnotationSpacing)
+			}
+		}
+		boxes[index] = boxes[index].Shift(0, bottom-boxes[index].Bottom)
+		if boxes[index].Top < canvasBox.Top && original[index].Top >= canvasBox.Top {
+			boxes[index] = boxes[index].Shift(0, canvasBox.Top-boxes[index].Top)
+		}
+	}
+
+	for _, index := range order {
+		dy := boxes[index].Top - original[index].Top
+		if dy != 0 {
+			placements[index].X = placements[index].AnchorX + DefaultAnnotationLeaderWidth
+			placements[index].Y = placements[index].AnchorY + dy
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func testAnnotationPlacements(t *testing.T, anchors ...[2]int) (Renderer, []annotationPlacement) {
+	r, err := PNG(200, 200)
+	testutil.AssertNil(t, err)
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	var placements []annotationPlacement
+	for _, anchor := range anchors {
+		placements = append(placements, annotationPlacement{
+			Label:   "10.00",
+			Style:   Style{Font: f, FontSize: 10.0, Padding: DefaultAnnotationPadding},
+			AnchorX: anchor[0],
+			AnchorY: anchor[1],
+			X:       anchor[0],
+			Y:       anchor[1],
+		})
+	}
+	return r, placements
+}
+
+func assertAnnotationsDontOverlap(t *testing.T, r Renderer, canvasBox Box, placements []annotationPlacement) {
+	for i := range placements {
+		for j := i + 1; j < len(placements); j++ {
+			a := Draw.MeasureAnnotation(r, canvasBox, placements[i].Style, placements[i].X, placements[i].Y, placements[i].Label)
+			b := Draw.MeasureAnnotation(r, canvasBox, placements[j].Style, placements[j].X, placements[j].Y, placements[j].Label)
+			testutil.AssertTrue(t, a.Bottom <= b.Top || b.Bottom <= a.Top)
+		}
+	}
+}
+
+func TestLayoutAnnotations(t *testing.T) {
+	canvasBox := Box{Top: 0, Left: 0, Right: 150, Bottom: 200}
+	r, placements := testAnnotationPlacements(t, [2]int{150, 100}, [2]int{150, 102}, [2]int{150, 98})
+
+	layoutAnnotations(r, canvasBox, placements)
+	assertAnnotationsDontOverlap(t, r, canvasBox, placements)
+
+	// the top most annotation stays put, the others are moved down with leader lines.
+	testutil.AssertFalse(t, placements[2].IsMoved())
+	testutil.AssertTrue(t, placements[0].IsMoved())
+	testutil.AssertTrue(t, placements[1].IsMoved())
+	testutil.AssertEqual(t, 150+DefaultAnnotationLeaderWidth, placements[0].X)
+	testutil.AssertTrue(t, placements[0].Y < placements[1].Y)
+}
+
+func TestLayoutAnnotationsNoOverlap(t *testing.T) {
+	canvasBox := Box{Top: 0, Left: 0, Right: 150, Bottom: 200}
+	r, placements := testAnnotationPlacements(t, [2]int{150, 20}, [2]int{150, 100}, [2]int{10, 101})
+
+	layoutAnnotations(r, canvasBox, placements)
+	for _, p := range placements {
+		testutil.AssertFalse(t, p.IsMoved())
+	}
+}
+
+func TestLayoutAnnotationsWithinCanvas(t *testing.T) {
+	canvasBox := Box{Top: 0, Left: 0, Right: 150, Bottom: 200}
+	r, placements := testAnnotationPlacements(t, [2]int{150, 195}, [2]int{150, 196}, [2]int{150, 197})
+
+	layoutAnnotations(r, canvasBox, placements)
+	assertAnnotationsDontOverlap(t, r, canvasBox, placements)
+	for _, p := range placements {
+		if p.IsMoved() {
+			testutil.AssertTrue(t, p.Measure(r, canvasBox).Bottom <= canvasBox.Bottom)
+		}
+	}
+}
+
+func TestLayoutAnnotationsFixed(t *testing.T) {
+	canvasBox := Box{Top: 0, Left: 0, Right: 150, Bottom: 200}
+	r, placements := testAnnotationPlacements(t, [2]int{150, 100}, [2]int{150, 101})
+	for index := range placements {
+		placements[index].Fixed = true
+	}
+
+	layoutAnnotations(r, canvasBox, placements)
+	for _, p := range placements {
+		testutil.AssertFalse(t, p.IsMoved())
+	}
+}
+
+func TestChartAnnotationPlacementsAcrossSeries(t *testing.T) {
+	a := ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{0, 10}}
+	b := ContinuousSeries{XValues: []float64{1, 2}, YValues: []float64{5, 10.1}}
+	c := Chart{
+		Series: []Series{a, b, LastValueAnnotationSeries(a), LastValueAnnotationSeries(b)},
+	}
+
+	r, err := PNG(c.GetWidth(), c.GetHeight())
+	testutil.AssertNil(t, err)
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	c.Font = f
+
+	xr, yr, yra := c.getRanges()
+	canvasBox := c.getDefaultCanvasBox()
+	xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+
+	placements := c.getAnnotationPlacements(r, canvasBox, xr, yr, yra, nil)
+	testutil.AssertLen(t, placements, 2)
+	testutil.AssertLen(t, placements[2], 1)
+	testutil.AssertLen(t, placements[3], 1)
+	assertAnnotationsDontOverlap(t, r, canvasBox, []annotationPlacement{placements[2][0], placements[3][0]})
+}
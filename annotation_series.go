@@ -16,6 +16,10 @@ type AnnotationSeries struct {
 	Style       Style
 	YAxis       YAxisType
 	Annotations []Value2
+
+	// AllowOverlap draws the annotations at their data points even if they overlap other annotations,
+	// instead of moving them apart with leader lines back to the data points.
+	AllowOverlap bool
 }
 
 // GetName returns the name of the time series.
@@ -54,12 +58,10 @@ func (as AnnotationSeries) Measure(r Renderer, canvasBox Box, xrange, yrange Ran
 		Bottom: 0,
 	}
 	if !as.Style.Hidden {
-		seriesStyle := as.Style.InheritFrom(as.annotationStyleDefaults(defaults))
-		for _, a := range as.Annotations {
-			style := a.Style.InheritFrom(seriesStyle)
-			lx := canvasBox.Left + xrange.Translate(a.XValue)
-			ly := canvasBox.Bottom - yrange.Translate(a.YValue)
-			ab := Draw.MeasureAnnotation(r, canvasBox, style, lx, ly, a.Label)
+		placements := as.getPlacements(canvasBox, xrange, yrange, defaults)
+		layoutAnnotations(r, canvasBox, placements)
+		for _, p := range placements {
+			ab := p.Measure(r, canvasBox)
 			box.Top = MinInt(box.Top, ab.Top)
 			box.Left = MinInt(box.Left, ab.Left)
 			box.Right = MaxInt(box.Right, ab.Right)
@@ -72,13 +74,35 @@ func (as AnnotationSeries) Measure(r Renderer, canvasBox Box, xrange, yrange Ran
 // Render draws the series.
 func (as AnnotationSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
 	if !as.Style.Hidden {
-		seriesStyle := as.Style.InheritFrom(as.annotationStyleDefaults(defaults))
-		for _, a := range as.Annotations {
-			style := a.Style.InheritFrom(seriesStyle)
-			lx := canvasBox.Left + xrange.Translate(a.XValue)
-			ly := canvasBox.Bottom - yrange.Translate(a.YValue)
-			Draw.Annotation(r, canvasBox, style, lx, ly, a.Label)
-		}
+		placements := as.getPlacements(canvasBox, xrange, yrange, defaults)
+		layoutAnnotations(r, canvasBox, placements)
+		as.renderPlacements(r, canvasBox, placements)
+	}
+}
+
+// getPlacements returns the annotations positioned at their data points.
+func (as AnnotationSeries) getPlacements(canvasBox Box, xrange, yrange Range, defaults Style) []annotationPlacement {
+	seriesStyle := as.Style.InheritFrom(as.annotationStyleDefaults(defaults))
+	placements := make([]annotationPlacement, 0, len(as.Annotations))
+	for _, a := range as.Annotations {
+		lx := canvasBox.Left + xrange.Translate(a.XValue)
+		ly := canvasBox.Bottom - yrange.Translate(a.YValue)
+		placements = append(placements, annotationPlacement{
+			Label:   a.Label,
+			Style:   a.Style.InheritFrom(seriesStyle),
+			AnchorX: lx,
+			AnchorY: ly,
+			X:       lx,
+			Y:       ly,
+			Fixed:   as.AllowOverlap,
+		})
+	}
+	return placements
+}
+
+func (as AnnotationSeries) renderPlacements(r Renderer, canvasBox Box, placements []annotationPlacement) {
+	for _, p := range placements {
+		p.Render(r, canvasBox)
 	}
 }
 
@@ -0,0 +1,116 @@
+package chart
+
+import "math"
+
+const (
+	// DefaultATRPeriod is the default number of periods the true ranges of the ATR are averaged over.
+	DefaultATRPeriod = 14
+)
+
+// Interface Assertions.
+var (
+	_ Series              = (*ATRSeries)(nil)
+	_ FirstValuesProvider = (*ATRSeries)(nil)
+	_ LastValuesProvider  = (*ATRSeries)(nil)
+)
+
+// ATRSeries computes the average true range of a price series, the average of the largest of the range of each
+// period and the gaps from the previous close, which shows how volatile the price is.
+//
+// The close is the value of the inner series; the high and low are the values of their series, or the close if
+// they aren't set.
+type ATRSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Period      int
+	InnerSeries ValuesProvider
+	HighSeries  ValuesProvider
+	LowSeries   ValuesProvider
+
+	cache []float64
+}
+
+// GetName returns the name of the time series.
+func (atr ATRSeries) GetName() string {
+	return atr.Name
+}
+
+// GetStyle returns the line style.
+func (atr ATRSeries) GetStyle() Style {
+	return atr.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (atr ATRSeries) GetYAxis() YAxisType {
+	return atr.YAxis
+}
+
+// GetPeriod returns the window size.
+func (atr ATRSeries) GetPeriod() int {
+	if atr.Period == 0 {
+		return DefaultATRPeriod
+	}
+	return atr.Period
+}
+
+// Len returns the number of elements in the series.
+func (atr ATRSeries) Len() int {
+	return atr.InnerSeries.Len()
+}
+
+// GetValues gets a value at a given index.
+func (atr *ATRSeries) GetValues(index int) (x, y float64) {
+	if atr.InnerSeries == nil {
+		return
+	}
+	if len(atr.cache) == 0 {
+		atr.ensureCachedValues()
+	}
+	x, _ = atr.InnerSeries.GetValues(index)
+	y = atr.cache[index]
+	return
+}
+
+// GetFirstValues returns the first value of the series.
+func (atr *ATRSeries) GetFirstValues() (x, y float64) {
+	if atr.InnerSeries == nil || atr.InnerSeries.Len() == 0 {
+		return
+	}
+	return atr.GetValues(0)
+}
+
+// GetLastValues returns the last value of the series.
+func (atr *ATRSeries) GetLastValues() (x, y float64) {
+	if atr.InnerSeries == nil || atr.InnerSeries.Len() == 0 {
+		return
+	}
+	return atr.GetValues(atr.InnerSeries.Len() - 1)
+}
+
+func (atr *ATRSeries) ensureCachedValues() {
+	seriesLength := atr.InnerSeries.Len()
+	trueRanges := make([]float64, seriesLength)
+	var previousClose float64
+	for index := 0; index < seriesLength; index++ {
+		_, high, low, close := getHighLowClose(atr.InnerSeries, atr.HighSeries, atr.LowSeries, index)
+		trueRanges[index] = high - low
+		if index > 0 {
+			trueRanges[index] = math.Max(trueRanges[index], math.Max(math.Abs(high-previousClose), math.Abs(low-previousClose)))
+		}
+		previousClose = close
+	}
+	atr.cache = wilderAverage(trueRanges, atr.GetPeriod())
+}
+
+// Render renders the series.
+func (atr *ATRSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := atr.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, atr)
+}
+
+// Validate validates the series.
+func (atr *ATRSeries) Validate() error {
+	return validateIndicatorSeries("atr", atr.InnerSeries, atr.HighSeries, atr.LowSeries)
+}
@@ -0,0 +1,26 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestATRSeries(t *testing.T) {
+	atr := &ATRSeries{
+		InnerSeries: mockValuesProvider{X: []float64{1, 2, 3}, Y: []float64{10, 14, 13}},
+		HighSeries:  mockValuesProvider{X: []float64{1, 2, 3}, Y: []float64{11, 15, 14}},
+		LowSeries:   mockValuesProvider{X: []float64{1, 2, 3}, Y: []float64{9, 13, 12}},
+		Period:      2,
+	}
+
+	// the true ranges are 2, 5 for the gap up from the close of 10, and 2.
+	expected := []float64{2, 3.5, 2.75}
+	for index, value := range expected {
+		_, y := atr.GetValues(index)
+		testutil.AssertInDelta(t, value, y, 0.0000001)
+	}
+	x, y := atr.GetLastValues()
+	testutil.AssertEqual(t, 3.0, x)
+	testutil.AssertInDelta(t, 2.75, y, 0.0000001)
+}
@@ -12,7 +12,8 @@ const (
 	TickPositionUnderTick TickPosition = 2
 )
 
-// YAxisType is a type of y-axis; it can either be primary or secondary.
+// YAxisType is a type of y-axis; it can either be primary, secondary,
+// or one of the chart's additional y-axes (see `YAxisAdditional`).
 type YAxisType int
 
 const (
@@ -22,6 +23,33 @@ const (
 	YAxisSecondary YAxisType = 1
 )
 
+// YAxisAdditional returns the axis type for the additional y-axis at a given index in `Chart.YAxes`.
+func YAxisAdditional(index int) YAxisType {
+	return YAxisSecondary + 1 + YAxisType(index)
+}
+
+// AdditionalIndex returns the index into `Chart.YAxes` for the axis type,
+// and if the axis type is an additional y-axis at all.
+func (yat YAxisType) AdditionalIndex() (index int, ok bool) {
+	if yat > YAxisSecondary {
+		return int(yat - YAxisSecondary - 1), true
+	}
+	return 0, false
+}
+
+// YAxisSide is the side of the canvas a y-axis is drawn on.
+type YAxisSide int
+
+const (
+	// YAxisSideUnset means to use the default side for the axis type;
+	// the secondary axis is drawn on the left, all others on the right.
+	YAxisSideUnset YAxisSide = 0
+	// YAxisSideRight draws the axis to the right of the canvas.
+	YAxisSideRight YAxisSide = 1
+	// YAxisSideLeft draws the axis to the left of the canvas.
+	YAxisSideLeft YAxisSide = 2
+)
+
 // Axis is a chart feature detailing what values happen where.
 type Axis interface {
 	GetName() string
@@ -28,6 +28,9 @@ type BarChart struct {
 	XAxis Style
 	YAxis YAxis
 
+	// XAxisLabelLayout determines how overlapping bar labels are handled, i.e. wrapped, rotated or thinned.
+	XAxisLabelLayout TickLabelLayout
+
 	BarSpacing int
 
 	UseBaseValue bool
@@ -239,27 +242,42 @@ func (bc BarChart) drawXAxis(r Renderer, canvasBox Box) {
 		r.Stroke()
 
 		cursor := canvasBox.Left
-		for index, bar := range bc.Bars {
-			barLabelBox := Box{
-				Top:    canvasBox.Bottom + DefaultXAxisMargin,
-				Left:   cursor,
-				Right:  cursor + width + spacing,
-				Bottom: bc.GetHeight(),
+		for index := range bc.Bars {
+			cursor += width + spacing
+			if index < len(bc.Bars)-1 {
+				r.MoveTo(cursor, canvasBox.Bottom)
+				r.LineTo(cursor, canvasBox.Bottom+DefaultVerticalTickHeight)
+				r.Stroke()
 			}
+		}
 
-			if len(bar.Label) > 0 {
-				Draw.TextWithin(r, bar.Label, barLabelBox, axisStyle)
+		labels := bc.getXAxisLabels(canvasBox)
+		plan := layoutTickLabels(r, labels, axisStyle, bc.XAxisLabelLayout)
+		for index, label := range labels {
+			if plan.ShouldDraw(index) {
+				plan.Render(r, label, canvasBox.Bottom+DefaultXAxisMargin, axisStyle)
 			}
+		}
+	}
+}
 
-			axisStyle.WriteToRenderer(r)
-			if index < len(bc.Bars)-1 {
-				r.MoveTo(barLabelBox.Right, canvasBox.Bottom)
-				r.LineTo(barLabelBox.Right, canvasBox.Bottom+DefaultVerticalTickHeight)
-				r.Stroke()
-			}
-			cursor += width + spacing
+// getXAxisLabels returns the bar labels positioned under each bar.
+func (bc BarChart) getXAxisLabels(canvasBox Box) []tickLabel {
+	width, spacing, _ := bc.calculateScaledTotalWidth(canvasBox)
+
+	var labels []tickLabel
+	cursor := canvasBox.Left
+	for _, bar := range bc.Bars {
+		if len(bar.Label) > 0 {
+			labels = append(labels, tickLabel{
+				Label: bar.Label,
+				Left:  cursor,
+				Right: cursor + width + spacing,
+			})
 		}
+		cursor += width + spacing
 	}
+	return labels
 }
 
 func (bc BarChart) drawYAxis(r Renderer, canvasBox Box, yr Range, ticks []Tick) {
@@ -368,31 +386,26 @@ func (bc BarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box, yrange Range,
 
 	if !bc.XAxis.Hidden {
 		xaxisHeight := DefaultVerticalTickHeight
+		xaxisLeft := canvasBox.Left
 
 		axisStyle := bc.XAxis.InheritFrom(bc.styleDefaultsAxes())
 		axisStyle.WriteToRenderer(r)
 
-		cursor := canvasBox.Left
-		for _, bar := range bc.Bars {
-			if len(bar.Label) > 0 {
-				barLabelBox := Box{
-					Top:    canvasBox.Bottom + DefaultXAxisMargin,
-					Left:   cursor,
-					Right:  cursor + bc.GetBarWidth() + bc.GetBarSpacing(),
-					Bottom: bc.GetHeight(),
-				}
-				lines := Text.WrapFit(r, bar.Label, barLabelBox.Width(), axisStyle)
-				linesBox := Text.MeasureLines(r, lines, axisStyle)
-
-				xaxisHeight = MinInt(linesBox.Height()+(2*DefaultXAxisMargin), xaxisHeight)
+		labels := bc.getXAxisLabels(canvasBox)
+		plan := layoutTickLabels(r, labels, axisStyle, bc.XAxisLabelLayout)
+		for index, label := range labels {
+			if plan.ShouldDraw(index) {
+				labelBox := plan.Measure(r, label, canvasBox.Bottom+DefaultXAxisMargin, axisStyle)
+				xaxisHeight = MaxInt(labelBox.Bottom-canvasBox.Bottom+DefaultXAxisMargin, xaxisHeight)
+				xaxisLeft = MinInt(labelBox.Left, xaxisLeft)
 			}
 		}
 
 		xbox := Box{
 			Top:    canvasBox.Top,
-			Left:   canvasBox.Left,
+			Left:   xaxisLeft,
 			Right:  canvasBox.Left + totalWidth,
-			Bottom: bc.GetHeight() - xaxisHeight,
+			Bottom: canvasBox.Bottom + xaxisHeight,
 		}
 
 		axesOuterBox = axesOuterBox.Grow(xbox)
@@ -0,0 +1,118 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series                = (*BarSeries)(nil)
+	_ ValuesProvider        = (*BarSeries)(nil)
+	_ BoundedValuesProvider = (*BarSeries)(nil)
+	_ LastValuesProvider    = (*BarSeries)(nil)
+)
+
+// BandWidthProvider is a range that can report the pixel width of the band a value occupies.
+type BandWidthProvider interface {
+	GetBandWidth() int
+}
+
+// BarSeries draws a bar for each value on a continuous chart.
+//
+// The x-value of each bar is its index in `Values`, which lines up with the
+// categories of a `CategoricalRange` x-axis; when the x-range is a `BandWidthProvider`
+// the bars fill the band less its padding.
+type BarSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// BaseValue is the value the bars are drawn from, typically zero.
+	BaseValue float64
+	Values    []Value
+}
+
+// GetName returns the name of the series.
+func (bs BarSeries) GetName() string {
+	return bs.Name
+}
+
+// GetStyle returns the series style.
+func (bs BarSeries) GetStyle() Style {
+	return bs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (bs BarSeries) GetYAxis() YAxisType {
+	return bs.YAxis
+}
+
+// Len returns the number of elements in the series.
+func (bs BarSeries) Len() int {
+	return len(bs.Values)
+}
+
+// GetValues gets the x,y values at a given index.
+func (bs BarSeries) GetValues(index int) (x, y float64) {
+	x = float64(index)
+	y = bs.Values[index].Value
+	return
+}
+
+// GetBoundedValues returns the top of the bar and the base value, so the y-range includes both.
+func (bs BarSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	x = float64(index)
+	y1 = bs.Values[index].Value
+	y2 = bs.BaseValue
+	return
+}
+
+// GetLastValues gets the last x,y values.
+func (bs BarSeries) GetLastValues() (x, y float64) {
+	return bs.GetValues(len(bs.Values) - 1)
+}
+
+// Render renders the series.
+func (bs BarSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if len(bs.Values) == 0 {
+		return
+	}
+
+	style := bs.Style.InheritFrom(defaults.InheritFrom(Style{
+		FillColor: defaults.GetStrokeColor(),
+	}))
+
+	barWidth := getBandWidth(xrange, len(bs.Values))
+	barWidth2 := barWidth >> 1
+
+	yb := canvasBox.Bottom - yrange.Translate(bs.BaseValue)
+	for index, v := range bs.Values {
+		x := canvasBox.Left + xrange.Translate(float64(index))
+		y := canvasBox.Bottom - yrange.Translate(v.Value)
+
+		Draw.Box(r, Box{
+			Top:    MinInt(y, yb),
+			Left:   x - barWidth2,
+			Right:  x - barWidth2 + barWidth,
+			Bottom: MaxInt(y, yb),
+		}, v.Style.InheritFrom(style))
+	}
+}
+
+// Validate validates the series.
+func (bs BarSeries) Validate() error {
+	if len(bs.Values) == 0 {
+		return fmt.Errorf("bar series must have values set")
+	}
+	return nil
+}
+
+// getBandWidth returns the pixel width of the band of each of a number of categories; the band reported by the range
+// if it is a `BandWidthProvider`, or an equal share of the domain less the band padding.
+func getBandWidth(xrange Range, count int) int {
+	if bwp, isBandWidthProvider := xrange.(BandWidthProvider); isBandWidthProvider {
+		return bwp.GetBandWidth()
+	}
+	return int(math.Floor(float64(xrange.GetDomain()) / float64(count) * (1.0 - DefaultCategoricalBandPadding)))
+}
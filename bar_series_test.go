@@ -0,0 +1,61 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestBarSeriesValues(t *testing.T) {
+	bs := BarSeries{
+		BaseValue: 1,
+		Values: []Value{
+			{Value: 5},
+			{Value: -2},
+		},
+	}
+
+	testutil.AssertNil(t, bs.Validate())
+	testutil.AssertEqual(t, 2, bs.Len())
+
+	x, y := bs.GetValues(1)
+	testutil.AssertEqual(t, 1.0, x)
+	testutil.AssertEqual(t, -2.0, y)
+
+	x, y1, y2 := bs.GetBoundedValues(0)
+	testutil.AssertEqual(t, 0.0, x)
+	testutil.AssertEqual(t, 5.0, y1)
+	testutil.AssertEqual(t, 1.0, y2)
+
+	testutil.AssertNotNil(t, BarSeries{}.Validate())
+}
+
+func TestBarSeriesOnCategoricalRange(t *testing.T) {
+	xrange := &CategoricalRange{Categories: []string{"north", "south", "east"}}
+	c := Chart{
+		XAxis: XAxis{Range: xrange},
+		Series: []Series{
+			BarSeries{
+				Values: []Value{
+					{Value: 10}, {Value: 20}, {Value: 15},
+				},
+			},
+			ContinuousSeries{
+				XValues: []float64{0, 1, 2},
+				YValues: []float64{12, 12, 12},
+			},
+		},
+	}
+
+	xr, yr, _ := c.getRanges()
+	testutil.AssertEqual(t, -0.5, xr.GetMin())
+	testutil.AssertEqual(t, 2.5, xr.GetMax())
+	testutil.AssertEqual(t, 0.0, yr.GetMin())
+	testutil.AssertEqual(t, 20.0, yr.GetMax())
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+	testutil.AssertNotZero(t, xrange.GetDomain())
+}
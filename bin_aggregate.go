@@ -0,0 +1,220 @@
+package chart
+
+import (
+	"math"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// BinAggregate is an enum for what the color of a bin of a hexbin or 2d histogram series shows.
+type BinAggregate int
+
+const (
+	// BinAggregateCount colors the bins by the number of points in them.
+	BinAggregateCount BinAggregate = 0
+	// BinAggregateLogCount colors the bins by the logarithm of the number of points in them, which keeps
+	// sparse bins apart when a few bins hold most of the points.
+	BinAggregateLogCount BinAggregate = 1
+	// BinAggregateMean colors the bins by the mean of the values of the points in them.
+	BinAggregateMean BinAggregate = 2
+)
+
+// bin is the points that fell into a bin.
+type bin struct {
+	Count int
+	Sum   float64
+}
+
+// add adds a point with a value to the bin.
+func (b *bin) add(value float64) {
+	b.Count++
+	b.Sum += value
+}
+
+// value returns the value the bin is colored by.
+func (b bin) value(aggregate BinAggregate) float64 {
+	switch aggregate {
+	case BinAggregateLogCount:
+		return math.Log10(float64(b.Count))
+	case BinAggregateMean:
+		return b.Sum / float64(b.Count)
+	default:
+		return float64(b.Count)
+	}
+}
+
+// binnedSeries is a series that aggregates its points into colored bins.
+type binnedSeries interface {
+	Series
+	getColorScale(canvasBox Box, xrange, yrange Range) binColorScale
+}
+
+// binColorScale maps the values of the bins of a series to colors.
+type binColorScale struct {
+	Min, Max       float64
+	Aggregate      BinAggregate
+	ColorProvider  ColorProvider
+	ValueFormatter ValueFormatter
+}
+
+// newBinColorScale returns the color scale over the values of the bins.
+func newBinColorScale(bins []bin, aggregate BinAggregate, colorProvider ColorProvider, valueFormatter ValueFormatter) binColorScale {
+	scale := binColorScale{
+		Min:            math.Inf(1),
+		Max:            math.Inf(-1),
+		Aggregate:      aggregate,
+		ColorProvider:  colorProvider,
+		ValueFormatter: valueFormatter,
+	}
+	for _, b := range bins {
+		value := b.value(aggregate)
+		scale.Min, scale.Max = math.Min(scale.Min, value), math.Max(scale.Max, value)
+	}
+	if scale.Min > scale.Max {
+		scale.Min, scale.Max = 0, 0
+	}
+	return scale
+}
+
+// GetColor returns the color of a bin value.
+func (bcs binColorScale) GetColor(value float64) drawing.Color {
+	vmin, vmax := bcs.Min, bcs.Max
+	if vmax <= vmin {
+		// every bin gets the middle color when they all have the same value.
+		vmin, vmax = value-1, value+1
+	}
+	if bcs.ColorProvider != nil {
+		return bcs.ColorProvider(value, vmin, vmax)
+	}
+	return Viridis(math.Max(vmin, math.Min(value, vmax)), vmin, vmax)
+}
+
+// GetValueFormatter returns the formatter of the labels of the scale or the default for the aggregate.
+func (bcs binColorScale) GetValueFormatter() ValueFormatter {
+	if bcs.ValueFormatter != nil {
+		return bcs.ValueFormatter
+	}
+	if bcs.Aggregate == BinAggregateMean {
+		return FloatValueFormatter
+	}
+	return IntValueFormatter
+}
+
+// GetTicks returns the labeled values of the scale; round values for counts and means, and powers of ten,
+// or round counts if there are too few of those, for log counts.
+func (bcs binColorScale) GetTicks() (values, labels []float64) {
+	if bcs.Max <= bcs.Min {
+		return []float64{bcs.Min}, []float64{bcs.label(bcs.Min)}
+	}
+
+	if bcs.Aggregate == BinAggregateLogCount {
+		for _, multiples := range [][]float64{{1}, {1, 2, 5}} {
+			values, labels = nil, nil
+			for magnitude := math.Floor(bcs.Min); magnitude <= bcs.Max; magnitude++ {
+				for _, multiple := range multiples {
+					value := magnitude + math.Log10(multiple)
+					if value >= bcs.Min && value <= bcs.Max {
+						values = append(values, value)
+						labels = append(labels, bcs.label(value))
+					}
+				}
+			}
+			if len(values) >= 3 {
+				break
+			}
+		}
+		return
+	}
+
+	step := niceStep((bcs.Max - bcs.Min) / float64(DefaultBinColorScaleTicks))
+	if bcs.Aggregate == BinAggregateCount {
+		step = math.Max(step, 1)
+	}
+	for multiple := math.Ceil(bcs.Min / step); multiple*step <= bcs.Max; multiple++ {
+		values = append(values, multiple*step)
+		labels = append(labels, multiple*step)
+	}
+	return
+}
+
+// label returns the value shown for a value of the scale; the count for log counts.
+func (bcs binColorScale) label(value float64) float64 {
+	if bcs.Aggregate == BinAggregateLogCount {
+		return math.Round(math.Pow(10, value))
+	}
+	return value
+}
+
+// BinColorScale returns a legend renderable function that draws the colors of the bins of the first hexbin
+// or 2d histogram series of a chart as a gradient bar along the left edge of the chart with round values
+// on its right, so it is meant to be drawn in the left padding of the chart.
+func BinColorScale(c *Chart, userDefaults ...Style) Renderable {
+	return func(r Renderer, cb Box, chartDefaults Style) {
+		var series binnedSeries
+		for _, s := range c.Series {
+			if bs, ok := s.(binnedSeries); ok && !s.GetStyle().Hidden {
+				series = bs
+				break
+			}
+		}
+		if series == nil {
+			return
+		}
+		xrange, yrange := c.getSeriesRanges(cb, series)
+		scale := series.getColorScale(cb, xrange, yrange)
+
+		legendDefaults := Style{
+			FontColor:   DefaultTextColor,
+			FontSize:    8.0,
+			StrokeColor: DefaultAxisColor,
+			StrokeWidth: DefaultAxisLineWidth,
+		}
+		var legendStyle Style
+		if len(userDefaults) > 0 {
+			legendStyle = userDefaults[0].InheritFrom(chartDefaults.InheritFrom(legendDefaults))
+		} else {
+			legendStyle = chartDefaults.InheritFrom(legendDefaults)
+		}
+
+		bar := Box{
+			Top:    cb.Top,
+			Left:   5,
+			Right:  5 + DefaultBinColorScaleWidth,
+			Bottom: cb.Bottom,
+		}
+		valueAt := func(y int) float64 {
+			return scale.Min + (scale.Max-scale.Min)*float64(bar.Bottom-y)/float64(MaxInt(bar.Height(), 1))
+		}
+
+		// the gradient is drawn a row of pixels at a time.
+		for y := bar.Top; y < bar.Bottom; y++ {
+			color := scale.GetColor(valueAt(y))
+			Draw.Box(r, Box{Top: y, Left: bar.Left, Right: bar.Right, Bottom: y + 1}, Style{FillColor: color})
+		}
+		Draw.Box(r, bar, Style{StrokeColor: legendStyle.GetStrokeColor(), StrokeWidth: legendStyle.GetStrokeWidth()})
+
+		vf := scale.GetValueFormatter()
+		values, labels := scale.GetTicks()
+		lastTop := math.MaxInt32
+		for index, value := range values {
+			y := bar.Bottom
+			if scale.Max > scale.Min {
+				y = bar.Bottom - int(float64(bar.Height())*(value-scale.Min)/(scale.Max-scale.Min))
+			}
+			label := vf(labels[index])
+			tb := Draw.MeasureText(r, label, legendStyle)
+			if y+tb.Height()>>1 > lastTop-DefaultMinimumTickVerticalSpacing {
+				continue
+			}
+
+			legendStyle.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(bar.Right, y)
+			r.LineTo(bar.Right+DefaultHorizontalTickWidth, y)
+			r.Stroke()
+			r.ResetStyle()
+
+			Draw.Text(r, label, bar.Right+DefaultYAxisMargin, y+tb.Height()>>1, legendStyle)
+			lastTop = y - tb.Height()>>1
+		}
+	}
+}
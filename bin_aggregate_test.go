@@ -0,0 +1,49 @@
+package chart
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestBinValue(t *testing.T) {
+	b := bin{}
+	b.add(2)
+	b.add(4)
+	b.add(9)
+
+	testutil.AssertEqual(t, 3.0, b.value(BinAggregateCount))
+	testutil.AssertInDelta(t, math.Log10(3), b.value(BinAggregateLogCount), 0.0001)
+	testutil.AssertEqual(t, 5.0, b.value(BinAggregateMean))
+}
+
+func TestBinColorScaleGetTicks(t *testing.T) {
+	scale := binColorScale{Min: 1, Max: 23, Aggregate: BinAggregateCount}
+	values, labels := scale.GetTicks()
+	testutil.AssertEqual(t, []float64{5, 10, 15, 20}, values)
+	testutil.AssertEqual(t, values, labels)
+
+	// log counts are labeled with the counts at powers of ten.
+	scale = binColorScale{Min: 0, Max: math.Log10(2500), Aggregate: BinAggregateLogCount}
+	values, labels = scale.GetTicks()
+	testutil.AssertLen(t, values, 4)
+	testutil.AssertEqual(t, []float64{1, 10, 100, 1000}, labels)
+
+	// with too few powers of ten the round counts between them are labeled as well.
+	scale = binColorScale{Min: 0, Max: math.Log10(30), Aggregate: BinAggregateLogCount}
+	_, labels = scale.GetTicks()
+	testutil.AssertEqual(t, []float64{1, 2, 5, 10, 20}, labels)
+}
+
+func TestBinColorScaleGetColor(t *testing.T) {
+	scale := newBinColorScale([]bin{{Count: 1}, {Count: 9}}, BinAggregateCount, nil, nil)
+	testutil.AssertEqual(t, 1.0, scale.Min)
+	testutil.AssertEqual(t, 9.0, scale.Max)
+	testutil.AssertEqual(t, Viridis(1, 1, 9), scale.GetColor(1))
+	testutil.AssertEqual(t, Viridis(9, 1, 9), scale.GetColor(9))
+
+	// a scale of a single value gets the middle color.
+	scale = newBinColorScale([]bin{{Count: 4}}, BinAggregateCount, nil, nil)
+	testutil.AssertEqual(t, Viridis(0.5, 0, 1), scale.GetColor(4))
+}
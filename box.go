@@ -135,6 +135,14 @@ func (b Box) IsSmallerThan(other Box) bool {
 		b.Right < other.Right
 }
 
+// Intersects returns if a box overlaps another box.
+func (b Box) Intersects(other Box) bool {
+	return b.Left < other.Right &&
+		other.Left < b.Right &&
+		b.Top < other.Bottom &&
+		other.Top < b.Bottom
+}
+
 // Equals returns if the box equals another box.
 func (b Box) Equals(other Box) bool {
 	return b.Top == other.Top &&
@@ -52,6 +52,14 @@ func TestBoxIsSmallerThan(t *testing.T) {
 	testutil.AssertFalse(t, c.IsSmallerThan(a))
 }
 
+func TestBoxIntersects(t *testing.T) {
+	a := Box{Top: 0, Left: 0, Right: 10, Bottom: 10}
+	testutil.AssertTrue(t, a.Intersects(Box{Top: 5, Left: 5, Right: 15, Bottom: 15}))
+	testutil.AssertTrue(t, a.Intersects(Box{Top: 2, Left: 2, Right: 4, Bottom: 4}))
+	testutil.AssertFalse(t, a.Intersects(Box{Top: 0, Left: 10, Right: 20, Bottom: 10}))
+	testutil.AssertFalse(t, a.Intersects(Box{Top: 11, Left: 0, Right: 10, Bottom: 20}))
+}
+
 func TestBoxGrow(t *testing.T) {
 	// replaced new assertions helper
 
@@ -0,0 +1,287 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// Interface Assertions.
+var (
+	_ Series              = (*BubbleSeries)(nil)
+	_ ValuesProvider      = (*BubbleSeries)(nil)
+	_ FirstValuesProvider = (*BubbleSeries)(nil)
+	_ LastValuesProvider  = (*BubbleSeries)(nil)
+)
+
+// BubbleSeries draws a bubble for each point with an area proportional to its size and, optionally,
+// a color from its color value.
+//
+// The bubbles are drawn largest first so smaller bubbles stay visible on top of larger ones.
+type BubbleSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	XValues []float64
+	YValues []float64
+	// Sizes are the sizes of the bubbles; they must not be negative.
+	Sizes []float64
+	// ColorValues, if set, color the bubbles with the `ColorProvider`.
+	ColorValues   []float64
+	ColorProvider ColorProvider
+
+	// MinRadius is the radius of the smallest bubbles, which keeps small sizes visible.
+	MinRadius float64
+	// MaxRadius is the radius of the bubble of the largest size.
+	MaxRadius float64
+
+	// LegendSizes are the reference sizes drawn by `BubbleSizeLegend`; if unset they are chosen from the sizes.
+	LegendSizes        []float64
+	SizeValueFormatter ValueFormatter
+}
+
+// GetName returns the name of the series.
+func (bs BubbleSeries) GetName() string {
+	return bs.Name
+}
+
+// GetStyle returns the series style.
+func (bs BubbleSeries) GetStyle() Style {
+	return bs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (bs BubbleSeries) GetYAxis() YAxisType {
+	return bs.YAxis
+}
+
+// Len returns the number of elements in the series.
+func (bs BubbleSeries) Len() int {
+	return len(bs.XValues)
+}
+
+// GetValues gets the x,y values at a given index.
+func (bs BubbleSeries) GetValues(index int) (x, y float64) {
+	return bs.XValues[index], bs.YValues[index]
+}
+
+// GetFirstValues gets the first x,y values.
+func (bs BubbleSeries) GetFirstValues() (x, y float64) {
+	return bs.GetValues(0)
+}
+
+// GetLastValues gets the last x,y values.
+func (bs BubbleSeries) GetLastValues() (x, y float64) {
+	return bs.GetValues(len(bs.XValues) - 1)
+}
+
+// GetMinRadius returns the radius of the smallest bubbles or the default.
+func (bs BubbleSeries) GetMinRadius() float64 {
+	if bs.MinRadius <= 0 {
+		return DefaultBubbleMinRadius
+	}
+	return bs.MinRadius
+}
+
+// GetMaxRadius returns the radius of the largest bubble or the default.
+func (bs BubbleSeries) GetMaxRadius() float64 {
+	if bs.MaxRadius <= 0 {
+		return DefaultBubbleMaxRadius
+	}
+	return math.Max(bs.MaxRadius, bs.GetMinRadius())
+}
+
+// GetSizeValueFormatter returns the formatter of the sizes in the size legend or the default.
+func (bs BubbleSeries) GetSizeValueFormatter() ValueFormatter {
+	if bs.SizeValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return bs.SizeValueFormatter
+}
+
+// GetRadius returns the radius of a bubble of a size; the area is proportional to the size, so the largest size
+// gets the max radius, but no bubble is smaller than the min radius.
+func (bs BubbleSeries) GetRadius(size float64) float64 {
+	max := ValueSequence(bs.Sizes...).Max()
+	if max <= 0 {
+		return bs.GetMinRadius()
+	}
+	return math.Max(bs.GetMinRadius(), bs.GetMaxRadius()*math.Sqrt(math.Max(size, 0)/max))
+}
+
+// GetLegendSizes returns the reference sizes of the size legend; if unset they are the largest round size
+// up to the largest size and the two round sizes below it.
+func (bs BubbleSeries) GetLegendSizes() []float64 {
+	if len(bs.LegendSizes) > 0 {
+		return bs.LegendSizes
+	}
+	max := ValueSequence(bs.Sizes...).Max()
+	if max <= 0 {
+		return nil
+	}
+
+	// round sizes go 1, 2, 5, 10, 20, 50 and so on.
+	magnitude := math.Pow(10, math.Floor(math.Log10(max)))
+	multiples := []float64{1, 2, 5}
+	index := 0
+	for index < len(multiples)-1 && multiples[index+1]*magnitude <= max {
+		index++
+	}
+	var sizes []float64
+	for len(sizes) < 3 {
+		sizes = append(sizes, multiples[index]*magnitude)
+		if index--; index < 0 {
+			index = len(multiples) - 1
+			magnitude /= 10
+		}
+	}
+	return sizes
+}
+
+// Render renders the series.
+func (bs BubbleSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if len(bs.XValues) == 0 {
+		return
+	}
+	style := bs.Style.InheritFrom(defaults.InheritFrom(Style{
+		FillColor:   defaults.GetStrokeColor().WithAlpha(DefaultBubbleFillAlpha),
+		StrokeWidth: DefaultAxisLineWidth,
+	}))
+
+	var vmin, vmax float64
+	if len(bs.ColorValues) > 0 {
+		vmin, vmax = ValueSequence(bs.ColorValues...).MinMax()
+	}
+
+	for _, index := range bs.getDrawOrder() {
+		bubbleStyle := style
+		if len(bs.ColorValues) > 0 {
+			color := bs.getColor(bs.ColorValues[index], vmin, vmax)
+			bubbleStyle.FillColor = color.WithAlpha(style.GetFillColor().A)
+			bubbleStyle.StrokeColor = color
+		}
+
+		x := canvasBox.Left + xrange.Translate(bs.XValues[index])
+		y := canvasBox.Bottom - yrange.Translate(bs.YValues[index])
+		bubbleStyle.GetFillAndStrokeOptions().WriteToRenderer(r)
+		r.Circle(bs.GetRadius(bs.Sizes[index]), x, y)
+		r.FillStroke()
+		r.ResetStyle()
+	}
+}
+
+// getDrawOrder returns the indexes of the bubbles from the largest to the smallest.
+func (bs BubbleSeries) getDrawOrder() []int {
+	order := make([]int, len(bs.Sizes))
+	for index := range order {
+		order[index] = index
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return bs.Sizes[order[i]] > bs.Sizes[order[j]]
+	})
+	return order
+}
+
+func (bs BubbleSeries) getColor(v, vmin, vmax float64) drawing.Color {
+	if bs.ColorProvider != nil {
+		return bs.ColorProvider(v, vmin, vmax)
+	}
+	return Viridis(v, vmin, vmax)
+}
+
+// Validate validates the series.
+func (bs BubbleSeries) Validate() error {
+	if len(bs.XValues) == 0 {
+		return fmt.Errorf("bubble series; must have xvalues set")
+	}
+	if len(bs.XValues) != len(bs.YValues) || len(bs.XValues) != len(bs.Sizes) {
+		return fmt.Errorf("bubble series; must have the same number of xvalues, yvalues and sizes")
+	}
+	if len(bs.ColorValues) > 0 && len(bs.ColorValues) != len(bs.XValues) {
+		return fmt.Errorf("bubble series; must have the same number of color values as xvalues")
+	}
+	for index, size := range bs.Sizes {
+		if size < 0 || math.IsNaN(size) || math.IsInf(size, 0) {
+			return fmt.Errorf("bubble series; sizes must be finite and cannot be negative; index: %d size: %v", index, size)
+		}
+	}
+	for index, value := range bs.ColorValues {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return fmt.Errorf("bubble series; color values must be finite; index: %d value: %v", index, value)
+		}
+	}
+	return nil
+}
+
+// BubbleSizeLegend returns a legend renderable function that explains the sizes of the bubbles of a series with
+// nested reference bubbles in the top right of the canvas.
+func BubbleSizeLegend(bs BubbleSeries, userDefaults ...Style) Renderable {
+	return func(r Renderer, cb Box, chartDefaults Style) {
+		sizes := append([]float64{}, bs.GetLegendSizes()...)
+		if len(sizes) == 0 {
+			return
+		}
+		sort.Sort(sort.Reverse(sort.Float64Slice(sizes)))
+
+		legendDefaults := Style{
+			FillColor:   drawing.ColorWhite,
+			FontColor:   DefaultTextColor,
+			FontSize:    8.0,
+			StrokeColor: DefaultAxisColor,
+			StrokeWidth: DefaultAxisLineWidth,
+		}
+		var legendStyle Style
+		if len(userDefaults) > 0 {
+			legendStyle = userDefaults[0].InheritFrom(chartDefaults.InheritFrom(legendDefaults))
+		} else {
+			legendStyle = chartDefaults.InheritFrom(legendDefaults)
+		}
+
+		padding := 5
+		lineTextGap := 5
+		vf := bs.GetSizeValueFormatter()
+
+		var labelWidth, labelHeight int
+		for _, size := range sizes {
+			tb := Draw.MeasureText(r, vf(size), legendStyle)
+			labelWidth = MaxInt(labelWidth, tb.Width())
+			labelHeight = MaxInt(labelHeight, tb.Height())
+		}
+
+		// the bubbles share their bottom, and a leader runs from the top of each to its label on the right.
+		radius := int(math.Ceil(bs.GetRadius(sizes[0])))
+		height := MaxInt(2*radius, labelHeight*len(sizes))
+		legend := Box{
+			Top:    cb.Top,
+			Right:  cb.Right,
+			Left:   cb.Right - (2*padding + 2*radius + 2*lineTextGap + labelWidth),
+			Bottom: cb.Top + 2*padding + height + labelHeight>>1,
+		}
+		Draw.Box(r, legend, legendStyle)
+
+		cx := legend.Left + padding + radius
+		bottom := legend.Bottom - padding
+		lx := cx + radius + lineTextGap
+		circleStyle := Style{
+			StrokeColor: legendStyle.GetFontColor(),
+			StrokeWidth: DefaultAxisLineWidth,
+		}
+		for _, size := range sizes {
+			bubbleRadius := bs.GetRadius(size)
+			top := bottom - int(2*bubbleRadius)
+
+			circleStyle.GetStrokeOptions().WriteToRenderer(r)
+			r.Circle(bubbleRadius, cx, bottom-int(bubbleRadius))
+			r.Stroke()
+			r.MoveTo(cx, top)
+			r.LineTo(lx, top)
+			r.Stroke()
+			r.ResetStyle()
+
+			Draw.Text(r, vf(size), lx+lineTextGap, top+labelHeight>>1, legendStyle)
+		}
+	}
+}
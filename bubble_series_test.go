@@ -0,0 +1,94 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestBubbleSeries(t *testing.T) {
+	bs := BubbleSeries{
+		XValues:     []float64{1, 2, 3, 4},
+		YValues:     []float64{4, 1, 3, 2},
+		Sizes:       []float64{10, 120, 0.01, 30},
+		ColorValues: []float64{1, 2, 3, 4},
+	}
+	graph := Chart{
+		Series:   []Series{bs},
+		Elements: []Renderable{BubbleSizeLegend(bs)},
+	}
+	testutil.AssertNil(t, graph.Render(PNG, bytes.NewBuffer([]byte{})))
+	testutil.AssertNil(t, graph.Render(SVG, bytes.NewBuffer([]byte{})))
+
+	// the ranges cover the centers of the bubbles.
+	xrange, yrange, _ := graph.getRanges()
+	testutil.AssertEqual(t, 1.0, xrange.GetMin())
+	testutil.AssertEqual(t, 4.0, xrange.GetMax())
+	testutil.AssertEqual(t, 1.0, yrange.GetMin())
+	testutil.AssertEqual(t, 4.0, yrange.GetMax())
+
+	// the colors span the color provider from the smallest to the largest color value.
+	testutil.AssertEqual(t, Viridis(0, 0, 1), bs.getColor(1, 1, 4))
+	testutil.AssertEqual(t, Viridis(1, 0, 1), bs.getColor(4, 1, 4))
+}
+
+func TestBubbleSeriesGetRadius(t *testing.T) {
+	bs := BubbleSeries{
+		Sizes:     []float64{10, 120, 0.01, 30},
+		MinRadius: 2,
+		MaxRadius: 20,
+	}
+
+	testutil.AssertEqual(t, 20.0, bs.GetRadius(120))
+	testutil.AssertEqual(t, 2.0, bs.GetRadius(0.01))
+	// the area is proportional to the size.
+	testutil.AssertInDelta(t, 10.0, bs.GetRadius(30), 0.0001)
+}
+
+func TestBubbleSeriesGetDrawOrder(t *testing.T) {
+	bs := BubbleSeries{Sizes: []float64{10, 120, 0.01, 30}}
+	testutil.AssertEqual(t, []int{1, 3, 0, 2}, bs.getDrawOrder())
+}
+
+func TestBubbleSeriesGetLegendSizes(t *testing.T) {
+	bs := BubbleSeries{Sizes: []float64{10, 120, 0.01, 30}}
+	testutil.AssertEqual(t, []float64{100, 50, 20}, bs.GetLegendSizes())
+
+	bs.Sizes = []float64{4, 1}
+	testutil.AssertEqual(t, []float64{2, 1, 0.5}, bs.GetLegendSizes())
+
+	bs.LegendSizes = []float64{3}
+	testutil.AssertEqual(t, []float64{3}, bs.GetLegendSizes())
+}
+
+func TestBubbleSeriesValidate(t *testing.T) {
+	bs := BubbleSeries{
+		XValues: []float64{1, 2},
+		YValues: []float64{2, 1},
+		Sizes:   []float64{10, 20},
+	}
+	testutil.AssertNil(t, bs.Validate())
+
+	bs.Sizes = []float64{10}
+	testutil.AssertNotNil(t, bs.Validate())
+
+	bs.Sizes = []float64{10, 20, 30}
+	testutil.AssertNotNil(t, bs.Validate())
+
+	for _, size := range []float64{-1, math.NaN(), math.Inf(1)} {
+		bs.Sizes = []float64{size, 20}
+		testutil.AssertNotNil(t, bs.Validate())
+	}
+
+	bs.Sizes = []float64{10, 20}
+	bs.ColorValues = []float64{1}
+	testutil.AssertNotNil(t, bs.Validate())
+
+	bs.ColorValues = []float64{1, math.NaN()}
+	testutil.AssertNotNil(t, bs.Validate())
+
+	bs.ColorValues = []float64{1, 2}
+	testutil.AssertNil(t, bs.Validate())
+}
@@ -0,0 +1,404 @@
+package chart
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// BulletRange is a qualitative range of a bullet chart, such as poor, satisfactory or good; it spans from the
+// maximum of the range before it, or the minimum of the chart, to its maximum.
+type BulletRange struct {
+	Max   float64
+	Style Style
+}
+
+// BulletChart is a compact chart that shows a measure against a target over qualitative ranges, in the style
+// described by Stephen Few. The title and subtitle are drawn to the left and the scale below.
+type BulletChart struct {
+	Title         string
+	TitleStyle    Style
+	Subtitle      string
+	SubtitleStyle Style
+
+	ColorPalette ColorPalette
+
+	Width  int
+	Height int
+	DPI    float64
+
+	// Background is the style of the background; unlike the other charts it has no padding by default.
+	Background Style
+
+	// Min is the start of the scale.
+	Min float64
+	// Max is the end of the scale; if it isn't greater than the minimum it is the largest of the ranges,
+	// the measure and the target rounded up.
+	Max float64
+
+	// Ranges are the qualitative ranges in ascending order; they are shaded from dark to light by default.
+	Ranges []BulletRange
+
+	Measure      float64
+	MeasureStyle Style
+
+	// Target is marked with a line across the measure; hide it with `TargetStyle`.
+	Target      float64
+	TargetStyle Style
+
+	// AxisStyle is the style of the scale; hide it for the most compact chart.
+	AxisStyle Style
+	// Ticks are the ticks of the scale; if unset they are generated.
+	Ticks          []Tick
+	ValueFormatter ValueFormatter
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+}
+
+// GetDPI returns the dpi for the chart.
+func (bc BulletChart) GetDPI(defaults ...float64) float64 {
+	if bc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return bc.DPI
+}
+
+// GetFont returns the text font.
+func (bc BulletChart) GetFont() *truetype.Font {
+	if bc.Font == nil {
+		return bc.defaultFont
+	}
+	return bc.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (bc BulletChart) GetWidth() int {
+	if bc.Width == 0 {
+		return DefaultBulletChartWidth
+	}
+	return bc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (bc BulletChart) GetHeight() int {
+	if bc.Height == 0 {
+		return DefaultBulletChartHeight
+	}
+	return bc.Height
+}
+
+// GetMax returns the end of the scale; if it is unset it is the largest value rounded up to a tick.
+func (bc BulletChart) GetMax() float64 {
+	if bc.Max > bc.Min {
+		return bc.Max
+	}
+	max := math.Max(bc.Measure, bc.Target)
+	for _, r := range bc.Ranges {
+		max = math.Max(max, r.Max)
+	}
+	if max <= bc.Min {
+		return bc.Min + 1
+	}
+	step := niceStep((max - bc.Min) / DefaultBulletChartTicks)
+	return bc.Min + math.Ceil((max-bc.Min)/step)*step
+}
+
+// GetValueFormatter returns the value formatter or the default.
+func (bc BulletChart) GetValueFormatter() ValueFormatter {
+	if bc.ValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return bc.ValueFormatter
+}
+
+// Validate validates the chart.
+func (bc BulletChart) Validate() error {
+	if bc.Max != 0 && bc.Max <= bc.Min {
+		return fmt.Errorf("bullet chart max must be greater than min; min: %v max: %v", bc.Min, bc.Max)
+	}
+	previous := bc.Min
+	for _, r := range bc.Ranges {
+		if r.Max <= previous {
+			return fmt.Errorf("bullet chart ranges must be ascending and above the min; max: %v previous: %v", r.Max, previous)
+		}
+		previous = r.Max
+	}
+	return nil
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (bc BulletChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := bc.Validate(); err != nil {
+		return err
+	}
+
+	r, err := rp(bc.GetWidth(), bc.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if bc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		bc.defaultFont = defaultFont
+	}
+	r.SetDPI(bc.GetDPI(DefaultDPI))
+
+	Draw.Box(r, Box{
+		Right:  bc.GetWidth(),
+		Bottom: bc.GetHeight(),
+	}, bc.getBackgroundStyle())
+
+	ticks := bc.getTicks()
+	plotBox := bc.getPlotBox(r, ticks)
+	xr := &ContinuousRange{
+		Min:    bc.Min,
+		Max:    bc.GetMax(),
+		Domain: plotBox.Width(),
+	}
+
+	bc.drawRanges(r, plotBox, xr)
+	bc.drawMeasure(r, plotBox, xr)
+	bc.drawTarget(r, plotBox, xr)
+	bc.drawAxis(r, plotBox, xr, ticks)
+	bc.drawTitles(r, plotBox)
+
+	return r.Save(w)
+}
+
+// getTicks returns the ticks of the scale, which are given or generated at a round step.
+func (bc BulletChart) getTicks() []Tick {
+	if len(bc.Ticks) > 0 {
+		return bc.Ticks
+	}
+	min, max := bc.Min, bc.GetMax()
+	step := niceStep((max - min) / DefaultBulletChartTicks)
+	vf := bc.GetValueFormatter()
+
+	var ticks []Tick
+	for index := math.Ceil(min / step); index*step <= max+step/1e6; index++ {
+		ticks = append(ticks, Tick{Value: index * step, Label: vf(index * step)})
+	}
+	return ticks
+}
+
+// getPlotBox returns the box of the ranges; it leaves room for the titles to the left and the scale below,
+// and for half of the widest tick label to the right since the labels are centered on their ticks.
+func (bc BulletChart) getPlotBox(r Renderer, ticks []Tick) Box {
+	plotBox := bc.Box()
+
+	var labelWidth int
+	if len(bc.Title) > 0 && !bc.TitleStyle.Hidden {
+		labelWidth = MaxInt(labelWidth, Draw.MeasureText(r, bc.Title, bc.getTitleStyle()).Width())
+	}
+	if len(bc.Subtitle) > 0 && !bc.SubtitleStyle.Hidden {
+		labelWidth = MaxInt(labelWidth, Draw.MeasureText(r, bc.Subtitle, bc.getSubtitleStyle()).Width())
+	}
+	if labelWidth > 0 {
+		plotBox.Left += labelWidth + DefaultBulletChartLabelPadding
+	}
+
+	axisStyle := bc.getAxisStyle()
+	if !axisStyle.Hidden && len(ticks) > 0 {
+		var tickWidth, tickHeight int
+		for _, t := range ticks {
+			tb := Draw.MeasureText(r, t.Label, axisStyle)
+			tickWidth = MaxInt(tickWidth, tb.Width())
+			tickHeight = MaxInt(tickHeight, tb.Height())
+		}
+		plotBox.Bottom -= DefaultBulletChartTickLength + DefaultBulletChartLabelPadding>>1 + tickHeight
+		plotBox.Right -= tickWidth >> 1
+	}
+	return plotBox
+}
+
+func (bc BulletChart) drawRanges(r Renderer, plotBox Box, xr Range) {
+	from := bc.Min
+	for index, rg := range bc.Ranges {
+		style := bc.getRangeStyle(index)
+		if !style.Hidden {
+			Draw.Box(r, Box{
+				Top:    plotBox.Top,
+				Left:   plotBox.Left + xr.Translate(from),
+				Right:  plotBox.Left + xr.Translate(math.Min(rg.Max, xr.GetMax())),
+				Bottom: plotBox.Bottom,
+			}, style)
+		}
+		from = rg.Max
+	}
+}
+
+// drawMeasure draws the measure as a bar from zero, or from the minimum if the scale doesn't include zero.
+func (bc BulletChart) drawMeasure(r Renderer, plotBox Box, xr Range) {
+	style := bc.getMeasureStyle()
+	if style.Hidden {
+		return
+	}
+	base := math.Max(bc.Min, math.Min(0, xr.GetMax()))
+	value := math.Max(bc.Min, math.Min(bc.Measure, xr.GetMax()))
+	from, to := plotBox.Left+xr.Translate(base), plotBox.Left+xr.Translate(value)
+	if from == to {
+		return
+	}
+
+	height := int(float64(plotBox.Height()) * DefaultBulletChartMeasureHeight)
+	top := plotBox.Top + (plotBox.Height()-height)>>1
+	Draw.Box(r, Box{
+		Top:    top,
+		Left:   MinInt(from, to),
+		Right:  MaxInt(from, to),
+		Bottom: top + height,
+	}, style)
+}
+
+func (bc BulletChart) drawTarget(r Renderer, plotBox Box, xr Range) {
+	style := bc.getTargetStyle()
+	if style.Hidden || bc.Target < bc.Min || bc.Target > xr.GetMax() {
+		return
+	}
+	x := plotBox.Left + xr.Translate(bc.Target)
+	height := int(float64(plotBox.Height()) * DefaultBulletChartTargetHeight)
+	top := plotBox.Top + (plotBox.Height()-height)>>1
+
+	style.GetStrokeOptions().WriteToRenderer(r)
+	r.MoveTo(x, top)
+	r.LineTo(x, top+height)
+	r.Stroke()
+	r.ResetStyle()
+}
+
+func (bc BulletChart) drawAxis(r Renderer, plotBox Box, xr Range, ticks []Tick) {
+	style := bc.getAxisStyle()
+	if style.Hidden {
+		return
+	}
+	for _, t := range ticks {
+		if t.Value < bc.Min || t.Value > xr.GetMax() {
+			continue
+		}
+		x := plotBox.Left + xr.Translate(t.Value)
+		style.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(x, plotBox.Bottom)
+		r.LineTo(x, plotBox.Bottom+DefaultBulletChartTickLength)
+		r.Stroke()
+		r.ResetStyle()
+
+		tb := Draw.MeasureText(r, t.Label, style)
+		Draw.Text(r, t.Label, x-tb.Width()>>1, plotBox.Bottom+DefaultBulletChartTickLength+DefaultBulletChartLabelPadding>>1+tb.Height(), style)
+	}
+}
+
+// drawTitles draws the title and the subtitle right aligned to the left of the ranges, centered on them together.
+func (bc BulletChart) drawTitles(r Renderer, plotBox Box) {
+	var lines []string
+	var styles []Style
+	if len(bc.Title) > 0 && !bc.TitleStyle.Hidden {
+		lines = append(lines, bc.Title)
+		styles = append(styles, bc.getTitleStyle())
+	}
+	if len(bc.Subtitle) > 0 && !bc.SubtitleStyle.Hidden {
+		lines = append(lines, bc.Subtitle)
+		styles = append(styles, bc.getSubtitleStyle())
+	}
+
+	var total int
+	heights := make([]int, len(lines))
+	for index, line := range lines {
+		heights[index] = Draw.MeasureText(r, line, styles[index]).Height()
+		total += heights[index]
+	}
+	total += (len(lines) - 1) * (DefaultBulletChartLabelPadding >> 1)
+
+	y := plotBox.Top + (plotBox.Height()-total)>>1
+	for index, line := range lines {
+		y += heights[index]
+		tb := Draw.MeasureText(r, line, styles[index])
+		Draw.Text(r, line, plotBox.Left-DefaultBulletChartLabelPadding-tb.Width(), y, styles[index])
+		y += DefaultBulletChartLabelPadding >> 1
+	}
+}
+
+// getRangeStyle returns the style of a range; the ranges are shaded from dark gray to light gray.
+func (bc BulletChart) getRangeStyle(index int) Style {
+	dark, light := 0x99, 0xe0
+	shade := light
+	if len(bc.Ranges) > 1 {
+		shade = dark + (light-dark)*index/(len(bc.Ranges)-1)
+	}
+	color := drawing.Color{R: uint8(shade), G: uint8(shade), B: uint8(shade), A: 255}
+	return bc.Ranges[index].Style.InheritFrom(Style{
+		FillColor: color,
+	})
+}
+
+func (bc BulletChart) getMeasureStyle() Style {
+	return bc.MeasureStyle.InheritFrom(Style{
+		FillColor: ColorBlack,
+	})
+}
+
+func (bc BulletChart) getTargetStyle() Style {
+	return bc.TargetStyle.InheritFrom(Style{
+		StrokeColor: ColorBlack,
+		StrokeWidth: DefaultBulletChartTargetWidth,
+	})
+}
+
+func (bc BulletChart) getAxisStyle() Style {
+	return bc.AxisStyle.InheritFrom(Style{
+		StrokeColor: bc.GetColorPalette().AxisStrokeColor(),
+		StrokeWidth: DefaultAxisLineWidth,
+		FontColor:   bc.GetColorPalette().TextColor(),
+		FontSize:    DefaultAxisFontSize,
+		Font:        bc.GetFont(),
+	})
+}
+
+func (bc BulletChart) getTitleStyle() Style {
+	return bc.TitleStyle.InheritFrom(Style{
+		FontColor: bc.GetColorPalette().TextColor(),
+		FontSize:  DefaultBulletChartTitleFontSize,
+		Font:      bc.GetFont(),
+	})
+}
+
+func (bc BulletChart) getSubtitleStyle() Style {
+	return bc.SubtitleStyle.InheritFrom(Style{
+		FontColor: bc.GetColorPalette().TextColor().WithAlpha(160),
+		FontSize:  DefaultAxisFontSize,
+		Font:      bc.GetFont(),
+	})
+}
+
+func (bc BulletChart) getBackgroundStyle() Style {
+	return bc.Background.InheritFrom(Style{
+		FillColor: bc.GetColorPalette().BackgroundColor(),
+	})
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (bc BulletChart) GetColorPalette() ColorPalette {
+	if bc.ColorPalette != nil {
+		return bc.ColorPalette
+	}
+	return DefaultColorPalette
+}
+
+// Box returns the chart bounds as a box.
+func (bc BulletChart) Box() Box {
+	return Box{
+		Top:    bc.Background.Padding.GetTop(),
+		Left:   bc.Background.Padding.GetLeft(),
+		Right:  bc.GetWidth() - bc.Background.Padding.GetRight(),
+		Bottom: bc.GetHeight() - bc.Background.Padding.GetBottom(),
+	}
+}
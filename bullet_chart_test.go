@@ -0,0 +1,95 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestBulletChart(t *testing.T) {
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	bc := BulletChart{
+		Title:    "Revenue",
+		Subtitle: "USD",
+		Font:     f,
+		Ranges: []BulletRange{
+			{Max: 150},
+			{Max: 225},
+			{Max: 300},
+		},
+		Measure: 270,
+		Target:  250,
+	}
+	testutil.AssertNil(t, bc.Render(PNG, bytes.NewBuffer([]byte{})))
+	testutil.AssertNil(t, bc.Render(SVG, bytes.NewBuffer([]byte{})))
+
+	// the ranges leave room for the titles on their left and the scale below them.
+	r, err := PNG(bc.GetWidth(), bc.GetHeight())
+	testutil.AssertNil(t, err)
+	box := bc.Box()
+	plotBox := bc.getPlotBox(r, bc.getTicks())
+	titleWidth := Draw.MeasureText(r, bc.Title, bc.getTitleStyle()).Width()
+	testutil.AssertTrue(t, plotBox.Left >= box.Left+titleWidth+DefaultBulletChartLabelPadding)
+	testutil.AssertTrue(t, plotBox.Bottom < box.Bottom)
+	testutil.AssertTrue(t, plotBox.Right < box.Right)
+	testutil.AssertEqual(t, box.Top, plotBox.Top)
+}
+
+func TestBulletChartValidate(t *testing.T) {
+	bc := BulletChart{
+		Ranges: []BulletRange{
+			{Max: 150},
+			{Max: 225},
+		},
+		Measure: 270,
+	}
+	testutil.AssertNil(t, bc.Validate())
+
+	bc.Ranges[1].Max = 100
+	testutil.AssertNotNil(t, bc.Validate())
+	bc.Ranges[1].Max = 225
+
+	bc.Min, bc.Max = 10, 5
+	testutil.AssertNotNil(t, bc.Validate())
+}
+
+func TestBulletChartGetMax(t *testing.T) {
+	bc := BulletChart{
+		Ranges: []BulletRange{
+			{Max: 150},
+			{Max: 225},
+			{Max: 300},
+		},
+		Measure: 270,
+		Target:  250,
+	}
+	testutil.AssertEqual(t, 300.0, bc.GetMax())
+
+	// the largest value is rounded up to a tick.
+	bc.Measure = 310
+	testutil.AssertEqual(t, 400.0, bc.GetMax())
+
+	bc.Max = 500
+	testutil.AssertEqual(t, 500.0, bc.GetMax())
+}
+
+func TestBulletChartGetTicks(t *testing.T) {
+	bc := BulletChart{
+		Ranges: []BulletRange{
+			{Max: 150},
+			{Max: 225},
+			{Max: 300},
+		},
+		Measure: 270,
+		Target:  250,
+	}
+	bc.ValueFormatter = IntValueFormatter
+
+	ticks := bc.getTicks()
+	testutil.AssertLen(t, ticks, 4)
+	testutil.AssertEqual(t, 0.0, ticks[0].Value)
+	testutil.AssertEqual(t, "300", ticks[3].Label)
+}
@@ -0,0 +1,522 @@
+package chart
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// CalendarHeatmapBucket is a discrete color bucket of a calendar heatmap; a day takes the style of the last bucket
+// whose minimum is at or below its value.
+type CalendarHeatmapBucket struct {
+	Min   float64
+	Style Style
+}
+
+// CalendarHeatmapChart is a chart that shows a value per day as colored cells, with a column per week and a row
+// per weekday, and a block of weeks for each year.
+type CalendarHeatmapChart struct {
+	Title      string
+	TitleStyle Style
+
+	ColorPalette ColorPalette
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+
+	// Start and End limit the days that are drawn; if unset the chart spans the whole years of the values.
+	Start time.Time
+	End   time.Time
+
+	// WeekStart is the weekday of the first row.
+	WeekStart time.Weekday
+
+	// CellSpacing is the space in pixels between the cells.
+	CellSpacing int
+
+	// ColorProvider, if set, colors the days by value.
+	ColorProvider ColorProvider
+	// Buckets, if set, color the days by the bucket their value falls in; they must be in ascending order.
+	Buckets []CalendarHeatmapBucket
+	// CellStyle is the default style of the days with a value, EmptyStyle of the days without one.
+	CellStyle  Style
+	EmptyStyle Style
+	LabelStyle Style
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	// Times and Values are the days and their values; values for the same day are added together.
+	Times    []time.Time
+	Values   []float64
+	Elements []Renderable
+}
+
+// calendarHeatmapLayout is where the weeks of a calendar heatmap are drawn.
+type calendarHeatmapLayout struct {
+	// Left is the left of the first week and Tops the top of the first weekday of each year.
+	Left int
+	Tops []int
+	// Cell is the size in pixels of a cell including the spacing after it.
+	Cell int
+}
+
+// GetDPI returns the dpi for the chart.
+func (chc CalendarHeatmapChart) GetDPI(defaults ...float64) float64 {
+	if chc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return chc.DPI
+}
+
+// GetFont returns the text font.
+func (chc CalendarHeatmapChart) GetFont() *truetype.Font {
+	if chc.Font == nil {
+		return chc.defaultFont
+	}
+	return chc.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (chc CalendarHeatmapChart) GetWidth() int {
+	if chc.Width == 0 {
+		return DefaultChartWidth
+	}
+	return chc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (chc CalendarHeatmapChart) GetHeight() int {
+	if chc.Height == 0 {
+		return DefaultChartHeight
+	}
+	return chc.Height
+}
+
+// GetCellSpacing returns the space between the cells or the default.
+func (chc CalendarHeatmapChart) GetCellSpacing() int {
+	if chc.CellSpacing <= 0 {
+		return DefaultCalendarHeatmapCellSpacing
+	}
+	return chc.CellSpacing
+}
+
+// GetStart returns the first day that is drawn; if unset it is the first of January of the year of the first value.
+func (chc CalendarHeatmapChart) GetStart() time.Time {
+	if !chc.Start.IsZero() {
+		return calendarDay(chc.Start)
+	}
+	start := calendarDay(chc.Times[0])
+	for _, t := range chc.Times {
+		if day := calendarDay(t); day.Before(start) {
+			start = day
+		}
+	}
+	return time.Date(start.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// GetEnd returns the last day that is drawn; if unset it is the last of December of the year of the last value.
+func (chc CalendarHeatmapChart) GetEnd() time.Time {
+	if !chc.End.IsZero() {
+		return calendarDay(chc.End)
+	}
+	end := calendarDay(chc.Times[0])
+	for _, t := range chc.Times {
+		if day := calendarDay(t); day.After(end) {
+			end = day
+		}
+	}
+	return time.Date(end.Year(), time.December, 31, 0, 0, 0, 0, time.UTC)
+}
+
+// Validate validates the chart.
+func (chc CalendarHeatmapChart) Validate() error {
+	if len(chc.Times) == 0 {
+		return fmt.Errorf("please provide at least one value")
+	}
+	if len(chc.Times) != len(chc.Values) {
+		return fmt.Errorf("calendar heatmap times and values must be the same length; times: %d values: %d", len(chc.Times), len(chc.Values))
+	}
+	if chc.GetEnd().Before(chc.GetStart()) {
+		return fmt.Errorf("calendar heatmap end must not be before start")
+	}
+	for index := 1; index < len(chc.Buckets); index++ {
+		if chc.Buckets[index].Min <= chc.Buckets[index-1].Min {
+			return fmt.Errorf("calendar heatmap buckets must be in ascending order")
+		}
+	}
+	return nil
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (chc CalendarHeatmapChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := chc.Validate(); err != nil {
+		return err
+	}
+
+	r, err := rp(chc.GetWidth(), chc.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if chc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		chc.defaultFont = defaultFont
+	}
+	r.SetDPI(chc.GetDPI(DefaultDPI))
+
+	canvasBox := chc.getCanvasBox(r)
+	chc.drawBackground(r)
+	chc.drawCanvas(r, canvasBox)
+
+	layout := chc.getLayout(r, canvasBox)
+	chc.drawCells(r, layout)
+	chc.drawLabels(r, layout)
+
+	chc.drawTitle(r)
+	for _, a := range chc.Elements {
+		a(r, canvasBox, chc.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+// calendarDay returns the calendar day of the time in its own location, as midnight UTC.
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// getDays returns the total value of each day that has a value.
+func (chc CalendarHeatmapChart) getDays() map[time.Time]float64 {
+	days := make(map[time.Time]float64)
+	for index, t := range chc.Times {
+		days[calendarDay(t)] += chc.Values[index]
+	}
+	return days
+}
+
+// getYears returns the years from the start to the end.
+func (chc CalendarHeatmapChart) getYears() []int {
+	var years []int
+	for year := chc.GetStart().Year(); year <= chc.GetEnd().Year(); year++ {
+		years = append(years, year)
+	}
+	return years
+}
+
+// getWeekdayRow returns the row of the weekday.
+func (chc CalendarHeatmapChart) getWeekdayRow(weekday time.Weekday) int {
+	return (int(weekday) - int(chc.WeekStart) + 7) % 7
+}
+
+// getWeekColumn returns the column of the week of the day within its year; the first column is the week
+// of the first of January.
+func (chc CalendarHeatmapChart) getWeekColumn(day time.Time) int {
+	first := time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	return (day.YearDay() - 1 + chc.getWeekdayRow(first.Weekday())) / 7
+}
+
+// getWeeks returns the number of columns of the years with the most weeks.
+func (chc CalendarHeatmapChart) getWeeks() int {
+	var weeks int
+	for _, year := range chc.getYears() {
+		weeks = MaxInt(weeks, chc.getWeekColumn(time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC))+1)
+	}
+	return weeks
+}
+
+// getLayout sizes the cells to fit the years in the canvas, leaving room for the weekday and year labels
+// to the left and the month labels above each year, and centers the result.
+func (chc CalendarHeatmapChart) getLayout(r Renderer, canvasBox Box) calendarHeatmapLayout {
+	years := chc.getYears()
+	style := chc.getLabelStyle()
+
+	var labelWidth, labelHeight int
+	if !style.Hidden {
+		for row := 0; row < 7; row++ {
+			tb := Draw.MeasureText(r, chc.getWeekdayLabel(row), style)
+			labelWidth = MaxInt(labelWidth, tb.Width())
+			labelHeight = MaxInt(labelHeight, tb.Height())
+		}
+		for _, year := range years {
+			labelWidth = MaxInt(labelWidth, Draw.MeasureText(r, strconv.Itoa(year), style).Width())
+		}
+		labelWidth += DefaultCalendarHeatmapLabelPadding
+		labelHeight += DefaultCalendarHeatmapLabelPadding
+	}
+
+	weeks := chc.getWeeks()
+	spacing := DefaultCalendarHeatmapYearSpacing * (len(years) - 1)
+	cell := MinInt(
+		(canvasBox.Width()-labelWidth)/weeks,
+		(canvasBox.Height()-spacing-labelHeight*len(years))/(7*len(years)),
+	)
+	cell = MaxInt(cell, 1)
+
+	width := labelWidth + cell*weeks
+	height := spacing + (labelHeight+7*cell)*len(years)
+	layout := calendarHeatmapLayout{
+		Left: canvasBox.Left + (canvasBox.Width()-width)>>1 + labelWidth,
+		Cell: cell,
+	}
+	top := canvasBox.Top + (canvasBox.Height()-height)>>1
+	for range years {
+		layout.Tops = append(layout.Tops, top+labelHeight)
+		top += labelHeight + 7*cell + DefaultCalendarHeatmapYearSpacing
+	}
+	return layout
+}
+
+// getCellBox returns the box of the cell of the day.
+func (chc CalendarHeatmapChart) getCellBox(layout calendarHeatmapLayout, yearIndex int, day time.Time) Box {
+	left := layout.Left + chc.getWeekColumn(day)*layout.Cell
+	top := layout.Tops[yearIndex] + chc.getWeekdayRow(day.Weekday())*layout.Cell
+	size := MaxInt(layout.Cell-chc.GetCellSpacing(), 1)
+	return Box{
+		Top:    top,
+		Left:   left,
+		Right:  left + size,
+		Bottom: top + size,
+	}
+}
+
+func (chc CalendarHeatmapChart) drawCells(r Renderer, layout calendarHeatmapLayout) {
+	days := chc.getDays()
+	vmin, vmax := math.MaxFloat64, -math.MaxFloat64
+	for _, value := range days {
+		vmin = math.Min(vmin, value)
+		vmax = math.Max(vmax, value)
+	}
+
+	start, end := chc.GetStart(), chc.GetEnd()
+	years := chc.getYears()
+	for yearIndex, year := range years {
+		for day := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC); day.Year() == year; day = day.AddDate(0, 0, 1) {
+			if day.Before(start) || day.After(end) {
+				continue
+			}
+			style := chc.getEmptyStyle()
+			if value, ok := days[day]; ok {
+				style = chc.getCellStyle(value, vmin, vmax)
+			}
+			if style.Hidden {
+				continue
+			}
+			Draw.Box(r, chc.getCellBox(layout, yearIndex, day), style)
+		}
+	}
+}
+
+// drawLabels draws the year and every other weekday to the left of each year, and the months above the weeks
+// they start in where they don't overlap the month before.
+func (chc CalendarHeatmapChart) drawLabels(r Renderer, layout calendarHeatmapLayout) {
+	style := chc.getLabelStyle()
+	if style.Hidden {
+		return
+	}
+	start, end := chc.GetStart(), chc.GetEnd()
+	for yearIndex, year := range chc.getYears() {
+		top := layout.Tops[yearIndex]
+
+		yearLabel := strconv.Itoa(year)
+		tb := Draw.MeasureText(r, yearLabel, style)
+		Draw.Text(r, yearLabel, layout.Left-DefaultCalendarHeatmapLabelPadding-tb.Width(), top-DefaultCalendarHeatmapLabelPadding, style)
+
+		for row := 1; row < 7; row += 2 {
+			label := chc.getWeekdayLabel(row)
+			tb := Draw.MeasureText(r, label, style)
+			y := top + row*layout.Cell + (layout.Cell-chc.GetCellSpacing()+tb.Height())>>1
+			Draw.Text(r, label, layout.Left-DefaultCalendarHeatmapLabelPadding-tb.Width(), y, style)
+		}
+
+		right := math.MinInt32
+		for month := time.January; month <= time.December; month++ {
+			first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+			last := first.AddDate(0, 1, -1)
+			if last.Before(start) || first.After(end) {
+				continue
+			}
+			if first.Before(start) {
+				first = start
+			}
+			label := first.Month().String()[:3]
+			x := layout.Left + chc.getWeekColumn(first)*layout.Cell
+			if x < right {
+				continue
+			}
+			tb := Draw.MeasureText(r, label, style)
+			Draw.Text(r, label, x, top-DefaultCalendarHeatmapLabelPadding, style)
+			right = x + tb.Width() + DefaultCalendarHeatmapLabelPadding
+		}
+	}
+}
+
+func (chc CalendarHeatmapChart) getWeekdayLabel(row int) string {
+	return time.Weekday((int(chc.WeekStart) + row) % 7).String()[:3]
+}
+
+// getCellStyle returns the style of a day with a value; it is colored by the bucket of the value, the color provider,
+// or by default by mixing the first series color with white.
+func (chc CalendarHeatmapChart) getCellStyle(value, vmin, vmax float64) Style {
+	if len(chc.Buckets) > 0 {
+		for index := len(chc.Buckets) - 1; index >= 0; index-- {
+			if value >= chc.Buckets[index].Min {
+				return chc.Buckets[index].Style.InheritFrom(chc.CellStyle.InheritFrom(Style{
+					FillColor: chc.getBucketColor(index),
+				}))
+			}
+		}
+		return chc.getEmptyStyle()
+	}
+
+	var color drawing.Color
+	if chc.ColorProvider != nil {
+		color = chc.ColorProvider(value, vmin, vmax)
+	} else {
+		amount := 0.0
+		if vmax > vmin {
+			amount = (vmax - value) / (vmax - vmin)
+		}
+		color = chc.GetColorPalette().GetSeriesColor(0).Lighten(amount * DefaultCalendarHeatmapLighten)
+	}
+	return chc.CellStyle.InheritFrom(Style{
+		FillColor: color,
+	})
+}
+
+// getBucketColor returns the default color of a bucket; the buckets get darker shades of the first series color.
+func (chc CalendarHeatmapChart) getBucketColor(index int) drawing.Color {
+	amount := float64(len(chc.Buckets)-1-index) / float64(len(chc.Buckets)) * DefaultCalendarHeatmapLighten
+	return chc.GetColorPalette().GetSeriesColor(0).Lighten(amount)
+}
+
+func (chc CalendarHeatmapChart) getEmptyStyle() Style {
+	return chc.EmptyStyle.InheritFrom(Style{
+		FillColor: chc.GetColorPalette().AxisStrokeColor().WithAlpha(24),
+	})
+}
+
+func (chc CalendarHeatmapChart) getLabelStyle() Style {
+	return chc.LabelStyle.InheritFrom(Style{
+		FontColor: chc.GetColorPalette().TextColor(),
+		FontSize:  DefaultAxisFontSize,
+		Font:      chc.GetFont(),
+	})
+}
+
+func (chc CalendarHeatmapChart) getCanvasBox(r Renderer) Box {
+	canvasBox := chc.Box()
+	if len(chc.Title) > 0 && !chc.TitleStyle.Hidden {
+		titleBox := Draw.MeasureText(r, chc.Title, chc.styleDefaultsTitle())
+		canvasBox.Top += titleBox.Height() + DefaultTitleTop
+	}
+	return canvasBox
+}
+
+func (chc CalendarHeatmapChart) drawBackground(r Renderer) {
+	Draw.Box(r, Box{
+		Right:  chc.GetWidth(),
+		Bottom: chc.GetHeight(),
+	}, chc.getBackgroundStyle())
+}
+
+func (chc CalendarHeatmapChart) drawCanvas(r Renderer, canvasBox Box) {
+	Draw.Box(r, canvasBox, chc.getCanvasStyle())
+}
+
+func (chc CalendarHeatmapChart) drawTitle(r Renderer) {
+	if len(chc.Title) > 0 && !chc.TitleStyle.Hidden {
+		Draw.TextWithin(r, chc.Title, chc.Box(), chc.styleDefaultsTitle())
+	}
+}
+
+func (chc CalendarHeatmapChart) getBackgroundStyle() Style {
+	return chc.Background.InheritFrom(chc.styleDefaultsBackground())
+}
+
+func (chc CalendarHeatmapChart) getCanvasStyle() Style {
+	return chc.Canvas.InheritFrom(chc.styleDefaultsCanvas())
+}
+
+func (chc CalendarHeatmapChart) styleDefaultsCanvas() Style {
+	return Style{
+		FillColor:   chc.GetColorPalette().CanvasColor(),
+		StrokeColor: chc.GetColorPalette().CanvasStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (chc CalendarHeatmapChart) styleDefaultsBackground() Style {
+	return Style{
+		FillColor:   chc.GetColorPalette().BackgroundColor(),
+		StrokeColor: chc.GetColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (chc CalendarHeatmapChart) styleDefaultsElements() Style {
+	return Style{
+		Font: chc.GetFont(),
+	}
+}
+
+func (chc CalendarHeatmapChart) styleDefaultsTitle() Style {
+	return chc.TitleStyle.InheritFrom(Style{
+		FontColor:           chc.GetColorPalette().TextColor(),
+		Font:                chc.GetFont(),
+		FontSize:            chc.getTitleFontSize(),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+func (chc CalendarHeatmapChart) getTitleFontSize() float64 {
+	effectiveDimension := MinInt(chc.GetWidth(), chc.GetHeight())
+	if effectiveDimension >= 2048 {
+		return 48
+	} else if effectiveDimension >= 1024 {
+		return 24
+	} else if effectiveDimension >= 512 {
+		return 18
+	} else if effectiveDimension >= 256 {
+		return 12
+	}
+	return 10
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (chc CalendarHeatmapChart) GetColorPalette() ColorPalette {
+	if chc.ColorPalette != nil {
+		return chc.ColorPalette
+	}
+	return DefaultColorPalette
+}
+
+// Box returns the chart bounds as a box.
+func (chc CalendarHeatmapChart) Box() Box {
+	dpr := chc.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
+	dpb := chc.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
+
+	return Box{
+		Top:    chc.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
+		Left:   chc.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
+		Right:  chc.GetWidth() - dpr,
+		Bottom: chc.GetHeight() - dpb,
+	}
+}
@@ -0,0 +1,124 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestCalendarHeatmapChart(t *testing.T) {
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	chc := CalendarHeatmapChart{
+		Title:         "Test Title",
+		Font:          f,
+		ColorProvider: Viridis,
+		Times: []time.Time{
+			time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, time.February, 3, 0, 0, 0, 0, time.UTC),
+		},
+		Values: []float64{1, 5, 4},
+	}
+	testutil.AssertNil(t, chc.Render(PNG, bytes.NewBuffer([]byte{})))
+	testutil.AssertNil(t, chc.Render(SVG, bytes.NewBuffer([]byte{})))
+
+	r, err := PNG(chc.GetWidth(), chc.GetHeight())
+	testutil.AssertNil(t, err)
+
+	// the canvas is below the title, and both years fit in it one above the other.
+	canvasBox := chc.getCanvasBox(r)
+	testutil.AssertTrue(t, canvasBox.Top > chc.Box().Top)
+	layout := chc.getLayout(r, canvasBox)
+	testutil.AssertLen(t, layout.Tops, 2)
+	testutil.AssertTrue(t, layout.Cell > 1)
+	testutil.AssertTrue(t, layout.Left > canvasBox.Left)
+	testutil.AssertTrue(t, layout.Left+layout.Cell*chc.getWeeks() <= canvasBox.Right)
+	testutil.AssertTrue(t, layout.Tops[0] >= canvasBox.Top)
+	testutil.AssertTrue(t, layout.Tops[1] >= layout.Tops[0]+7*layout.Cell+DefaultCalendarHeatmapYearSpacing)
+	testutil.AssertTrue(t, layout.Tops[1]+7*layout.Cell <= canvasBox.Bottom)
+
+	// the first of March 2024 is a friday, and the second a saturday in the row below it.
+	first := chc.getCellBox(layout, 0, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC))
+	second := chc.getCellBox(layout, 0, time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC))
+	testutil.AssertEqual(t, layout.Tops[0]+5*layout.Cell, first.Top)
+	testutil.AssertEqual(t, first.Left, second.Left)
+	testutil.AssertEqual(t, first.Top+layout.Cell, second.Top)
+	testutil.AssertEqual(t, layout.Cell-chc.GetCellSpacing(), first.Height())
+
+	// the sunday after starts the next week.
+	third := chc.getCellBox(layout, 0, time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC))
+	testutil.AssertEqual(t, first.Left+layout.Cell, third.Left)
+	testutil.AssertEqual(t, layout.Tops[0], third.Top)
+}
+
+func TestCalendarHeatmapChartValidate(t *testing.T) {
+	chc := CalendarHeatmapChart{
+		Times:  []time.Time{time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		Values: []float64{1},
+	}
+	testutil.AssertNil(t, chc.Validate())
+
+	chc.Values = nil
+	testutil.AssertNotNil(t, chc.Validate())
+
+	chc.Values = []float64{1}
+	chc.Buckets = []CalendarHeatmapBucket{{Min: 5}, {Min: 1}}
+	testutil.AssertNotNil(t, chc.Validate())
+
+	chc = CalendarHeatmapChart{}
+	testutil.AssertNotNil(t, chc.Validate())
+}
+
+func TestCalendarHeatmapChartRange(t *testing.T) {
+	chc := CalendarHeatmapChart{
+		Times: []time.Time{
+			time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, time.March, 1, 17, 0, 0, 0, time.UTC),
+			time.Date(2024, time.June, 12, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, time.February, 3, 0, 0, 0, 0, time.UTC),
+		},
+		Values: []float64{1, 2, 5, 4},
+	}
+	testutil.AssertEqual(t, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), chc.GetStart())
+	testutil.AssertEqual(t, time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC), chc.GetEnd())
+	testutil.AssertEqual(t, []int{2024, 2025}, chc.getYears())
+
+	// values on the same day are added together.
+	days := chc.getDays()
+	testutil.AssertLen(t, days, 3)
+	testutil.AssertEqual(t, 3.0, days[time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)])
+}
+
+func TestCalendarHeatmapChartWeeks(t *testing.T) {
+	chc := CalendarHeatmapChart{}
+
+	// the first of January 2025 is a wednesday, so its week starts on the sunday before.
+	first := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	testutil.AssertEqual(t, 3, chc.getWeekdayRow(first.Weekday()))
+	testutil.AssertEqual(t, 0, chc.getWeekColumn(first))
+	testutil.AssertEqual(t, 1, chc.getWeekColumn(time.Date(2025, time.January, 5, 0, 0, 0, 0, time.UTC)))
+
+	chc.WeekStart = time.Monday
+	testutil.AssertEqual(t, 2, chc.getWeekdayRow(first.Weekday()))
+	testutil.AssertEqual(t, 6, chc.getWeekdayRow(time.Sunday))
+	testutil.AssertEqual(t, 0, chc.getWeekColumn(time.Date(2025, time.January, 5, 0, 0, 0, 0, time.UTC)))
+	testutil.AssertEqual(t, "Mon", chc.getWeekdayLabel(0))
+}
+
+func TestCalendarHeatmapChartBuckets(t *testing.T) {
+	chc := CalendarHeatmapChart{
+		Buckets: []CalendarHeatmapBucket{
+			{Min: 1, Style: Style{FillColor: drawing.ColorRed}},
+			{Min: 5, Style: Style{FillColor: drawing.ColorBlue}},
+		},
+	}
+
+	testutil.AssertEqual(t, drawing.ColorRed, chc.getCellStyle(4, 0, 10).FillColor)
+	testutil.AssertEqual(t, drawing.ColorBlue, chc.getCellStyle(5, 0, 10).FillColor)
+	testutil.AssertEqual(t, chc.getEmptyStyle().FillColor, chc.getCellStyle(0.5, 0, 10).FillColor)
+}
@@ -0,0 +1,136 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+const (
+	// DefaultCategoricalBandPadding is the default fraction of each band left empty around marks like bars.
+	DefaultCategoricalBandPadding = 0.2
+)
+
+// Interface Assertions.
+var (
+	_ Range         = (*CategoricalRange)(nil)
+	_ TicksProvider = (*CategoricalRange)(nil)
+)
+
+// CategoricalRange maps a set of string categories to evenly spaced bands across the domain.
+//
+// Series plotted against a categorical range use the index of the category as their x-value,
+// i.e. the first category is at 0, the second at 1 and so on, and values are translated
+// to the center of their band.
+//
+// Labels are placed between the band boundaries, so it should be used with `TickPositionBetweenTicks`
+// (which `Chart` will default to when the x-axis tick position is unset).
+type CategoricalRange struct {
+	Categories []string
+	Domain     int
+
+	// BandPadding is the fraction of each band left empty around marks like bars, on the interval [0, 1).
+	// Set it to `Disabled` to have marks fill the entire band.
+	BandPadding float64
+}
+
+// IsDescending returns if the range is descending.
+func (r CategoricalRange) IsDescending() bool {
+	return false
+}
+
+// IsZero returns if the CategoricalRange has been set or not.
+func (r CategoricalRange) IsZero() bool {
+	return len(r.Categories) == 0
+}
+
+// GetMin gets the min value for the range, or the left edge of the first band.
+func (r CategoricalRange) GetMin() float64 {
+	return -0.5
+}
+
+// SetMin is a no-op for categorical ranges; the bounds are determined by the categories.
+func (r *CategoricalRange) SetMin(min float64) {}
+
+// GetMax gets the max value for the range, or the right edge of the last band.
+func (r CategoricalRange) GetMax() float64 {
+	return float64(len(r.Categories)) - 0.5
+}
+
+// SetMax is a no-op for categorical ranges; the bounds are determined by the categories.
+func (r *CategoricalRange) SetMax(max float64) {}
+
+// GetDelta returns the difference between the min and max value.
+func (r CategoricalRange) GetDelta() float64 {
+	return r.GetMax() - r.GetMin()
+}
+
+// GetDomain returns the range domain.
+func (r CategoricalRange) GetDomain() int {
+	return r.Domain
+}
+
+// SetDomain sets the range domain.
+func (r *CategoricalRange) SetDomain(domain int) {
+	r.Domain = domain
+}
+
+// GetBandPadding returns the band padding or the default.
+func (r CategoricalRange) GetBandPadding() float64 {
+	if r.BandPadding == Disabled {
+		return 0
+	}
+	if r.BandPadding <= 0 || r.BandPadding >= 1 {
+		return DefaultCategoricalBandPadding
+	}
+	return r.BandPadding
+}
+
+// GetBandWidth returns the width in pixels of the drawable part of each band, i.e. less the band padding.
+func (r CategoricalRange) GetBandWidth() int {
+	if len(r.Categories) == 0 {
+		return 0
+	}
+	band := float64(r.Domain) / float64(len(r.Categories))
+	return int(math.Floor(band * (1.0 - r.GetBandPadding())))
+}
+
+// IndexOf returns the x-value for a given category, or -1 if the category isn't in the range.
+func (r CategoricalRange) IndexOf(category string) float64 {
+	for index, c := range r.Categories {
+		if c == category {
+			return float64(index)
+		}
+	}
+	return -1
+}
+
+// String returns a simple string for the CategoricalRange.
+func (r CategoricalRange) String() string {
+	return fmt.Sprintf("CategoricalRange [%s] => %d", strings.Join(r.Categories, ","), r.Domain)
+}
+
+// Translate maps a given value into the CategoricalRange space.
+func (r CategoricalRange) Translate(value float64) int {
+	delta := r.GetDelta()
+	if delta <= 0 {
+		return 0
+	}
+	ratio := (value - r.GetMin()) / delta
+	return int(math.Round(ratio * float64(r.Domain)))
+}
+
+// GetTicks returns a tick at each band boundary, labeled with the category to the left of it.
+func (r CategoricalRange) GetTicks(_ Renderer, _ Style, _ ValueFormatter) []Tick {
+	if len(r.Categories) == 0 {
+		return nil
+	}
+	ticks := []Tick{{Value: r.GetMin()}}
+	for index, category := range r.Categories {
+		ticks = append(ticks, Tick{
+			Value: float64(index) + 0.5,
+			Label: category,
+		})
+	}
+	return ticks
+}
@@ -0,0 +1,61 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestCategoricalRangeTranslate(t *testing.T) {
+	r := CategoricalRange{Categories: []string{"a", "b", "c", "d"}, Domain: 400}
+
+	testutil.AssertEqual(t, -0.5, r.GetMin())
+	testutil.AssertEqual(t, 3.5, r.GetMax())
+	testutil.AssertEqual(t, 0, r.Translate(r.GetMin()))
+	testutil.AssertEqual(t, 50, r.Translate(0))
+	testutil.AssertEqual(t, 150, r.Translate(1))
+	testutil.AssertEqual(t, 350, r.Translate(3))
+	testutil.AssertEqual(t, 400, r.Translate(r.GetMax()))
+}
+
+func TestCategoricalRangeSetMinMax(t *testing.T) {
+	r := &CategoricalRange{Categories: []string{"a", "b"}}
+	r.SetMin(-100)
+	r.SetMax(100)
+
+	testutil.AssertEqual(t, -0.5, r.GetMin())
+	testutil.AssertEqual(t, 1.5, r.GetMax())
+	testutil.AssertFalse(t, r.IsZero())
+	testutil.AssertTrue(t, (&CategoricalRange{}).IsZero())
+}
+
+func TestCategoricalRangeGetTicks(t *testing.T) {
+	r := CategoricalRange{Categories: []string{"a", "b", "c"}, Domain: 300}
+
+	ticks := r.GetTicks(nil, Style{}, nil)
+	testutil.AssertLen(t, ticks, 4)
+	testutil.AssertEqual(t, -0.5, ticks[0].Value)
+	testutil.AssertEmpty(t, ticks[0].Label)
+	testutil.AssertEqual(t, 0.5, ticks[1].Value)
+	testutil.AssertEqual(t, "a", ticks[1].Label)
+	testutil.AssertEqual(t, 2.5, ticks[3].Value)
+	testutil.AssertEqual(t, "c", ticks[3].Label)
+}
+
+func TestCategoricalRangeBandWidth(t *testing.T) {
+	r := CategoricalRange{Categories: []string{"a", "b", "c", "d"}, Domain: 400}
+	testutil.AssertEqual(t, DefaultCategoricalBandPadding, r.GetBandPadding())
+	testutil.AssertEqual(t, 80, r.GetBandWidth())
+
+	r.BandPadding = 0.5
+	testutil.AssertEqual(t, 50, r.GetBandWidth())
+
+	r.BandPadding = Disabled
+	testutil.AssertEqual(t, 100, r.GetBandWidth())
+}
+
+func TestCategoricalRangeIndexOf(t *testing.T) {
+	r := CategoricalRange{Categories: []string{"a", "b", "c"}}
+	testutil.AssertEqual(t, 1.0, r.IndexOf("b"))
+	testutil.AssertEqual(t, -1.0, r.IndexOf("z"))
+}
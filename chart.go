@@ -27,6 +27,14 @@ type Chart struct {
 	YAxis          YAxis
 	YAxisSecondary YAxis
 
+	// YAxes are additional y-axes beyond the primary and secondary.
+	// Series are mapped to them with `YAxisAdditional(index)`.
+	YAxes []YAxis
+
+	// Polar draws the series in polar coordinates; the x-axis wraps clockwise around a circle
+	// from twelve o'clock and the y-axis is the distance from its center.
+	Polar bool
+
 	Font        *truetype.Font
 	defaultFont *truetype.Font
 
@@ -81,6 +89,10 @@ func (c Chart) Render(rp RendererProvider, w io.Writer) error {
 	}
 
 	c.YAxisSecondary.AxisType = YAxisSecondary
+	c.YAxes = c.getAdditionalYAxes()
+	if _, isCategorical := c.XAxis.Range.(*CategoricalRange); isCategorical && c.XAxis.TickPosition == TickPositionUnset {
+		c.XAxis.TickPosition = TickPositionBetweenTicks
+	}
 
 	r, err := rp(c.GetWidth(), c.GetHeight())
 	if err != nil {
@@ -99,15 +111,26 @@ func (c Chart) Render(rp RendererProvider, w io.Writer) error {
 	c.drawBackground(r)
 
 	var xt, yt, yta []Tick
+	var yts [][]Tick
 	xr, yr, yra := c.getRanges()
+	yrs := c.getAdditionalRanges()
 	canvasBox := c.getDefaultCanvasBox()
 	xf, yf, yfa := c.getValueFormatters()
+	yfs := c.getAdditionalValueFormatters()
 
 	Debugf(c.Log, "chart; canvas box: %v", canvasBox)
 
+	if c.Polar {
+		return c.renderPolar(r, w, canvasBox, xr, yr, yra, yrs, xf, yf, yfa)
+	}
+
 	xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+	yrs = c.setAdditionalRangeDomains(canvasBox, yrs)
 
 	err = c.checkRanges(xr, yr, yra)
+	if err == nil {
+		err = c.checkAdditionalRanges(yrs)
+	}
 	if err != nil {
 		r.Save(w)
 		return err
@@ -115,29 +138,46 @@ func (c Chart) Render(rp RendererProvider, w io.Writer) error {
 
 	if c.hasAxes() {
 		xt, yt, yta = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
-		canvasBox = c.getAxesAdjustedCanvasBox(r, canvasBox, xr, yr, yra, xt, yt, yta)
+		yts = c.getAdditionalAxesTicks(r, yrs, yfs)
+		c.YAxis, c.YAxisSecondary, c.YAxes = c.stackYAxes(r, canvasBox, yr, yra, yrs, yt, yta, yts)
+		canvasBox = c.getAxesAdjustedCanvasBox(r, canvasBox, xr, yr, yra, yrs, xt, yt, yta, yts)
 		xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+		yrs = c.setAdditionalRangeDomains(canvasBox, yrs)
 
 		Debugf(c.Log, "chart; axes adjusted canvas box: %v", canvasBox)
 
 		// do a second pass in case things haven't settled yet.
 		xt, yt, yta = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
-		canvasBox = c.getAxesAdjustedCanvasBox(r, canvasBox, xr, yr, yra, xt, yt, yta)
+		yts = c.getAdditionalAxesTicks(r, yrs, yfs)
+		c.YAxis, c.YAxisSecondary, c.YAxes = c.stackYAxes(r, canvasBox, yr, yra, yrs, yt, yta, yts)
+		canvasBox = c.getAxesAdjustedCanvasBox(r, canvasBox, xr, yr, yra, yrs, xt, yt, yta, yts)
 		xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+		yrs = c.setAdditionalRangeDomains(canvasBox, yrs)
 	}
 
 	if c.hasAnnotationSeries() {
-		canvasBox = c.getAnnotationAdjustedCanvasBox(r, canvasBox, xr, yr, yra, xf, yf, yfa)
+		canvasBox = c.getAnnotationAdjustedCanvasBox(r, canvasBox, xr, yr, yra, yrs, xf, yf, yfa)
 		xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+		yrs = c.setAdditionalRangeDomains(canvasBox, yrs)
 		xt, yt, yta = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
+		yts = c.getAdditionalAxesTicks(r, yrs, yfs)
 
 		Debugf(c.Log, "chart; annotation adjusted canvas box: %v", canvasBox)
 	}
 
+	var annotations map[int][]annotationPlacement
+	if c.hasAnnotationSeries() {
+		annotations = c.getAnnotationPlacements(r, canvasBox, xr, yr, yra, yrs)
+	}
+
 	c.drawCanvas(r, canvasBox)
-	c.drawAxes(r, canvasBox, xr, yr, yra, xt, yt, yta)
+	c.drawAxes(r, canvasBox, xr, yr, yra, yrs, xt, yt, yta, yts)
 	for index, series := range c.Series {
-		c.drawSeries(r, canvasBox, xr, yr, yra, series, index)
+		if as, isAnnotationSeries := series.(AnnotationSeries); isAnnotationSeries {
+			as.renderPlacements(r, canvasBox, annotations[index])
+			continue
+		}
+		c.drawSeries(r, canvasBox, xr, yr, yra, yrs, series, index)
 	}
 
 	c.drawTitle(r)
@@ -208,6 +248,9 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 				seriesLength := vp.Len()
 				for index := 0; index < seriesLength; index++ {
 					vx, vy := vp.GetValues(index)
+					if math.IsNaN(vy) {
+						continue
+					}
 
 					minx = math.Min(minx, vx)
 					maxx = math.Max(maxx, vx)
@@ -222,6 +265,11 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 					}
 				}
 			}
+			if xbp, isXBoundsProvider := s.(xBoundsProvider); isXBoundsProvider {
+				vx1, vx2 := xbp.getXBounds()
+				minx = math.Min(minx, vx1)
+				maxx = math.Max(maxx, vx2)
+			}
 		}
 	}
 
@@ -302,6 +350,85 @@ func (c Chart) getRanges() (xrange, yrange, yrangeAlt Range) {
 	return
 }
 
+func (c Chart) getAdditionalYAxes() []YAxis {
+	if len(c.YAxes) == 0 {
+		return nil
+	}
+	yaxes := make([]YAxis, len(c.YAxes))
+	for index, ya := range c.YAxes {
+		ya.AxisType = YAxisAdditional(index)
+		yaxes[index] = ya
+	}
+	return yaxes
+}
+
+func (c Chart) getAdditionalRanges() []Range {
+	if len(c.YAxes) == 0 {
+		return nil
+	}
+
+	yranges := make([]Range, len(c.YAxes))
+	for index, ya := range c.YAxes {
+		var yrange Range
+		if ya.Range == nil {
+			yrange = &ContinuousRange{}
+		} else {
+			yrange = ya.Range
+		}
+
+		if len(ya.Ticks) > 0 {
+			tickMin, tickMax := math.MaxFloat64, -math.MaxFloat64
+			for _, t := range ya.Ticks {
+				tickMin = math.Min(tickMin, t.Value)
+				tickMax = math.Max(tickMax, t.Value)
+			}
+			yrange.SetMin(tickMin)
+			yrange.SetMax(tickMax)
+		} else if c.hasAdditionalSeries(index) && yrange.IsZero() {
+			miny, maxy := c.getSeriesYBounds(YAxisAdditional(index))
+			yrange.SetMin(miny)
+			yrange.SetMax(maxy)
+
+			if !ya.Style.Hidden {
+				delta := yrange.GetDelta()
+				roundTo := GetRoundToForDelta(delta)
+				rmin, rmax := RoundDown(yrange.GetMin(), roundTo), RoundUp(yrange.GetMax(), roundTo)
+				yrange.SetMin(rmin)
+				yrange.SetMax(rmax)
+			}
+		}
+		yranges[index] = yrange
+	}
+	return yranges
+}
+
+// getSeriesYBounds returns the minimum and maximum y-values of the visible series mapped to a given axis.
+func (c Chart) getSeriesYBounds(axis YAxisType) (miny, maxy float64) {
+	miny, maxy = math.MaxFloat64, -math.MaxFloat64
+	for _, s := range c.Series {
+		if s.GetStyle().Hidden || s.GetYAxis() != axis {
+			continue
+		}
+		if bvp, isBoundedValuesProvider := s.(BoundedValuesProvider); isBoundedValuesProvider {
+			for index := 0; index < bvp.Len(); index++ {
+				_, vy1, vy2 := bvp.GetBoundedValues(index)
+				miny = math.Min(miny, math.Min(vy1, vy2))
+				maxy = math.Max(maxy, math.Max(vy1, vy2))
+			}
+		} else if vp, isValuesProvider := s.(ValuesProvider); isValuesProvider {
+			for index := 0; index < vp.Len(); index++ {
+				_, vy := vp.GetValues(index)
+				if math.IsNaN(vy) {
+					continue
+				}
+				miny = math.Min(miny, vy)
+				maxy = math.Max(maxy, vy)
+			}
+		}
+	}
+	return
+}
+
 func (c Chart) checkRanges(xr, yr, yra Range) error {
 	Debugf(c.Log, "checking xrange: %v", xr)
 	xDelta := xr.GetDelta()
@@ -338,6 +465,23 @@ func (c Chart) checkRanges(xr, yr, yra Range) error {
 	return nil
 }
 
+func (c Chart) checkAdditionalRanges(yrs []Range) error {
+	for index, yr := range yrs {
+		if !c.hasAdditionalSeries(index) {
+			continue
+		}
+		Debugf(c.Log, "checking additional yrange %d: %v", index, yr)
+		yDelta := yr.GetDelta()
+		if math.IsInf(yDelta, 0) {
+			return fmt.Errorf("infinite additional y-range delta at index %d", index)
+		}
+		if math.IsNaN(yDelta) {
+			return fmt.Errorf("nan additional y-range delta at index %d", index)
+		}
+	}
+	return nil
+}
+
 func (c Chart) getDefaultCanvasBox() Box {
 	return c.Box()
 }
@@ -367,8 +511,36 @@ func (c Chart) getValueFormatters() (x, y, ya ValueFormatter) {
 	return
 }
 
+func (c Chart) getAdditionalValueFormatters() []ValueFormatter {
+	if len(c.YAxes) == 0 {
+		return nil
+	}
+	yfs := make([]ValueFormatter, len(c.YAxes))
+	for _, s := range c.Series {
+		if vfp, isVfp := s.(ValueFormatterProvider); isVfp {
+			if index, ok := s.GetYAxis().AdditionalIndex(); ok && index < len(yfs) {
+				_, yfs[index] = vfp.GetValueFormatters()
+			}
+		}
+	}
+	for index, ya := range c.YAxes {
+		if ya.ValueFormatter != nil {
+			yfs[index] = ya.GetValueFormatter()
+		}
+	}
+	return yfs
+}
+
 func (c Chart) hasAxes() bool {
-	return !c.XAxis.Style.Hidden || !c.YAxis.Style.Hidden || !c.YAxisSecondary.Style.Hidden
+	if !c.XAxis.Style.Hidden || !c.YAxis.Style.Hidden || !c.YAxisSecondary.Style.Hidden {
+		return true
+	}
+	for _, ya := range c.YAxes {
+		if !ya.Style.Hidden {
+			return true
+		}
+	}
+	return false
 }
 
 func (c Chart) getAxesTicks(r Renderer, xr, yr, yar Range, xf, yf, yfa ValueFormatter) (xticks, yticks, yticksAlt []Tick) {
@@ -384,7 +556,61 @@ func (c Chart) getAxesTicks(r Renderer, xr, yr, yar Range, xf, yf, yfa ValueForm
 	return
 }
 
-func (c Chart) getAxesAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr, yra Range, xticks, yticks, yticksAlt []Tick) Box {
+func (c Chart) getAdditionalAxesTicks(r Renderer, yrs []Range, yfs []ValueFormatter) [][]Tick {
+	if len(c.YAxes) == 0 {
+		return nil
+	}
+	yticks := make([][]Tick, len(c.YAxes))
+	for index, ya := range c.YAxes {
+		if c.isAdditionalYAxisVisible(index) {
+			yticks[index] = ya.GetTicks(r, yrs[index], c.styleDefaultsAxes(), yfs[index])
+		}
+	}
+	return yticks
+}
+
+// stackYAxes computes the offsets for y-axes that share a side of the canvas
+// so that they are drawn next to each other instead of on top of each other.
+// Axes are stacked outward from the canvas in the order primary, secondary, then additional.
+func (c Chart) stackYAxes(r Renderer, canvasBox Box, yr, yra Range, yrs []Range, yticks, yticksAlt []Tick, yticksAdditional [][]Tick) (primary, secondary YAxis, additional []YAxis) {
+	primary = c.YAxis
+	secondary = c.YAxisSecondary
+	if len(c.YAxes) > 0 {
+		additional = make([]YAxis, len(c.YAxes))
+		copy(additional, c.YAxes)
+	}
+
+	var offsetLeft, offsetRight int
+	stack := func(ya *YAxis, ra Range, ticks []Tick) {
+		ya.offset = 0
+		if len(ticks) == 0 {
+			return
+		}
+		axesBounds := ya.Measure(r, canvasBox, ra, c.styleDefaultsAxes(), ticks)
+		if ya.GetSide() == YAxisSideLeft {
+			ya.offset = offsetLeft
+			offsetLeft += (canvasBox.Left - axesBounds.Left) + DefaultYAxisMargin
+		} else {
+			ya.offset = offsetRight
+			offsetRight += (axesBounds.Right - canvasBox.Right) + DefaultYAxisMargin
+		}
+	}
+
+	if !primary.Style.Hidden {
+		stack(&primary, yr, yticks)
+	}
+	if !secondary.Style.Hidden && c.hasSecondarySeries() {
+		stack(&secondary, yra, yticksAlt)
+	}
+	for index := range additional {
+		if c.isAdditionalYAxisVisible(index) {
+			stack(&additional[index], yrs[index], yticksAdditional[index])
+		}
+	}
+	return
+}
+
+func (c Chart) getAxesAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr, yra Range, yrs []Range, xticks, yticks, yticksAlt []Tick, yticksAdditional [][]Tick) Box {
 	axesOuterBox := canvasBox.Clone()
 	if !c.XAxis.Style.Hidden {
 		axesBounds := c.XAxis.Measure(r, canvasBox, xr, c.styleDefaultsAxes(), xticks)
@@ -401,6 +627,13 @@ func (c Chart) getAxesAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr, yra R
 		Debugf(c.Log, "chart; y-axis secondary measured %v", axesBounds)
 		axesOuterBox = axesOuterBox.Grow(axesBounds)
 	}
+	for index, ya := range c.YAxes {
+		if c.isAdditionalYAxisVisible(index) {
+			axesBounds := ya.Measure(r, canvasBox, yrs[index], c.styleDefaultsAxes(), yticksAdditional[index])
+			Debugf(c.Log, "chart; y-axis additional %d measured %v", index, axesBounds)
+			axesOuterBox = axesOuterBox.Grow(axesBounds)
+		}
+	}
 
 	return canvasBox.OuterConstrain(c.Box(), axesOuterBox)
 }
@@ -412,6 +645,13 @@ func (c Chart) setRangeDomains(canvasBox Box, xr, yr, yra Range) (Range, Range,
 	return xr, yr, yra
 }
 
+func (c Chart) setAdditionalRangeDomains(canvasBox Box, yrs []Range) []Range {
+	for _, yr := range yrs {
+		yr.SetDomain(canvasBox.Height())
+	}
+	return yrs
+}
+
 func (c Chart) hasAnnotationSeries() bool {
 	for _, s := range c.Series {
 		if as, isAnnotationSeries := s.(AnnotationSeries); isAnnotationSeries {
@@ -432,25 +672,62 @@ func (c Chart) hasSecondarySeries() bool {
 	return false
 }
 
-func (c Chart) getAnnotationAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr, yra Range, xf, yf, yfa ValueFormatter) Box {
+func (c Chart) hasAdditionalSeries(index int) bool {
+	for _, s := range c.Series {
+		if seriesIndex, ok := s.GetYAxis().AdditionalIndex(); ok && seriesIndex == index {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Chart) isAdditionalYAxisVisible(index int) bool {
+	return !c.YAxes[index].Style.Hidden && c.hasAdditionalSeries(index)
+}
+
+func (c Chart) getAnnotationAdjustedCanvasBox(r Renderer, canvasBox Box, xr, yr, yra Range, yrs []Range, xf, yf, yfa ValueFormatter) Box {
 	annotationSeriesBox := canvasBox.Clone()
-	for seriesIndex, s := range c.Series {
-		if as, isAnnotationSeries := s.(AnnotationSeries); isAnnotationSeries {
-			if !as.GetStyle().Hidden {
-				style := c.styleDefaultsSeries(seriesIndex)
-				var annotationBounds Box
-				if as.YAxis == YAxisPrimary {
-					annotationBounds = as.Measure(r, canvasBox, xr, yr, style)
-				} else if as.YAxis == YAxisSecondary {
-					annotationBounds = as.Measure(r, canvasBox, xr, yra, style)
-				}
+	for _, placements := range c.getAnnotationPlacements(r, canvasBox, xr, yr, yra, yrs) {
+		for _, p := range placements {
+			annotationSeriesBox = annotationSeriesBox.Grow(p.Measure(r, canvasBox))
+		}
+	}
 
-				annotationSeriesBox = annotationSeriesBox.Grow(annotationBounds)
+	return canvasBox.OuterConstrain(c.Box(), annotationSeriesBox)
+}
+
+// getAnnotationPlacements positions the annotations of every visible annotation series, by series index,
+// laying them out together so annotations from different series don't overlap.
+func (c Chart) getAnnotationPlacements(r Renderer, canvasBox Box, xr, yr, yra Range, yrs []Range) map[int][]annotationPlacement {
+	var all []annotationPlacement
+	var owners []int
+	for seriesIndex, s := range c.Series {
+		if as, isAnnotationSeries := s.(AnnotationSeries); isAnnotationSeries && !as.GetStyle().Hidden {
+			var yrange Range
+			if as.YAxis == YAxisPrimary {
+				yrange = yr
+			} else if as.YAxis == YAxisSecondary {
+				yrange = yra
+			} else if index, ok := as.YAxis.AdditionalIndex(); ok && index < len(yrs) {
+				yrange = yrs[index]
+			}
+			if yrange == nil {
+				continue
+			}
+			for _, p := range as.getPlacements(canvasBox, xr, yrange, c.styleDefaultsSeries(seriesIndex)) {
+				all = append(all, p)
+				owners = append(owners, seriesIndex)
 			}
 		}
 	}
 
-	return canvasBox.OuterConstrain(c.Box(), annotationSeriesBox)
+	layoutAnnotations(r, canvasBox, all)
+
+	placements := map[int][]annotationPlacement{}
+	for index, p := range all {
+		placements[owners[index]] = append(placements[owners[index]], p)
+	}
+	return placements
 }
 
 func (c Chart) getBackgroundStyle() Style {
@@ -472,7 +749,7 @@ func (c Chart) drawCanvas(r Renderer, canvasBox Box) {
 	Draw.Box(r, canvasBox, c.getCanvasStyle())
 }
 
-func (c Chart) drawAxes(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, xticks, yticks, yticksAlt []Tick) {
+func (c Chart) drawAxes(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, yrangesAdditional []Range, xticks, yticks, yticksAlt []Tick, yticksAdditional [][]Tick) {
 	if !c.XAxis.Style.Hidden {
 		c.XAxis.Render(r, canvasBox, xrange, c.styleDefaultsAxes(), xticks)
 	}
@@ -482,18 +759,43 @@ func (c Chart) drawAxes(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Ran
 	if !c.YAxisSecondary.Style.Hidden {
 		c.YAxisSecondary.Render(r, canvasBox, yrangeAlt, c.styleDefaultsAxes(), yticksAlt)
 	}
+	for index, ya := range c.YAxes {
+		if c.isAdditionalYAxisVisible(index) {
+			ya.Render(r, canvasBox, yrangesAdditional[index], c.styleDefaultsAxes(), yticksAdditional[index])
+		}
+	}
 }
 
-func (c Chart) drawSeries(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, s Series, seriesIndex int) {
+func (c Chart) drawSeries(r Renderer, canvasBox Box, xrange, yrange, yrangeAlt Range, yrangesAdditional []Range, s Series, seriesIndex int) {
 	if !s.GetStyle().Hidden {
 		if s.GetYAxis() == YAxisPrimary {
 			s.Render(r, canvasBox, xrange, yrange, c.styleDefaultsSeries(seriesIndex))
 		} else if s.GetYAxis() == YAxisSecondary {
 			s.Render(r, canvasBox, xrange, yrangeAlt, c.styleDefaultsSeries(seriesIndex))
+		} else if index, ok := s.GetYAxis().AdditionalIndex(); ok && index < len(yrangesAdditional) {
+			s.Render(r, canvasBox, xrange, yrangesAdditional[index], c.styleDefaultsSeries(seriesIndex))
 		}
 	}
 }
 
+// getSeriesRanges returns the ranges a series is drawn with in the canvas box, for elements
+// that need to lay out a series the way it was drawn.
+func (c Chart) getSeriesRanges(canvasBox Box, s Series) (xrange, yrange Range) {
+	c.YAxisSecondary.AxisType = YAxisSecondary
+	c.YAxes = c.getAdditionalYAxes()
+
+	xr, yr, yra := c.getRanges()
+	xr, yr, yra = c.setRangeDomains(canvasBox, xr, yr, yra)
+	yrs := c.setAdditionalRangeDomains(canvasBox, c.getAdditionalRanges())
+
+	if s.GetYAxis() == YAxisSecondary {
+		return xr, yra
+	} else if index, ok := s.GetYAxis().AdditionalIndex(); ok && index < len(yrs) {
+		return xr, yrs[index]
+	}
+	return xr, yr
+}
+
 func (c Chart) drawTitle(r Renderer) {
 	if len(c.Title) > 0 && !c.TitleStyle.Hidden {
 		r.SetFont(c.TitleStyle.GetFont(c.GetFont()))
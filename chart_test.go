@@ -592,3 +592,95 @@ func Test_Chart_cve(t *testing.T) {
 	err := poc.Render(PNG, &imgContent)
 	testutil.AssertNotNil(t, err)
 }
+
+func TestChartAdditionalYAxes(t *testing.T) {
+	c := Chart{
+		YAxes: []YAxis{
+			{Name: "Latency"},
+			{Name: "Requests", Side: YAxisSideLeft},
+		},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: LinearRange(1, 5),
+				YValues: LinearRange(1, 5),
+			},
+			ContinuousSeries{
+				YAxis:   YAxisSecondary,
+				XValues: LinearRange(1, 5),
+				YValues: LinearRange(10, 50),
+			},
+			ContinuousSeries{
+				YAxis:   YAxisAdditional(0),
+				XValues: LinearRange(1, 5),
+				YValues: []float64{100, 200, 300, 400, 500},
+			},
+			ContinuousSeries{
+				YAxis:   YAxisAdditional(1),
+				XValues: LinearRange(1, 5),
+				YValues: []float64{5000, 4000, 3000, 2000, 1000},
+			},
+		},
+	}
+
+	yrs := c.getAdditionalRanges()
+	testutil.AssertLen(t, yrs, 2)
+	testutil.AssertEqual(t, 100.0, yrs[0].GetMin())
+	testutil.AssertEqual(t, 500.0, yrs[0].GetMax())
+	testutil.AssertEqual(t, 1000.0, yrs[1].GetMin())
+	testutil.AssertEqual(t, 5000.0, yrs[1].GetMax())
+
+	buffer := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, c.Render(PNG, buffer))
+	testutil.AssertNotZero(t, buffer.Len())
+}
+
+func TestChartStackYAxes(t *testing.T) {
+	r, err := PNG(1024, 400)
+	testutil.AssertNil(t, err)
+
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	c := Chart{
+		Font:           f,
+		YAxisSecondary: YAxis{AxisType: YAxisSecondary},
+		YAxes: []YAxis{
+			{AxisType: YAxisAdditional(0)},
+			{AxisType: YAxisAdditional(1), Side: YAxisSideLeft},
+		},
+		Series: []Series{
+			ContinuousSeries{YAxis: YAxisSecondary},
+			ContinuousSeries{YAxis: YAxisAdditional(0)},
+			ContinuousSeries{YAxis: YAxisAdditional(1)},
+		},
+	}
+
+	canvasBox := NewBox(50, 100, 900, 350)
+	ticks := []Tick{{Value: 1.0, Label: "1.0"}, {Value: 2.0, Label: "2.0"}}
+	yr := &ContinuousRange{Min: 1.0, Max: 2.0, Domain: canvasBox.Height()}
+
+	primary, secondary, additional := c.stackYAxes(r, canvasBox, yr, yr, []Range{yr, yr}, ticks, ticks, [][]Tick{ticks, ticks})
+	testutil.AssertZero(t, primary.offset)
+	testutil.AssertZero(t, secondary.offset)
+	testutil.AssertLen(t, additional, 2)
+	testutil.AssertNotZero(t, additional[0].offset)
+	testutil.AssertNotZero(t, additional[1].offset)
+
+	// the first additional axis should start past the primary axis labels.
+	primaryBounds := primary.Measure(r, canvasBox, yr, c.styleDefaultsAxes(), ticks)
+	testutil.AssertTrue(t, canvasBox.Right+additional[0].offset > primaryBounds.Right)
+
+	// the user supplied axes should not be modified.
+	testutil.AssertZero(t, c.YAxes[0].offset)
+}
+
+func TestYAxisTypeAdditionalIndex(t *testing.T) {
+	_, ok := YAxisPrimary.AdditionalIndex()
+	testutil.AssertFalse(t, ok)
+	_, ok = YAxisSecondary.AdditionalIndex()
+	testutil.AssertFalse(t, ok)
+
+	index, ok := YAxisAdditional(3).AdditionalIndex()
+	testutil.AssertTrue(t, ok)
+	testutil.AssertEqual(t, 3, index)
+}
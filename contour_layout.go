@@ -0,0 +1,177 @@
+package chart
+
+import "math"
+
+// contourPoint is a point of a contour line in data coordinates.
+type contourPoint struct {
+	X, Y float64
+}
+
+// contourVertex is a vertex of a cell of the grid, or of a band clipped from one, with its value.
+type contourVertex struct {
+	X, Y, Value float64
+}
+
+// contourEdge is an edge between two neighbouring points of the grid; the horizontal edge of a row and column
+// runs to the next column and the vertical edge to the next row.
+type contourEdge struct {
+	Row, Column int
+	Vertical    bool
+}
+
+// contourSegment is the part of a contour line that crosses a cell, between two of its edges.
+type contourSegment struct {
+	From, To contourEdge
+}
+
+// contourCell returns the corners of a cell of the grid counter-clockwise from the first row and column,
+// or false if a corner is missing from a ragged grid or is NaN.
+func contourCell(xs, ys []float64, values [][]float64, row, column int) ([4]contourVertex, bool) {
+	if row+1 >= len(values) || column+1 >= len(values[row]) || column+1 >= len(values[row+1]) {
+		return [4]contourVertex{}, false
+	}
+	corners := [4]contourVertex{
+		{X: xs[column], Y: ys[row], Value: values[row][column]},
+		{X: xs[column+1], Y: ys[row], Value: values[row][column+1]},
+		{X: xs[column+1], Y: ys[row+1], Value: values[row+1][column+1]},
+		{X: xs[column], Y: ys[row+1], Value: values[row+1][column]},
+	}
+	for _, corner := range corners {
+		if math.IsNaN(corner.Value) {
+			return corners, false
+		}
+	}
+	return corners, true
+}
+
+// contourLines returns the contour lines of a level through the grid as polylines, using marching squares;
+// a closed line ends at its start.
+func contourLines(xs, ys []float64, values [][]float64, level float64) [][]contourPoint {
+	points := map[contourEdge]contourPoint{}
+	var segments []contourSegment
+
+	for row := 0; row < len(ys)-1; row++ {
+		for column := 0; column < len(xs)-1; column++ {
+			corners, ok := contourCell(xs, ys, values, row, column)
+			if !ok {
+				continue
+			}
+			// edge n runs from corner n to the next corner.
+			edges := [4]contourEdge{
+				{Row: row, Column: column},
+				{Row: row, Column: column + 1, Vertical: true},
+				{Row: row + 1, Column: column},
+				{Row: row, Column: column, Vertical: true},
+			}
+
+			var crossed []int
+			for edge := range edges {
+				from, to := corners[edge], corners[(edge+1)%4]
+				if (from.Value >= level) == (to.Value >= level) {
+					continue
+				}
+				crossed = append(crossed, edge)
+				if _, ok := points[edges[edge]]; !ok {
+					t := (level - from.Value) / (to.Value - from.Value)
+					points[edges[edge]] = contourPoint{X: from.X + t*(to.X-from.X), Y: from.Y + t*(to.Y-from.Y)}
+				}
+			}
+
+			switch len(crossed) {
+			case 2:
+				segments = append(segments, contourSegment{From: edges[crossed[0]], To: edges[crossed[1]]})
+			case 4:
+				// a saddle; the value at the center decides which opposite corners are joined.
+				center := (corners[0].Value + corners[1].Value + corners[2].Value + corners[3].Value) / 4
+				if (center >= level) == (corners[0].Value >= level) {
+					segments = append(segments,
+						contourSegment{From: edges[0], To: edges[1]},
+						contourSegment{From: edges[2], To: edges[3]},
+					)
+				} else {
+					segments = append(segments,
+						contourSegment{From: edges[3], To: edges[0]},
+						contourSegment{From: edges[1], To: edges[2]},
+					)
+				}
+			}
+		}
+	}
+
+	// the segments are joined into lines through the edges they share.
+	byEdge := map[contourEdge][]int{}
+	for index, segment := range segments {
+		byEdge[segment.From] = append(byEdge[segment.From], index)
+		byEdge[segment.To] = append(byEdge[segment.To], index)
+	}
+	used := make([]bool, len(segments))
+	extend := func(chain []contourEdge) []contourEdge {
+		for {
+			last := chain[len(chain)-1]
+			next := -1
+			for _, index := range byEdge[last] {
+				if !used[index] {
+					next = index
+					break
+				}
+			}
+			if next < 0 {
+				return chain
+			}
+			used[next] = true
+			if segments[next].From == last {
+				chain = append(chain, segments[next].To)
+			} else {
+				chain = append(chain, segments[next].From)
+			}
+		}
+	}
+
+	var lines [][]contourPoint
+	for index, segment := range segments {
+		if used[index] {
+			continue
+		}
+		used[index] = true
+		chain := extend([]contourEdge{segment.From, segment.To})
+		for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+			chain[i], chain[j] = chain[j], chain[i]
+		}
+		chain = extend(chain)
+
+		line := make([]contourPoint, len(chain))
+		for i, edge := range chain {
+			line[i] = points[edge]
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// clipContourPolygon clips a polygon to where its linearly interpolated values are at least the level, or at most
+// the level if not above.
+func clipContourPolygon(polygon []contourVertex, level float64, above bool) []contourVertex {
+	inside := func(v contourVertex) bool {
+		if above {
+			return v.Value >= level
+		}
+		return v.Value <= level
+	}
+
+	var clipped []contourVertex
+	for index, current := range polygon {
+		previous := polygon[(index+len(polygon)-1)%len(polygon)]
+		if inside(current) != inside(previous) {
+			t := (level - previous.Value) / (current.Value - previous.Value)
+			clipped = append(clipped, contourVertex{
+				X:     previous.X + t*(current.X-previous.X),
+				Y:     previous.Y + t*(current.Y-previous.Y),
+				Value: level,
+			})
+		}
+		if inside(current) {
+			clipped = append(clipped, current)
+		}
+	}
+	return clipped
+}
@@ -0,0 +1,374 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// Interface Assertions.
+var (
+	_ Series         = (*ContourSeries)(nil)
+	_ ValuesProvider = (*ContourSeries)(nil)
+)
+
+// ContourSeries draws the contour lines of values on a regular grid and, optionally, fills the bands between them.
+//
+// The lines are found with marching squares, interpolating linearly along the edges of each cell of the grid.
+type ContourSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// XValues and YValues are the coordinates of the columns and rows of the grid, in order.
+	XValues []float64
+	YValues []float64
+	// Values are the values of the grid; Values[row][column] is the value at YValues[row] and XValues[column].
+	// Cells with a NaN corner are left out.
+	Values [][]float64
+
+	// Levels are the values of the contour lines; if unset about `LevelCount` round levels are chosen.
+	Levels     []float64
+	LevelCount int
+
+	// Filled fills the bands between the levels with colors from the `ColorProvider`, which defaults to `Viridis`;
+	// the colors are spread evenly over the bands, lowest first, whatever their values.
+	// If the series isn't filled and the `ColorProvider` is set, it colors the lines by level instead.
+	Filled        bool
+	ColorProvider ColorProvider
+
+	// LabelStyle is the style of the labels of the contour lines; hide it to leave the lines unlabeled.
+	LabelStyle     Style
+	ValueFormatter ValueFormatter
+}
+
+// GetName returns the name of the series.
+func (cs ContourSeries) GetName() string {
+	return cs.Name
+}
+
+// GetStyle returns the series style.
+func (cs ContourSeries) GetStyle() Style {
+	return cs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (cs ContourSeries) GetYAxis() YAxisType {
+	return cs.YAxis
+}
+
+// Len returns the number of points of the grid.
+func (cs ContourSeries) Len() int {
+	return len(cs.XValues) * len(cs.YValues)
+}
+
+// GetValues returns the coordinates of a point of the grid, row by row.
+func (cs ContourSeries) GetValues(index int) (x, y float64) {
+	return cs.XValues[index%len(cs.XValues)], cs.YValues[index/len(cs.XValues)]
+}
+
+// GetLevelCount returns the approximate number of levels or the default.
+func (cs ContourSeries) GetLevelCount() int {
+	if cs.LevelCount <= 0 {
+		return DefaultContourLevelCount
+	}
+	return cs.LevelCount
+}
+
+// GetValueFormatter returns the formatter of the levels or the default.
+func (cs ContourSeries) GetValueFormatter() ValueFormatter {
+	if cs.ValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return cs.ValueFormatter
+}
+
+// GetBounds returns the smallest and largest values of the grid, ignoring NaNs and values past its xvalues
+// and yvalues.
+func (cs ContourSeries) GetBounds() (min, max float64) {
+	min, max = math.Inf(1), math.Inf(-1)
+	for rowIndex, row := range cs.Values {
+		if rowIndex >= len(cs.YValues) {
+			break
+		}
+		for column, value := range row {
+			if column < len(cs.XValues) && !math.IsNaN(value) {
+				min, max = math.Min(min, value), math.Max(max, value)
+			}
+		}
+	}
+	if min > max {
+		return 0, 0
+	}
+	return
+}
+
+// GetLevels returns the levels in order; if unset they are the multiples of a round step within the values.
+func (cs ContourSeries) GetLevels() []float64 {
+	if len(cs.Levels) > 0 {
+		levels := append([]float64{}, cs.Levels...)
+		sort.Float64s(levels)
+		return levels
+	}
+
+	min, max := cs.GetBounds()
+	if max <= min {
+		return nil
+	}
+	step := niceStep((max - min) / float64(cs.GetLevelCount()))
+	var levels []float64
+	for multiple := math.Floor(min/step) + 1; multiple*step < max; multiple++ {
+		levels = append(levels, multiple*step)
+	}
+	return levels
+}
+
+// getBands returns the bounds of the bands; the smallest value, the levels within the values and the largest value.
+func (cs ContourSeries) getBands() []float64 {
+	min, max := cs.GetBounds()
+	bands := []float64{min}
+	for _, level := range cs.GetLevels() {
+		if level > min && level < max {
+			bands = append(bands, level)
+		}
+	}
+	return append(bands, max)
+}
+
+// getColor returns the color of the nth of a number of bands or levels.
+func (cs ContourSeries) getColor(index, count int) drawing.Color {
+	vmax := float64(MaxInt(count-1, 1))
+	if cs.ColorProvider != nil {
+		return cs.ColorProvider(float64(index), 0, vmax)
+	}
+	return Viridis(float64(index), 0, vmax)
+}
+
+// Render renders the series.
+func (cs ContourSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if len(cs.XValues) < 2 || len(cs.YValues) < 2 {
+		return
+	}
+	translate := func(x, y float64) (int, int) {
+		return canvasBox.Left + xrange.Translate(x), canvasBox.Bottom - yrange.Translate(y)
+	}
+
+	if cs.Filled {
+		cs.drawBands(r, translate)
+	}
+
+	lineStyle := cs.Style.InheritFrom(defaults)
+	if cs.Filled {
+		lineStyle = cs.Style.InheritFrom(Style{
+			StrokeColor: ColorBlack.WithAlpha(DefaultContourLineAlpha),
+			StrokeWidth: DefaultAxisLineWidth,
+		})
+	}
+	labelStyle := cs.LabelStyle.InheritFrom(Style{
+		Font:      defaults.GetFont(),
+		FontColor: DefaultTextColor,
+		FontSize:  8.0,
+		FillColor: ColorWhite.WithAlpha(192),
+	})
+
+	var labels []Box
+	levels := cs.GetLevels()
+	for index, level := range levels {
+		style := lineStyle
+		if !cs.Filled && cs.ColorProvider != nil && cs.Style.StrokeColor.IsZero() {
+			style.StrokeColor = cs.getColor(index, len(levels))
+		}
+
+		for _, line := range contourLines(cs.XValues, cs.YValues, cs.Values, level) {
+			xs, ys := make([]int, len(line)), make([]int, len(line))
+			style.GetStrokeOptions().WriteToRenderer(r)
+			for index, point := range line {
+				xs[index], ys[index] = translate(point.X, point.Y)
+				if index == 0 {
+					r.MoveTo(xs[index], ys[index])
+				} else {
+					r.LineTo(xs[index], ys[index])
+				}
+			}
+			r.Stroke()
+			r.ResetStyle()
+
+			if !labelStyle.Hidden {
+				if label, ok := cs.drawLabel(r, xs, ys, cs.GetValueFormatter()(level), labels, labelStyle); ok {
+					labels = append(labels, label)
+				}
+			}
+		}
+	}
+}
+
+// drawBands fills the bands between the levels cell by cell, clipping each cell to the bands it spans.
+func (cs ContourSeries) drawBands(r Renderer, translate func(x, y float64) (int, int)) {
+	bands := cs.getBands()
+	for row := 0; row < len(cs.YValues)-1; row++ {
+		for column := 0; column < len(cs.XValues)-1; column++ {
+			corners, ok := contourCell(cs.XValues, cs.YValues, cs.Values, row, column)
+			if !ok {
+				continue
+			}
+			low, high := math.Inf(1), math.Inf(-1)
+			for _, corner := range corners {
+				low, high = math.Min(low, corner.Value), math.Max(high, corner.Value)
+			}
+
+			for band := 0; band < len(bands)-1; band++ {
+				if bands[band+1] < low || bands[band] > high {
+					continue
+				}
+				polygon := corners[:]
+				if band > 0 {
+					polygon = clipContourPolygon(polygon, bands[band], true)
+				}
+				if band < len(bands)-2 {
+					polygon = clipContourPolygon(polygon, bands[band+1], false)
+				}
+				if len(polygon) < 3 {
+					continue
+				}
+
+				// the band is stroked in its own color as well so neighbouring cells leave no seams.
+				color := cs.getColor(band, len(bands)-1)
+				r.SetFillColor(color)
+				r.SetStrokeColor(color)
+				r.SetStrokeWidth(DefaultAxisLineWidth)
+				for index, vertex := range polygon {
+					x, y := translate(vertex.X, vertex.Y)
+					if index == 0 {
+						r.MoveTo(x, y)
+					} else {
+						r.LineTo(x, y)
+					}
+				}
+				r.Close()
+				r.FillStroke()
+				r.ResetStyle()
+			}
+		}
+	}
+}
+
+// drawLabel draws the label of a contour line halfway along it, unless the line is too short or the label would
+// overlap one already drawn, and returns the box of the label.
+func (cs ContourSeries) drawLabel(r Renderer, xs, ys []int, label string, drawn []Box, style Style) (Box, bool) {
+	var length float64
+	for index := 1; index < len(xs); index++ {
+		length += math.Hypot(float64(xs[index]-xs[index-1]), float64(ys[index]-ys[index-1]))
+	}
+	if length < DefaultContourLabelMinLength {
+		return Box{}, false
+	}
+
+	// find the point halfway along the line.
+	x, y := float64(xs[0]), float64(ys[0])
+	remaining := length / 2
+	for index := 1; index < len(xs); index++ {
+		segment := math.Hypot(float64(xs[index]-xs[index-1]), float64(ys[index]-ys[index-1]))
+		if segment >= remaining {
+			t := remaining / segment
+			x = float64(xs[index-1]) + t*float64(xs[index]-xs[index-1])
+			y = float64(ys[index-1]) + t*float64(ys[index]-ys[index-1])
+			break
+		}
+		remaining -= segment
+	}
+
+	tb := Draw.MeasureText(r, label, style)
+	box := Box{
+		Top:    int(y) - tb.Height()>>1 - DefaultContourLabelPadding,
+		Left:   int(x) - tb.Width()>>1 - DefaultContourLabelPadding,
+		Right:  int(x) + tb.Width()>>1 + DefaultContourLabelPadding,
+		Bottom: int(y) + tb.Height()>>1 + DefaultContourLabelPadding,
+	}
+	for _, other := range drawn {
+		if box.Intersects(other) {
+			return Box{}, false
+		}
+	}
+
+	Draw.Box(r, box, Style{FillColor: style.GetFillColor()})
+	Draw.Text(r, label, box.Left+DefaultContourLabelPadding, box.Bottom-DefaultContourLabelPadding, style)
+	return box, true
+}
+
+// Validate validates the series.
+func (cs ContourSeries) Validate() error {
+	if len(cs.XValues) < 2 || len(cs.YValues) < 2 {
+		return fmt.Errorf("contour series; must have at least two xvalues and yvalues")
+	}
+	if len(cs.Values) != len(cs.YValues) {
+		return fmt.Errorf("contour series; must have a row of values for each yvalue")
+	}
+	for index, row := range cs.Values {
+		if len(row) != len(cs.XValues) {
+			return fmt.Errorf("contour series; row %d must have a value for each xvalue", index)
+		}
+	}
+	return nil
+}
+
+// ContourColorBar returns a legend renderable function that draws the colors of the bands of a contour series
+// as a bar of evenly sized blocks along the left edge of the chart with the levels on its right, so it is meant
+// to be drawn in the left padding of the chart.
+func ContourColorBar(cs ContourSeries, userDefaults ...Style) Renderable {
+	return func(r Renderer, cb Box, chartDefaults Style) {
+		bands := cs.getBands()
+		if bands[0] >= bands[len(bands)-1] {
+			return
+		}
+
+		legendDefaults := Style{
+			FontColor:   DefaultTextColor,
+			FontSize:    8.0,
+			StrokeColor: DefaultAxisColor,
+			StrokeWidth: DefaultAxisLineWidth,
+		}
+		var legendStyle Style
+		if len(userDefaults) > 0 {
+			legendStyle = userDefaults[0].InheritFrom(chartDefaults.InheritFrom(legendDefaults))
+		} else {
+			legendStyle = chartDefaults.InheritFrom(legendDefaults)
+		}
+
+		bar := Box{
+			Top:    cb.Top,
+			Left:   5,
+			Right:  5 + DefaultContourColorBarWidth,
+			Bottom: cb.Bottom,
+		}
+		translate := func(bound int) int {
+			return bar.Bottom - bar.Height()*bound/(len(bands)-1)
+		}
+
+		for band := 0; band < len(bands)-1; band++ {
+			color := cs.getColor(band, len(bands)-1)
+			Draw.Box(r, Box{
+				Top:    translate(band + 1),
+				Left:   bar.Left,
+				Right:  bar.Right,
+				Bottom: translate(band),
+			}, Style{FillColor: color, StrokeColor: color, StrokeWidth: DefaultAxisLineWidth})
+		}
+		Draw.Box(r, bar, Style{StrokeColor: legendStyle.GetStrokeColor(), StrokeWidth: legendStyle.GetStrokeWidth()})
+
+		// labels that would overlap the one below them are skipped.
+		vf := cs.GetValueFormatter()
+		lastTop := math.MaxInt32
+		for bound, value := range bands {
+			label := vf(value)
+			tb := Draw.MeasureText(r, label, legendStyle)
+			y := translate(bound)
+			if y+tb.Height()>>1 > lastTop-DefaultMinimumTickVerticalSpacing {
+				continue
+			}
+			Draw.Text(r, label, bar.Right+DefaultContourLabelPadding*2, y+tb.Height()>>1, legendStyle)
+			lastTop = y - tb.Height()>>1
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestContourSeries(t *testing.T) {
+	for _, filled := range []bool{false, true} {
+		cs := ContourSeries{
+			XValues: []float64{0, 1, 2, 3, 4},
+			YValues: []float64{0, 1, 2, 3, 4},
+			Values: [][]float64{
+				{math.NaN(), 5, 6, 5, 2},
+				{5, 8, 9, 8, 5},
+				{6, 9, 10, 9, 6},
+				{5, 8, 9, 8, 5},
+				{2, 5, 6, 5, 2},
+			},
+			Filled: filled,
+		}
+		graph := Chart{
+			Background: Style{
+				Padding: Box{Left: 60},
+			},
+			Series:   []Series{cs},
+			Elements: []Renderable{ContourColorBar(cs)},
+		}
+		testutil.AssertNil(t, graph.Render(PNG, bytes.NewBuffer([]byte{})))
+		testutil.AssertNil(t, graph.Render(SVG, bytes.NewBuffer([]byte{})))
+
+		// the ranges cover the grid, and the cell with the NaN corner is skipped.
+		xrange, yrange, _ := graph.getRanges()
+		testutil.AssertEqual(t, 0.0, xrange.GetMin())
+		testutil.AssertEqual(t, 4.0, xrange.GetMax())
+		testutil.AssertEqual(t, 0.0, yrange.GetMin())
+		testutil.AssertEqual(t, 4.0, yrange.GetMax())
+		_, ok := contourCell(cs.XValues, cs.YValues, cs.Values, 0, 0)
+		testutil.AssertFalse(t, ok)
+		_, ok = contourCell(cs.XValues, cs.YValues, cs.Values, 0, 1)
+		testutil.AssertTrue(t, ok)
+
+		// the bands are colored from the start to the end of the color provider.
+		bands := cs.getBands()
+		testutil.AssertEqual(t, []float64{2, 3, 4, 5, 6, 7, 8, 9, 10}, bands)
+		testutil.AssertEqual(t, Viridis(0, 0, 1), cs.getColor(0, len(bands)-1))
+		testutil.AssertEqual(t, Viridis(1, 0, 1), cs.getColor(len(bands)-2, len(bands)-1))
+	}
+}
+
+func TestContourSeriesGetLevels(t *testing.T) {
+	cs := ContourSeries{
+		XValues: []float64{0, 1, 2, 3, 4},
+		YValues: []float64{0, 1, 2, 3, 4},
+		Values: [][]float64{
+			{2, 5, 6, 5, 2},
+			{5, 8, 9, 8, 5},
+			{6, 9, 10, 9, 6},
+			{5, 8, 9, 8, 5},
+			{2, 5, 6, 5, 2},
+		},
+	}
+	min, max := cs.GetBounds()
+	testutil.AssertEqual(t, 2.0, min)
+	testutil.AssertEqual(t, 10.0, max)
+	testutil.AssertEqual(t, []float64{3, 4, 5, 6, 7, 8, 9}, cs.GetLevels())
+
+	cs.LevelCount = 4
+	testutil.AssertEqual(t, []float64{4, 6, 8}, cs.GetLevels())
+
+	cs.Levels = []float64{8, 5, 20}
+	testutil.AssertEqual(t, []float64{5, 8, 20}, cs.GetLevels())
+	// levels outside of the values don't bound a band.
+	testutil.AssertEqual(t, []float64{2, 5, 8, 10}, cs.getBands())
+}
+
+func TestContourLines(t *testing.T) {
+	cs := ContourSeries{
+		XValues: []float64{0, 1, 2, 3, 4},
+		YValues: []float64{0, 1, 2, 3, 4},
+		Values: [][]float64{
+			{2, 5, 6, 5, 2},
+			{5, 8, 9, 8, 5},
+			{6, 9, 10, 9, 6},
+			{5, 8, 9, 8, 5},
+			{2, 5, 6, 5, 2},
+		},
+	}
+
+	// the peak is circled by a single closed line.
+	lines := contourLines(cs.XValues, cs.YValues, cs.Values, 9.5)
+	testutil.AssertLen(t, lines, 1)
+	testutil.AssertLen(t, lines[0], 5)
+	testutil.AssertEqual(t, lines[0][0], lines[0][4])
+	for _, point := range lines[0] {
+		testutil.AssertInDelta(t, 0.5, math.Abs(point.X-2)+math.Abs(point.Y-2), 0.0001)
+	}
+
+	// the center of a saddle decides which corners are joined.
+	saddle := [][]float64{{1, 0}, {0, 1}}
+	testutil.AssertLen(t, contourLines([]float64{0, 1}, []float64{0, 1}, saddle, 0.5), 2)
+	testutil.AssertLen(t, contourLines([]float64{0, 1}, []float64{0, 1}, saddle, 2), 0)
+}
+
+func TestClipContourPolygon(t *testing.T) {
+	square := []contourVertex{
+		{X: 0, Y: 0, Value: 0},
+		{X: 1, Y: 0, Value: 1},
+		{X: 1, Y: 1, Value: 1},
+		{X: 0, Y: 1, Value: 0},
+	}
+
+	above := clipContourPolygon(square, 0.5, true)
+	testutil.AssertLen(t, above, 4)
+	for _, vertex := range above {
+		testutil.AssertTrue(t, vertex.X >= 0.5)
+	}
+
+	below := clipContourPolygon(square, 0.25, false)
+	testutil.AssertLen(t, below, 4)
+	for _, vertex := range below {
+		testutil.AssertTrue(t, vertex.X <= 0.25)
+	}
+
+	testutil.AssertEmpty(t, clipContourPolygon(square, 2, true))
+}
+
+func TestContourSeriesValidate(t *testing.T) {
+	cs := ContourSeries{
+		XValues: []float64{0, 1, 2},
+		YValues: []float64{0, 1},
+		Values:  [][]float64{{1, 2, 3}, {2, 3, 4}},
+	}
+	testutil.AssertNil(t, cs.Validate())
+
+	cs.Values = [][]float64{{1, 2, 3}}
+	testutil.AssertNotNil(t, cs.Validate())
+
+	cs.Values = [][]float64{{1, 2, 3}, {2, 3}}
+	testutil.AssertNotNil(t, cs.Validate())
+
+	cs.Values = [][]float64{{1, 2, 3}, {2, 3, 4}}
+	cs.XValues = []float64{0}
+	testutil.AssertNotNil(t, cs.Validate())
+}
+
+func TestContourSeriesRaggedGrid(t *testing.T) {
+	values := [][]float64{{1, 2, 3}, {2}}
+	_, ok := contourCell([]float64{0, 1, 2}, []float64{0, 1}, values, 0, 0)
+	testutil.AssertFalse(t, ok)
+
+	cs := ContourSeries{
+		XValues: []float64{0, 1, 2},
+		YValues: []float64{0, 1},
+		Values:  values,
+		Filled:  true,
+	}
+	min, max := cs.GetBounds()
+	testutil.AssertEqual(t, 1.0, min)
+	testutil.AssertEqual(t, 3.0, max)
+
+	graph := Chart{Series: []Series{cs}}
+	testutil.AssertNil(t, graph.Render(PNG, bytes.NewBuffer([]byte{})))
+	cs.Filled = false
+	graph = Chart{Series: []Series{cs}}
+	testutil.AssertNil(t, graph.Render(PNG, bytes.NewBuffer([]byte{})))
+}
@@ -25,11 +25,18 @@ const (
 	DefaultAnnotationDeltaWidth = 10
 	// DefaultAnnotationFontSize is the font size of annotations.
 	DefaultAnnotationFontSize = 10.0
+	// DefaultAnnotationSpacing is the minimum vertical distance between annotations that would otherwise overlap.
+	DefaultAnnotationSpacing = 2
+	// DefaultAnnotationLeaderWidth is how far right of its anchor an annotation is drawn when it has been moved to avoid another annotation.
+	DefaultAnnotationLeaderWidth = 10
 	// DefaultAxisFontSize is the font size of the axis labels.
 	DefaultAxisFontSize = 10.0
 	// DefaultTitleTop is the default distance from the top of the chart to put the title.
 	DefaultTitleTop = 10
 
+	// DefaultTextEllipsis is appended to text that is truncated to fit.
+	DefaultTextEllipsis = "…"
+
 	// DefaultBackgroundStrokeWidth is the default stroke on the chart background.
 	DefaultBackgroundStrokeWidth = 0.0
 	// DefaultCanvasStrokeWidth is the default stroke on the chart canvas.
@@ -57,6 +64,8 @@ const (
 	DefaultMinimumTickHorizontalSpacing = 20
 	// DefaultMinimumTickVerticalSpacing is the minimum distance between vertical ticks.
 	DefaultMinimumTickVerticalSpacing = 20
+	// DefaultMinimumTickLabelSpacing is the minimum distance between adjacent tick labels.
+	DefaultMinimumTickLabelSpacing = 5
 
 	// DefaultDateFormat is the default date format.
 	DefaultDateFormat = "2006-01-02"
@@ -69,6 +78,184 @@ const (
 	// DefaultPercentValueFormat is the default percent format.
 	DefaultPercentValueFormat = "%0.2f%%"
 
+	// DefaultPieOtherLabel is the default label of the slice that small slices are merged into.
+	DefaultPieOtherLabel = "Other"
+	// DefaultPieExplodeOffset is the default distance exploded slices are moved out from the center, as a fraction of the radius.
+	DefaultPieExplodeOffset = 0.1
+	// DefaultPieLabelLeaderLength is the length of each segment of the leader line to an outside slice label.
+	DefaultPieLabelLeaderLength = 15
+	// DefaultPieLabelSpacing is the minimum distance between outside slice labels, and between the labels and their leader lines.
+	DefaultPieLabelSpacing = 4
+
+	// DefaultDonutHoleRadius is the default radius of the hole of a donut chart, as a fraction of the outer radius.
+	DefaultDonutHoleRadius = 0.36
+	// DefaultDonutRingLighten is how much the colors of a donut ring that inherits its colors are mixed with white.
+	DefaultDonutRingLighten = 0.35
+	// DefaultDonutCenterSpacing is the vertical spacing between the center text and caption of a donut chart.
+	DefaultDonutCenterSpacing = 4
+
+	// DefaultTreemapGroupPadding is the default space between a treemap group's rectangle and its children.
+	DefaultTreemapGroupPadding = 3
+	// DefaultTreemapLabelPadding is the space between a treemap rectangle and its label.
+	DefaultTreemapLabelPadding = 4
+	// DefaultTreemapGroupLighten is how much the color of a treemap group is mixed with white.
+	DefaultTreemapGroupLighten = 0.6
+
+	// DefaultRadarGridRings is the default number of grid rings on a radar chart.
+	DefaultRadarGridRings = 5
+	// DefaultRadarLabelPadding is the space between the end of a radar chart spoke and its label.
+	DefaultRadarLabelPadding = 8
+	// DefaultRadarFillAlpha is the default alpha of the fill of a radar series.
+	DefaultRadarFillAlpha = 64
+
+	// DefaultPolarSegmentLength is the length in pixels of the segments lines are split into on a polar chart.
+	DefaultPolarSegmentLength = 4
+	// DefaultPolarLabelPadding is the space between the circle of a polar chart and its labels.
+	DefaultPolarLabelPadding = 8
+	// DefaultRoseGridRings is the default number of grid rings on a rose chart.
+	DefaultRoseGridRings = 4
+
+	// DefaultGaugeSweep is the default angle in degrees a gauge spans.
+	DefaultGaugeSweep = 180.0
+	// DefaultGaugeThickness is the default thickness of the dial of a gauge as a fraction of its radius.
+	DefaultGaugeThickness = 0.2
+	// DefaultGaugeMajorTicks is the default number of intervals between the labeled ticks of a gauge.
+	DefaultGaugeMajorTicks = 5
+	// DefaultGaugeMinorTicks is the default number of intervals between the minor ticks in each major interval of a gauge.
+	DefaultGaugeMinorTicks = 4
+	// DefaultGaugeMajorTickLength is the length in pixels of the labeled ticks of a gauge.
+	DefaultGaugeMajorTickLength = 8.0
+	// DefaultGaugeMinorTickLength is the length in pixels of the minor ticks of a gauge.
+	DefaultGaugeMinorTickLength = 4.0
+	// DefaultGaugeLabelPadding is the space around the labels and the value of a gauge.
+	DefaultGaugeLabelPadding = 4
+	// DefaultGaugeNeedleWidth is the width in pixels of the base of the needle of a gauge.
+	DefaultGaugeNeedleWidth = 8.0
+	// DefaultGaugeBandStrip is the thickness of the strip of bands around an arc gauge as a fraction of the dial.
+	DefaultGaugeBandStrip = 0.25
+	// DefaultGaugeBandSpacing is the space in pixels between the strip of bands and the arc of an arc gauge.
+	DefaultGaugeBandSpacing = 2.0
+
+	// DefaultWaterfallLabelPadding is the space between a waterfall bar and its value label.
+	DefaultWaterfallLabelPadding = 4
+
+	// DefaultGanttLabelPadding is the space around the names of gantt chart tasks and lanes and the labels of their intervals.
+	DefaultGanttLabelPadding = 6
+	// DefaultGanttBarHeight is the height of the intervals of a gantt chart task as a fraction of the height of its row.
+	DefaultGanttBarHeight = 0.6
+	// DefaultGanttArrowSize is the size in pixels of the heads of gantt chart dependency arrows and the gap before them.
+	DefaultGanttArrowSize = 6
+
+	// DefaultSankeyNodeWidth is the width in pixels of the nodes of a sankey chart.
+	DefaultSankeyNodeWidth = 12
+	// DefaultSankeyNodePadding is the space in pixels between the nodes in a column of a sankey chart.
+	DefaultSankeyNodePadding = 10
+	// DefaultSankeyLabelPadding is the space between a sankey node and its label.
+	DefaultSankeyLabelPadding = 6
+	// DefaultSankeyIterations is the number of passes that move sankey nodes towards the nodes they are linked to.
+	DefaultSankeyIterations = 32
+	// DefaultSankeyLinkAlpha is the alpha of the color of sankey links, which take the color of their source node.
+	DefaultSankeyLinkAlpha = 96
+
+	// DefaultSparklineWidth is the default width of a sparkline.
+	DefaultSparklineWidth = 100
+	// DefaultSparklineHeight is the default height of a sparkline.
+	DefaultSparklineHeight = 20
+	// DefaultSparklineStrokeWidth is the width of the line of a sparkline.
+	DefaultSparklineStrokeWidth = 1.0
+	// DefaultSparklineMarkerRadius is the radius of the dots that mark values of a sparkline.
+	DefaultSparklineMarkerRadius = 2.0
+
+	// DefaultBulletChartWidth is the default width of a bullet chart.
+	DefaultBulletChartWidth = 400
+	// DefaultBulletChartHeight is the default height of a bullet chart.
+	DefaultBulletChartHeight = 60
+	// DefaultBulletChartTitleFontSize is the font size of the title of a bullet chart.
+	DefaultBulletChartTitleFontSize = 12.0
+	// DefaultBulletChartLabelPadding is the space between the labels of a bullet chart and its ranges.
+	DefaultBulletChartLabelPadding = 6
+	// DefaultBulletChartTickLength is the length of the ticks of the scale of a bullet chart.
+	DefaultBulletChartTickLength = 3
+	// DefaultBulletChartTicks is the number of intervals the scale of a bullet chart aims for.
+	DefaultBulletChartTicks = 5
+	// DefaultBulletChartMeasureHeight is the height of the measure bar of a bullet chart as a fraction of the ranges.
+	DefaultBulletChartMeasureHeight = 1.0 / 3.0
+	// DefaultBulletChartTargetHeight is the height of the target marker of a bullet chart as a fraction of the ranges.
+	DefaultBulletChartTargetHeight = 2.0 / 3.0
+	// DefaultBulletChartTargetWidth is the width in pixels of the target marker of a bullet chart.
+	DefaultBulletChartTargetWidth = 3
+
+	// DefaultCalendarHeatmapCellSpacing is the space in pixels between the days of a calendar heatmap.
+	DefaultCalendarHeatmapCellSpacing = 2
+	// DefaultCalendarHeatmapLabelPadding is the space between the days of a calendar heatmap and their labels.
+	DefaultCalendarHeatmapLabelPadding = 4
+	// DefaultCalendarHeatmapYearSpacing is the space in pixels between the years of a calendar heatmap.
+	DefaultCalendarHeatmapYearSpacing = 16
+	// DefaultCalendarHeatmapLighten is how much the color of the smallest value of a calendar heatmap is mixed with white.
+	DefaultCalendarHeatmapLighten = 0.85
+
+	// DefaultKernelDensityPoints is the number of values a kernel density estimate provides.
+	DefaultKernelDensityPoints = 100
+	// DefaultKernelDensityCut is how many bandwidths a kernel density estimate extends beyond its samples.
+	DefaultKernelDensityCut = 3.0
+	// DefaultViolinBoxWidth is the width of the inner box of a violin as a fraction of the width of the violin.
+	DefaultViolinBoxWidth = 0.15
+	// DefaultViolinMedianRadius is the radius of the dot that marks the median in the inner box of a violin.
+	DefaultViolinMedianRadius = 3.0
+	// DefaultViolinFillAlpha is the alpha of the fill color of a violin, which is the color of the series.
+	DefaultViolinFillAlpha = 128
+
+	// DefaultBubbleMinRadius is the radius in pixels of the smallest bubbles of a bubble series.
+	DefaultBubbleMinRadius = 3.0
+	// DefaultBubbleMaxRadius is the radius in pixels of the largest bubble of a bubble series.
+	DefaultBubbleMaxRadius = 30.0
+	// DefaultBubbleFillAlpha is the alpha of the fill color of bubbles, so overlapping bubbles show through.
+	DefaultBubbleFillAlpha = 160
+
+	// DefaultContourLevelCount is the approximate number of levels of a contour series.
+	DefaultContourLevelCount = 10
+	// DefaultContourLabelMinLength is the length in pixels a contour line needs to be labeled.
+	DefaultContourLabelMinLength = 60
+	// DefaultContourLabelPadding is the padding in pixels around the labels of contour lines.
+	DefaultContourLabelPadding = 2
+	// DefaultContourColorBarWidth is the width in pixels of the bar of a contour color bar.
+	DefaultContourColorBarWidth = 12
+	// DefaultContourLineAlpha is the alpha of the lines drawn over the bands of a filled contour series.
+	DefaultContourLineAlpha = 96
+
+	// DefaultHexbinRadius is the radius in pixels of the hexagons of a hexbin series.
+	DefaultHexbinRadius = 8.0
+	// DefaultHistogram2DBins is the number of bins along each axis of a 2d histogram series.
+	DefaultHistogram2DBins = 20
+	// DefaultBinColorScaleWidth is the width in pixels of the bar of a bin color scale.
+	DefaultBinColorScaleWidth = 12
+	// DefaultBinColorScaleTicks is the approximate number of labeled values of a bin color scale.
+	DefaultBinColorScaleTicks = 5
+
+	// DefaultRegressionConfidence is the confidence level of the bands of regression series.
+	DefaultRegressionConfidence = 0.95
+	// DefaultRegressionBandAlpha is the alpha of the fill color of the bands of regression series.
+	DefaultRegressionBandAlpha = 48
+	// DefaultLOESSSpan is the fraction of the values each local fit of a LOESS regression series uses.
+	DefaultLOESSSpan = 0.75
+
+	// DefaultForecastSteps is the number of values a forecast series forecasts past the end of its inner series.
+	DefaultForecastSteps = 12
+	// DefaultForecastConfidence is the confidence level of the prediction intervals of forecast series.
+	DefaultForecastConfidence = 0.95
+	// DefaultForecastBandAlpha is the alpha of the fill color of the prediction intervals of forecast series.
+	DefaultForecastBandAlpha = 48
+
+	// DefaultFunctionSamples is the number of values of function and parametric series used for the ranges of
+	// the chart.
+	DefaultFunctionSamples = 200
+	// DefaultFunctionSampleSpacing is the spacing in pixels of the first samples of a plotted function.
+	DefaultFunctionSampleSpacing = 4
+	// DefaultFunctionTolerance is how far in pixels the curve of a plotted function may stray from its line.
+	DefaultFunctionTolerance = 1.0
+	// DefaultFunctionMaxDepth is the number of times the samples of a plotted function are halved at most.
+	DefaultFunctionMaxDepth = 10
+
 	// DefaultBarSpacing is the default pixel spacing between bars.
 	DefaultBarSpacing = 100
 	// DefaultBarWidth is the default pixel width of bars in a bar chart.
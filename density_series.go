@@ -0,0 +1,64 @@
+package chart
+
+// Interface Assertions.
+var (
+	_ Series              = (*DensitySeries)(nil)
+	_ ValuesProvider      = (*DensitySeries)(nil)
+	_ FirstValuesProvider = (*DensitySeries)(nil)
+	_ LastValuesProvider  = (*DensitySeries)(nil)
+)
+
+// DensitySeries draws the kernel density estimate of samples as a line, or as an area if the style has a fill color.
+type DensitySeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Density KernelDensity
+}
+
+// GetName returns the name of the time series.
+func (ds DensitySeries) GetName() string {
+	return ds.Name
+}
+
+// GetStyle returns the line style.
+func (ds DensitySeries) GetStyle() Style {
+	return ds.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ds DensitySeries) GetYAxis() YAxisType {
+	return ds.YAxis
+}
+
+// Len returns the number of elements in the series.
+func (ds *DensitySeries) Len() int {
+	return ds.Density.Len()
+}
+
+// GetValues gets the x,y values at a given index.
+func (ds *DensitySeries) GetValues(index int) (x, y float64) {
+	return ds.Density.GetValues(index)
+}
+
+// GetFirstValues gets the first x,y values.
+func (ds *DensitySeries) GetFirstValues() (x, y float64) {
+	return ds.Density.GetValues(0)
+}
+
+// GetLastValues gets the last x,y values.
+func (ds *DensitySeries) GetLastValues() (x, y float64) {
+	return ds.Density.GetValues(ds.Density.Len() - 1)
+}
+
+// Render renders the series.
+func (ds *DensitySeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := ds.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, ds)
+}
+
+// Validate validates the series.
+func (ds *DensitySeries) Validate() error {
+	return ds.Density.Validate()
+}
@@ -0,0 +1,47 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestDensitySeries(t *testing.T) {
+	ds := &DensitySeries{
+		Density: KernelDensity{
+			Samples: []float64{1, 2, 2, 3, 3, 3, 4, 4, 5},
+			Points:  50,
+		},
+	}
+	testutil.AssertNil(t, ds.Validate())
+	testutil.AssertEqual(t, 50, ds.Len())
+
+	x0, _ := ds.GetFirstValues()
+	x1, _ := ds.GetLastValues()
+	testutil.AssertTrue(t, x0 < 1)
+	testutil.AssertTrue(t, x1 > 5)
+
+	graph := Chart{
+		Series: []Series{ds},
+	}
+	testutil.AssertNil(t, graph.Render(PNG, bytes.NewBuffer([]byte{})))
+
+	// the x-axis covers the tails of the estimate, and the y-axis its peak at the mode.
+	xrange, yrange, _ := graph.getRanges()
+	testutil.AssertEqual(t, x0, xrange.GetMin())
+	testutil.AssertEqual(t, x1, xrange.GetMax())
+	testutil.AssertTrue(t, yrange.GetMax() >= ds.Density.Density(3))
+}
+
+func TestDensitySeriesTwoSamples(t *testing.T) {
+	ds := &DensitySeries{
+		Density: KernelDensity{Samples: []float64{1, 3}},
+	}
+	testutil.AssertNil(t, ds.Validate())
+
+	x, y := ds.GetValues(0)
+	testutil.AssertTrue(t, x < 1)
+	testutil.AssertTrue(t, y > 0)
+	testutil.AssertNil(t, Chart{Series: []Series{ds}}.Render(PNG, bytes.NewBuffer([]byte{})))
+}
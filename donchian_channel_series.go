@@ -0,0 +1,111 @@
+package chart
+
+const (
+	// DefaultDonchianChannelPeriod is the default number of periods a donchian channel looks back over.
+	DefaultDonchianChannelPeriod = 20
+)
+
+// Interface Assertions.
+var (
+	_ Series                    = (*DonchianChannelSeries)(nil)
+	_ BoundedValuesProvider     = (*DonchianChannelSeries)(nil)
+	_ FirstValuesProvider       = (*DonchianChannelSeries)(nil)
+	_ LastValuesProvider        = (*DonchianChannelSeries)(nil)
+	_ BoundedLastValuesProvider = (*DonchianChannelSeries)(nil)
+)
+
+// DonchianChannelSeries draws donchian channels for a price series.
+// The bands are the highest high and the lowest low of the last periods, and the middle is halfway between them.
+//
+// The close is the value of the inner series; the high and low are the values of their series, or the close if
+// they aren't set.
+type DonchianChannelSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Period      int
+	InnerSeries ValuesProvider
+	HighSeries  ValuesProvider
+	LowSeries   ValuesProvider
+}
+
+// GetName returns the name of the time series.
+func (dcs DonchianChannelSeries) GetName() string {
+	return dcs.Name
+}
+
+// GetStyle returns the line style.
+func (dcs DonchianChannelSeries) GetStyle() Style {
+	return dcs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (dcs DonchianChannelSeries) GetYAxis() YAxisType {
+	return dcs.YAxis
+}
+
+// GetPeriod returns the window size.
+func (dcs DonchianChannelSeries) GetPeriod() int {
+	if dcs.Period == 0 {
+		return DefaultDonchianChannelPeriod
+	}
+	return dcs.Period
+}
+
+// Len returns the number of elements in the series.
+func (dcs DonchianChannelSeries) Len() int {
+	return dcs.InnerSeries.Len()
+}
+
+// GetValues gets the middle of the channel at a given index.
+func (dcs DonchianChannelSeries) GetValues(index int) (x, y float64) {
+	x, y1, y2 := dcs.GetBoundedValues(index)
+	y = (y1 + y2) / 2
+	return
+}
+
+// GetBoundedValues gets the bands of the channel at a given index.
+func (dcs DonchianChannelSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	if dcs.InnerSeries == nil {
+		return
+	}
+	x, _ = dcs.InnerSeries.GetValues(index)
+	y1, y2 = getHighestLowest(dcs.InnerSeries, dcs.HighSeries, dcs.LowSeries, index, dcs.GetPeriod())
+	return
+}
+
+// GetFirstValues returns the first middle value of the channel.
+func (dcs DonchianChannelSeries) GetFirstValues() (x, y float64) {
+	if dcs.InnerSeries == nil || dcs.InnerSeries.Len() == 0 {
+		return
+	}
+	return dcs.GetValues(0)
+}
+
+// GetLastValues returns the last middle value of the channel.
+func (dcs DonchianChannelSeries) GetLastValues() (x, y float64) {
+	if dcs.InnerSeries == nil || dcs.InnerSeries.Len() == 0 {
+		return
+	}
+	return dcs.GetValues(dcs.InnerSeries.Len() - 1)
+}
+
+// GetBoundedLastValues returns the last bands of the channel.
+func (dcs DonchianChannelSeries) GetBoundedLastValues() (x, y1, y2 float64) {
+	if dcs.InnerSeries == nil || dcs.InnerSeries.Len() == 0 {
+		return
+	}
+	return dcs.GetBoundedValues(dcs.InnerSeries.Len() - 1)
+}
+
+// Render renders the series.
+func (dcs DonchianChannelSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := dcs.Style.InheritFrom(defaults.InheritFrom(channelStyleDefaults()))
+	drawChannel(r, canvasBox, xrange, yrange, style, dcs)
+}
+
+// Validate validates the series.
+func (dcs DonchianChannelSeries) Validate() error {
+	return validateIndicatorSeries("donchian channel", dcs.InnerSeries, dcs.HighSeries, dcs.LowSeries)
+}
@@ -0,0 +1,47 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestDonchianChannelSeries(t *testing.T) {
+	dcs := DonchianChannelSeries{
+		InnerSeries: mockValuesProvider{
+			X: LinearRange(1.0, 100.0),
+			Y: LinearRange(1.0, 100.0),
+		},
+	}
+
+	x, y1, y2 := dcs.GetBoundedValues(5)
+	testutil.AssertEqual(t, 6.0, x)
+	testutil.AssertEqual(t, 6.0, y1)
+	testutil.AssertEqual(t, 1.0, y2)
+
+	x, y1, y2 = dcs.GetBoundedLastValues()
+	testutil.AssertEqual(t, 100.0, x)
+	testutil.AssertEqual(t, 100.0, y1)
+	testutil.AssertEqual(t, 81.0, y2)
+
+	_, y := dcs.GetLastValues()
+	testutil.AssertEqual(t, 90.5, y)
+}
+
+func TestChannelSeriesRender(t *testing.T) {
+	prices := mockValuesProvider{
+		X: LinearRange(1.0, 100.0),
+		Y: RandomValuesWithMax(100, 1024),
+	}
+	graph := Chart{
+		Series: []Series{
+			DonchianChannelSeries{InnerSeries: prices},
+			&KeltnerChannelSeries{InnerSeries: prices},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, graph.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 
 	"github.com/golang/freetype/truetype"
 )
@@ -23,6 +24,43 @@ type DonutChart struct {
 	Canvas     Style
 	SliceStyle Style
 
+	// StartAngle is the angle in degrees, clockwise from three o'clock, that the first slice starts at.
+	StartAngle float64
+	// CounterClockwise lays the slices out counter-clockwise from the start angle.
+	CounterClockwise bool
+
+	// LabelPosition determines where the slice labels are drawn.
+	LabelPosition PieLabelPosition
+	// ValueFormatter, if set, formats a value shown with each slice label; it is given the slice's
+	// share of the total on the interval [0, 1], or the original value if `FormatValues` is set.
+	ValueFormatter ValueFormatter
+	FormatValues   bool
+
+	// OtherThreshold merges the slices whose share of the total is below the threshold
+	// into a single slice labeled `OtherLabel`.
+	OtherThreshold float64
+	OtherLabel     string
+
+	// Exploded lists the indexes of the values whose slices are moved out from the center
+	// by `ExplodeOffset`, a fraction of the radius.
+	Exploded      []int
+	ExplodeOffset float64
+
+	// Rings are drawn outside of the ring made from `Values`, from the inside out;
+	// `Values` can be left empty to only draw the rings.
+	Rings []DonutRing
+	// HoleRadius is the radius of the hole as a fraction of the outer radius.
+	HoleRadius float64
+	// RingGap is the space in pixels between rings.
+	RingGap int
+
+	// CenterText and CenterCaption are drawn in the hole, e.g. a total and what it counts;
+	// the text is shrunk to fit the hole if it is too wide.
+	CenterText         string
+	CenterTextStyle    Style
+	CenterCaption      string
+	CenterCaptionStyle Style
+
 	Font        *truetype.Font
 	defaultFont *truetype.Font
 
@@ -65,9 +103,17 @@ func (pc DonutChart) GetHeight() int {
 	return pc.Height
 }
 
+// GetHoleRadius returns the radius of the hole as a fraction of the outer radius or the default.
+func (pc DonutChart) GetHoleRadius() float64 {
+	if pc.HoleRadius <= 0 {
+		return DefaultDonutHoleRadius
+	}
+	return pc.HoleRadius
+}
+
 // Render renders the chart with the given renderer to the given io.Writer.
 func (pc DonutChart) Render(rp RendererProvider, w io.Writer) error {
-	if len(pc.Values) == 0 {
+	if len(pc.Values) == 0 && len(pc.Rings) == 0 {
 		return errors.New("please provide at least one value")
 	}
 
@@ -86,16 +132,20 @@ func (pc DonutChart) Render(rp RendererProvider, w io.Writer) error {
 	r.SetDPI(pc.GetDPI(DefaultDPI))
 
 	canvasBox := pc.getDefaultCanvasBox()
-	canvasBox = pc.getCircleAdjustedCanvasBox(canvasBox)
+	if pc.LabelPosition != PieLabelPositionOutside {
+		canvasBox = pc.getCircleAdjustedCanvasBox(canvasBox)
+	}
 
 	pc.drawBackground(r)
 	pc.drawCanvas(r, canvasBox)
 
-	finalValues, err := pc.finalizeValues(pc.Values)
+	layout := pc.getSliceLayout()
+	rings := pc.getRings()
+	slices, err := pc.finalizeSlices(layout, rings)
 	if err != nil {
 		return err
 	}
-	pc.drawSlices(r, canvasBox, finalValues)
+	pc.drawSlices(r, canvasBox, layout, rings, slices)
 	pc.drawTitle(r)
 	for _, a := range pc.Elements {
 		a(r, canvasBox, pc.styleDefaultsElements())
@@ -121,73 +171,184 @@ func (pc DonutChart) drawTitle(r Renderer) {
 	}
 }
 
-func (pc DonutChart) drawSlices(r Renderer, canvasBox Box, values []Value) {
+func (pc DonutChart) drawSlices(r Renderer, canvasBox Box, layout pieSliceLayout, rings []DonutRing, slices [][]pieSlice) {
+	styles := pc.getSliceStyles(rings, slices)
+	outermost := len(slices) - 1
+	outermostStyle := func(index int) Style {
+		return styles[outermost][index]
+	}
+
 	cx, cy := canvasBox.Center()
-	diameter := MinInt(canvasBox.Width(), canvasBox.Height())
-	radius := float64(diameter>>1) / 1.1
+	var radius float64
+	if layout.LabelPosition == PieLabelPositionOutside {
+		cx, radius = layout.GetOutsideLabelCircle(r, canvasBox, slices[outermost], outermostStyle)
+		radius *= 1.25
+	} else {
+		diameter := MinInt(canvasBox.Width(), canvasBox.Height())
+		radius = float64(diameter>>1) / 1.1 / layout.OuterRadius(slices[outermost], 1.0)
+	}
 	labelRadius := (radius * 2.83) / 3.0
+	sliceRadius := radius / 1.25
+	inner, outer := donutRingRadii(rings, sliceRadius*pc.GetHoleRadius(), sliceRadius, pc.RingGap)
 
 	// draw the donut slices
-	var rads, delta, delta2, total float64
-	var lx, ly int
+	var dx, dy int
+	for ringIndex, ringSlices := range slices {
+		for index, ps := range ringSlices {
+			dx, dy = layout.Offset(ps, sliceRadius)
+			Draw.AnnularSector(r, cx+dx, cy+dy, inner[ringIndex], outer[ringIndex], ps.Start, ps.Delta, styles[ringIndex][index])
+		}
+	}
 
-	if len(values) == 1 {
-		pc.styleDonutChartValue(0).WriteToRenderer(r)
-		r.MoveTo(cx, cy)
-		r.Circle(radius, cx, cy)
-	} else {
-		for index, v := range values {
-			v.Style.InheritFrom(pc.styleDonutChartValue(index)).WriteToRenderer(r)
-			r.MoveTo(cx, cy)
-			rads = PercentToRadians(total)
-			delta = PercentToRadians(v.Value)
-
-			r.ArcTo(cx, cy, (radius / 1.25), (radius / 1.25), rads, delta)
-
-			r.LineTo(cx, cy)
-			r.Close()
-			r.FillStroke()
-			total = total + v.Value
+	pc.drawCenter(r, cx, cy, inner[0])
+
+	// draw the labels of the inner rings within their slices, skipping the ones that don't fit
+	for ringIndex, ringSlices := range slices[:outermost] {
+		middle := (inner[ringIndex] + outer[ringIndex]) / 2.0
+		thickness := outer[ringIndex] - inner[ringIndex]
+		for index, ps := range ringSlices {
+			label := layout.Label(ps)
+			if label == "" {
+				continue
+			}
+			tb := Draw.MeasureText(r, label, styles[ringIndex][index])
+			if float64(tb.Width()) > middle*ps.Delta || float64(tb.Height()) > thickness {
+				continue
+			}
+			lx := cx + int(middle*math.Cos(ps.Mid())) - tb.Width()>>1
+			ly := cy + int(middle*math.Sin(ps.Mid())) + tb.Height()>>1
+			Draw.Text(r, label, lx, ly, styles[ringIndex][index])
 		}
 	}
 
-	//making the donut hole
-	v := Value{Value: 100, Label: "center"}
-	styletemp := pc.SliceStyle.InheritFrom(Style{
-		StrokeColor: ColorWhite, StrokeWidth: 4.0, FillColor: ColorWhite, FontColor: ColorWhite, //Font:        pc.GetFont(),//FontSize:    pc.getScaledFontSize(),
-	})
-	v.Style.InheritFrom(styletemp).WriteToRenderer(r)
-	r.MoveTo(cx, cy)
-	r.ArcTo(cx, cy, (radius / 3.5), (radius / 3.5), DegreesToRadians(0), DegreesToRadians(359))
-	r.LineTo(cx, cy)
-	r.Close()
-	r.FillStroke()
-
-	// draw the labels
-	total = 0
-	for index, v := range values {
-		v.Style.InheritFrom(pc.styleDonutChartValue(index)).WriteToRenderer(r)
-		if len(v.Label) > 0 {
-			delta2 = PercentToRadians(total + (v.Value / 2.0))
-			delta2 = RadianAdd(delta2, _pi2)
-			lx, ly = CirclePoint(cx, cy, labelRadius, delta2)
-
-			tb := r.MeasureText(v.Label)
+	// draw the labels of the outermost ring
+	if layout.LabelPosition == PieLabelPositionOutside {
+		for _, label := range layout.OutsideLabels(r, canvasBox, cx, cy, sliceRadius, slices[outermost], outermostStyle) {
+			style := outermostStyle(label.Slice)
+			drawPieLabel(r, label, Style{
+				StrokeColor: style.GetFillColor(),
+				StrokeWidth: DefaultAxisLineWidth,
+			}, style)
+		}
+		return
+	}
+
+	var lx, ly int
+	for index, ps := range slices[outermost] {
+		outermostStyle(index).WriteToRenderer(r)
+		if label := layout.Label(ps); len(label) > 0 {
+			dx, dy = layout.Offset(ps, sliceRadius)
+			lx = cx + dx + int(labelRadius*math.Cos(ps.Mid()))
+			ly = cy + dy + int(labelRadius*math.Sin(ps.Mid()))
+
+			tb := r.MeasureText(label)
 			lx = lx - (tb.Width() >> 1)
 			ly = ly + (tb.Height() >> 1)
 
-			r.Text(v.Label, lx, ly)
+			r.Text(label, lx, ly)
 		}
-		total = total + v.Value
 	}
 }
 
-func (pc DonutChart) finalizeValues(values []Value) ([]Value, error) {
-	finalValues := Values(values).Normalize()
-	if len(finalValues) == 0 {
-		return nil, fmt.Errorf("donut chart must contain at least (1) non-zero value")
+// drawCenter draws the center text and caption in the hole, shrinking them to fit if they are too wide.
+func (pc DonutChart) drawCenter(r Renderer, cx, cy int, holeRadius float64) {
+	if pc.CenterText == "" && pc.CenterCaption == "" {
+		return
+	}
+
+	maxWidth := int(holeRadius * 1.6)
+	textStyle := pc.fitCenterText(r, pc.CenterText, pc.getCenterTextStyle(), maxWidth)
+	captionStyle := pc.fitCenterText(r, pc.CenterCaption, pc.getCenterCaptionStyle(), maxWidth)
+
+	var tb, cb Box
+	var height int
+	if pc.CenterText != "" {
+		tb = Draw.MeasureText(r, pc.CenterText, textStyle)
+		height += tb.Height()
+	}
+	if pc.CenterCaption != "" {
+		cb = Draw.MeasureText(r, pc.CenterCaption, captionStyle)
+		if height > 0 {
+			height += DefaultDonutCenterSpacing
+		}
+		height += cb.Height()
+	}
+
+	top := cy - height>>1
+	if pc.CenterText != "" {
+		top += tb.Height()
+		Draw.Text(r, pc.CenterText, cx-tb.Width()>>1, top, textStyle)
+		top += DefaultDonutCenterSpacing
+	}
+	if pc.CenterCaption != "" {
+		Draw.Text(r, pc.CenterCaption, cx-cb.Width()>>1, top+cb.Height(), captionStyle)
 	}
-	return finalValues, nil
+}
+
+func (pc DonutChart) fitCenterText(r Renderer, text string, style Style, maxWidth int) Style {
+	if text == "" {
+		return style
+	}
+	if width := Draw.MeasureText(r, text, style).Width(); width > maxWidth && maxWidth > 0 {
+		style.FontSize = style.GetFontSize() * float64(maxWidth) / float64(width)
+	}
+	return style
+}
+
+// getSliceStyles returns the style of each slice of each ring; the slices of rings that inherit their colors
+// are filled with a lighter color of the slice of the ring inside them that contains their middle.
+func (pc DonutChart) getSliceStyles(rings []DonutRing, slices [][]pieSlice) [][]Style {
+	styles := make([][]Style, len(slices))
+	for ringIndex, ringSlices := range slices {
+		styles[ringIndex] = make([]Style, len(ringSlices))
+		for index, ps := range ringSlices {
+			defaults := pc.styleDonutChartValue(index)
+			if rings[ringIndex].InheritColors && ringIndex > 0 {
+				if parent := parentPieSlice(slices[ringIndex-1], ps.Mid()); parent >= 0 {
+					defaults.FillColor = styles[ringIndex-1][parent].GetFillColor().Lighten(DefaultDonutRingLighten)
+				}
+			}
+			styles[ringIndex][index] = ps.Value.Style.InheritFrom(rings[ringIndex].Style.InheritFrom(defaults))
+		}
+	}
+	return styles
+}
+
+func (pc DonutChart) getRings() []DonutRing {
+	if len(pc.Values) == 0 {
+		return pc.Rings
+	}
+	return append([]DonutRing{{Values: pc.Values}}, pc.Rings...)
+}
+
+func (pc DonutChart) getSliceLayout() pieSliceLayout {
+	return pieSliceLayout{
+		StartAngle:       pc.StartAngle,
+		CounterClockwise: pc.CounterClockwise,
+		OtherThreshold:   pc.OtherThreshold,
+		OtherLabel:       pc.OtherLabel,
+		Exploded:         pc.Exploded,
+		ExplodeOffset:    pc.ExplodeOffset,
+		LabelPosition:    pc.LabelPosition,
+		ValueFormatter:   pc.ValueFormatter,
+		FormatValues:     pc.FormatValues,
+	}
+}
+
+// finalizeSlices lays out the slices of each ring; only the slices of the outermost ring can be exploded.
+func (pc DonutChart) finalizeSlices(layout pieSliceLayout, rings []DonutRing) ([][]pieSlice, error) {
+	slices := make([][]pieSlice, len(rings))
+	for index, ring := range rings {
+		ringLayout := layout
+		if index < len(rings)-1 {
+			ringLayout.Exploded = nil
+		}
+		slices[index] = ringLayout.Slices(ring.Values)
+		if len(slices[index]) == 0 {
+			return nil, fmt.Errorf("donut chart must contain at least (1) non-zero value")
+		}
+	}
+	return slices, nil
 }
 
 func (pc DonutChart) getDefaultCanvasBox() Box {
@@ -240,6 +401,22 @@ func (pc DonutChart) styleDonutChartValue(index int) Style {
 	})
 }
 
+func (pc DonutChart) getCenterTextStyle() Style {
+	return pc.CenterTextStyle.InheritFrom(Style{
+		FontSize:  pc.getScaledFontSize() * 2.0,
+		FontColor: pc.GetColorPalette().TextColor(),
+		Font:      pc.GetFont(),
+	})
+}
+
+func (pc DonutChart) getCenterCaptionStyle() Style {
+	return pc.CenterCaptionStyle.InheritFrom(Style{
+		FontSize:  pc.getScaledFontSize(),
+		FontColor: pc.GetColorPalette().TextColor(),
+		Font:      pc.GetFont(),
+	})
+}
+
 func (pc DonutChart) getScaledFontSize() float64 {
 	effectiveDimension := MinInt(pc.GetWidth(), pc.GetHeight())
 	if effectiveDimension >= 2048 {
@@ -30,6 +30,29 @@ func TestDonutChart(t *testing.T) {
 	testutil.AssertNotZero(t, b.Len())
 }
 
+func TestDonutChartSVGArcs(t *testing.T) {
+	pie := DonutChart{
+		Width:  200,
+		Height: 200,
+		Values: []Value{
+			{Value: 1, Label: "a"},
+			{Value: 3, Label: "b"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, pie.Render(SVG, b))
+	svg := b.String()
+
+	// the slices are drawn as ring sectors; the outer arc sweeps clockwise and the inner arc back, so the hole
+	// is left unpainted rather than painted over with a white circle.
+	testutil.AssertContains(t, svg, "A 69 69 90.00 0 1 100 169")
+	testutil.AssertContains(t, svg, "A 24 24 -90.00 0 0 124 100")
+	testutil.AssertContains(t, svg, "A 69 69 270.00 1 1 169 100")
+	testutil.AssertContains(t, svg, "A 24 24 -270.00 1 0 100 124")
+	testutil.AssertNotContains(t, svg, "359.00")
+}
+
 func TestDonutChartDropsZeroValues(t *testing.T) {
 	// replaced new assertions helper
 
@@ -67,3 +90,74 @@ func TestDonutChartAllZeroValues(t *testing.T) {
 	err := pie.Render(PNG, b)
 	testutil.AssertNotNil(t, err)
 }
+
+func TestDonutChartOutsideLabels(t *testing.T) {
+	chart := DonutChart{
+		Width:          640,
+		Height:         480,
+		StartAngle:     -90,
+		LabelPosition:  PieLabelPositionOutside,
+		ValueFormatter: PercentValueFormatter,
+		OtherThreshold: 0.05,
+		Exploded:       []int{0},
+		Values: []Value{
+			{Value: 50, Label: "Blue"},
+			{Value: 30, Label: "Green"},
+			{Value: 2, Label: "Gray"},
+			{Value: 1, Label: "Orange"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	err := chart.Render(PNG, b)
+	testutil.AssertNil(t, err)
+	testutil.AssertNotZero(t, b.Len())
+}
+
+func TestDonutChartRings(t *testing.T) {
+	donut := DonutChart{
+		CenterText:    "1,240",
+		CenterCaption: "requests / s",
+		RingGap:       2,
+		Rings: []DonutRing{
+			{
+				Values: []Value{
+					{Value: 6, Label: "A"},
+					{Value: 4, Label: "B"},
+				},
+			},
+			{
+				InheritColors: true,
+				Values: []Value{
+					{Value: 4, Label: "A1"},
+					{Value: 2, Label: "A2"},
+					{Value: 4, Label: "B1"},
+				},
+			},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	err := donut.Render(PNG, b)
+	testutil.AssertNil(t, err)
+	testutil.AssertNotZero(t, b.Len())
+
+	rings := donut.getRings()
+	slices, err := donut.finalizeSlices(donut.getSliceLayout(), rings)
+	testutil.AssertNil(t, err)
+	styles := donut.getSliceStyles(rings, slices)
+	testutil.AssertEqual(t, styles[0][0].FillColor.Lighten(DefaultDonutRingLighten), styles[1][0].FillColor)
+	testutil.AssertEqual(t, styles[0][0].FillColor.Lighten(DefaultDonutRingLighten), styles[1][1].FillColor)
+	testutil.AssertEqual(t, styles[0][1].FillColor.Lighten(DefaultDonutRingLighten), styles[1][2].FillColor)
+}
+
+func TestDonutChartRingsAllZeroValues(t *testing.T) {
+	donut := DonutChart{
+		Values: []Value{{Value: 1, Label: "A"}},
+		Rings:  []DonutRing{{Values: []Value{{Value: 0, Label: "B"}}}},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	err := donut.Render(PNG, b)
+	testutil.AssertNotNil(t, err)
+}
@@ -0,0 +1,57 @@
+package chart
+
+import "math"
+
+// DonutRing is a ring of slices in a donut chart with more than one ring.
+//
+// Each ring's values span the whole circle, so the slices of a ring line up with the slices of the
+// ring inside it when they are ordered by parent and each parent's value is the sum of its children.
+type DonutRing struct {
+	Values []Value
+	// Style is the default style of the ring's slices.
+	Style Style
+
+	// Thickness is the thickness of the ring relative to the other rings; it defaults to 1.
+	Thickness float64
+	// InheritColors colors each slice like the slice of the ring inside it that contains the middle of the slice, lightened.
+	InheritColors bool
+}
+
+// GetThickness returns the relative thickness of the ring or the default.
+func (dr DonutRing) GetThickness() float64 {
+	if dr.Thickness <= 0 {
+		return 1
+	}
+	return dr.Thickness
+}
+
+// donutRingRadii returns the inner and outer radius of each ring, splitting the space between
+// the hole and the outer radius by the rings' relative thickness.
+func donutRingRadii(rings []DonutRing, holeRadius, outerRadius float64, gap int) (inner, outer []float64) {
+	var total float64
+	for _, ring := range rings {
+		total += ring.GetThickness()
+	}
+	available := math.Max(outerRadius-holeRadius-float64(gap*(len(rings)-1)), 0)
+
+	radius := holeRadius
+	for index, ring := range rings {
+		if index > 0 {
+			radius += float64(gap)
+		}
+		inner = append(inner, radius)
+		radius += available * ring.GetThickness() / total
+		outer = append(outer, radius)
+	}
+	return
+}
+
+// parentPieSlice returns the index of the slice that contains the given angle, or -1 if there isn't one.
+func parentPieSlice(slices []pieSlice, angle float64) int {
+	for index, ps := range slices {
+		if RadianAdd(angle, -ps.Start) < ps.Delta {
+			return index
+		}
+	}
+	return -1
+}
@@ -0,0 +1,33 @@
+package chart
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestDonutRingRadii(t *testing.T) {
+	rings := []DonutRing{{}, {Thickness: 2}, {Thickness: 1}}
+
+	inner, outer := donutRingRadii(rings, 10, 110, 5)
+	testutil.AssertLen(t, inner, 3)
+	testutil.AssertInDelta(t, 10, inner[0], 0.0001)
+	testutil.AssertInDelta(t, 32.5, outer[0], 0.0001)
+	testutil.AssertInDelta(t, 37.5, inner[1], 0.0001)
+	testutil.AssertInDelta(t, 82.5, outer[1], 0.0001)
+	testutil.AssertInDelta(t, 87.5, inner[2], 0.0001)
+	testutil.AssertInDelta(t, 110, outer[2], 0.0001)
+}
+
+func TestParentPieSlice(t *testing.T) {
+	slices := pieSliceLayout{StartAngle: -90}.Slices([]Value{
+		{Value: 1, Label: "A"},
+		{Value: 1, Label: "B"},
+	})
+
+	// the first slice wraps around three o'clock.
+	testutil.AssertEqual(t, 0, parentPieSlice(slices, 0.1))
+	testutil.AssertEqual(t, 0, parentPieSlice(slices, 3*math.Pi/2+0.1))
+	testutil.AssertEqual(t, 1, parentPieSlice(slices, math.Pi))
+}
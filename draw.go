@@ -12,6 +12,7 @@ var (
 type draw struct{}
 
 // LineSeries draws a line series with a renderer.
+// Values with a y-value of NaN are missing; the line breaks around them.
 func (d draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style Style, vs ValuesProvider) {
 	if vs.Len() == 0 {
 		return
@@ -20,10 +21,6 @@ func (d draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style
 	cb := canvasBox.Bottom
 	cl := canvasBox.Left
 
-	v0x, v0y := vs.GetValues(0)
-	x0 := cl + xrange.Translate(v0x)
-	y0 := cb - yrange.Translate(v0y)
-
 	yv0 := yrange.Translate(0)
 
 	var vx, vy float64
@@ -31,28 +28,56 @@ func (d draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style
 
 	if style.ShouldDrawStroke() && style.ShouldDrawFill() {
 		style.GetFillOptions().WriteDrawingOptionsToRenderer(r)
-		r.MoveTo(x0, y0)
-		for i := 1; i < vs.Len(); i++ {
+
+		// each run of values between missing values is filled down to zero on its own.
+		var x0, y0 int
+		var drawing bool
+		fill := func() {
+			if drawing {
+				r.LineTo(x, MinInt(cb, cb-yv0))
+				r.LineTo(x0, MinInt(cb, cb-yv0))
+				r.LineTo(x0, y0)
+				r.Fill()
+			}
+			drawing = false
+		}
+		for i := 0; i < vs.Len(); i++ {
 			vx, vy = vs.GetValues(i)
+			if math.IsNaN(vy) {
+				fill()
+				continue
+			}
 			x = cl + xrange.Translate(vx)
 			y = cb - yrange.Translate(vy)
-			r.LineTo(x, y)
+			if drawing {
+				r.LineTo(x, y)
+			} else {
+				x0, y0 = x, y
+				r.MoveTo(x, y)
+				drawing = true
+			}
 		}
-		r.LineTo(x, MinInt(cb, cb-yv0))
-		r.LineTo(x0, MinInt(cb, cb-yv0))
-		r.LineTo(x0, y0)
-		r.Fill()
+		fill()
 	}
 
 	if style.ShouldDrawStroke() {
 		style.GetStrokeOptions().WriteDrawingOptionsToRenderer(r)
 
-		r.MoveTo(x0, y0)
-		for i := 1; i < vs.Len(); i++ {
+		var drawing bool
+		for i := 0; i < vs.Len(); i++ {
 			vx, vy = vs.GetValues(i)
+			if math.IsNaN(vy) {
+				drawing = false
+				continue
+			}
 			x = cl + xrange.Translate(vx)
 			y = cb - yrange.Translate(vy)
-			r.LineTo(x, y)
+			if drawing {
+				r.LineTo(x, y)
+			} else {
+				r.MoveTo(x, y)
+				drawing = true
+			}
 		}
 		r.Stroke()
 	}
@@ -63,6 +88,9 @@ func (d draw) LineSeries(r Renderer, canvasBox Box, xrange, yrange Range, style
 		style.GetDotOptions().WriteDrawingOptionsToRenderer(r)
 		for i := 0; i < vs.Len(); i++ {
 			vx, vy = vs.GetValues(i)
+			if math.IsNaN(vy) {
+				continue
+			}
 			x = cl + xrange.Translate(vx)
 			y = cb - yrange.Translate(vy)
 
@@ -195,6 +223,20 @@ func (d draw) MeasureAnnotation(r Renderer, canvasBox Box, style Style, lx, ly i
 	}
 }
 
+// AnnotationLeader draws a line from an anchor point to an annotation that has been moved away from it.
+func (d draw) AnnotationLeader(r Renderer, style Style, ax, ay, lx, ly int) {
+	Style{
+		StrokeColor:     style.GetStrokeColor(DefaultAxisColor),
+		StrokeWidth:     style.GetStrokeWidth(DefaultAxisLineWidth),
+		StrokeDashArray: style.StrokeDashArray,
+	}.WriteToRenderer(r)
+	defer r.ResetStyle()
+
+	r.MoveTo(ax, ay)
+	r.LineTo(lx, ly)
+	r.Stroke()
+}
+
 // Annotation draws an anotation with a renderer.
 func (d draw) Annotation(r Renderer, canvasBox Box, style Style, lx, ly int, label string) {
 	style.GetTextOptions().WriteToRenderer(r)
@@ -268,6 +310,41 @@ func (d draw) BoxCorners(r Renderer, bc BoxCorners, s Style) {
 	r.FillStroke()
 }
 
+// AnnularSector draws the part of a ring between the inner and outer radius starting at the start angle
+// in radians, clockwise from three o'clock, and sweeping through delta; a delta of a whole turn draws the whole ring.
+func (d draw) AnnularSector(r Renderer, cx, cy int, innerRadius, outerRadius, start, delta float64, s Style) {
+	s.GetFillAndStrokeOptions().WriteToRenderer(r)
+	defer r.ResetStyle()
+
+	if delta >= _2pi {
+		// a whole turn can't be drawn as a single arc, so the ring is drawn as two closed circles of opposite direction.
+		r.MoveTo(cx+int(outerRadius), cy)
+		r.ArcTo(cx, cy, outerRadius, outerRadius, 0, _pi)
+		r.ArcTo(cx, cy, outerRadius, outerRadius, _pi, _pi)
+		r.Close()
+		if innerRadius > 0 {
+			r.MoveTo(cx+int(innerRadius), cy)
+			r.ArcTo(cx, cy, innerRadius, innerRadius, 0, -_pi)
+			r.ArcTo(cx, cy, innerRadius, innerRadius, _pi, -_pi)
+			r.Close()
+		}
+		r.FillStroke()
+		return
+	}
+
+	end := start + delta
+	r.MoveTo(cx+int(outerRadius*math.Cos(start)), cy+int(outerRadius*math.Sin(start)))
+	r.ArcTo(cx, cy, outerRadius, outerRadius, start, delta)
+	if innerRadius > 0 {
+		r.LineTo(cx+int(innerRadius*math.Cos(end)), cy+int(innerRadius*math.Sin(end)))
+		r.ArcTo(cx, cy, innerRadius, innerRadius, end, -delta)
+	} else {
+		r.LineTo(cx, cy)
+	}
+	r.Close()
+	r.FillStroke()
+}
+
 // DrawText draws text with a given style.
 func (d draw) Text(r Renderer, text string, x, y int, style Style) {
 	style.GetTextOptions().WriteToRenderer(r)
@@ -2,6 +2,7 @@ package drawing
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
@@ -249,6 +250,17 @@ func (c Color) WithAlpha(a uint8) Color {
 	}
 }
 
+// Lighten returns a copy of the color mixed with white by a given amount on the interval [0, 1].
+func (c Color) Lighten(amount float64) Color {
+	amount = math.Max(0, math.Min(amount, 1))
+	return Color{
+		R: c.R + uint8(math.Round(float64(255-c.R)*amount)),
+		G: c.G + uint8(math.Round(float64(255-c.G)*amount)),
+		B: c.B + uint8(math.Round(float64(255-c.B)*amount)),
+		A: c.A,
+	}
+}
+
 // Equals returns true if the color equals another.
 func (c Color) Equals(other Color) bool {
 	return c.R == other.R &&
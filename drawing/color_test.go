@@ -112,3 +112,10 @@ func TestParseColor(t *testing.T) {
 		testutil.AssertEqual(t, tc.Expected, actual, fmt.Sprintf("test case: %d -> %s", index, tc.Input))
 	}
 }
+
+func TestColorLighten(t *testing.T) {
+	c := Color{R: 0, G: 100, B: 255, A: 128}
+	testutil.AssertEqual(t, c, c.Lighten(0))
+	testutil.AssertEqual(t, Color{R: 255, G: 255, B: 255, A: 128}, c.Lighten(1))
+	testutil.AssertEqual(t, Color{R: 128, G: 178, B: 255, A: 128}, c.Lighten(0.5))
+}
@@ -0,0 +1,48 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	/*
+	   In this example several series end at nearly the same value, so their last value annotations
+	   would be drawn on top of each other.
+
+	   The chart lays out the annotations of all the annotation series together, moving overlapping
+	   annotations apart and drawing a leader line back to the value they label.
+	   Set `AllowOverlap` on an annotation series to draw its annotations at their values regardless.
+	*/
+
+	xvalues := []float64{1.0, 2.0, 3.0, 4.0, 5.0, 6.0}
+	series := []chart.ContinuousSeries{
+		{Name: "us-east", XValues: xvalues, YValues: []float64{42, 48, 51, 55, 61, 70.2}},
+		{Name: "us-west", XValues: xvalues, YValues: []float64{30, 38, 47, 58, 64, 69.8}},
+		{Name: "eu-west", XValues: xvalues, YValues: []float64{55, 57, 60, 62, 66, 70.5}},
+		{Name: "ap-south", XValues: xvalues, YValues: []float64{20, 25, 27, 26, 30, 31.0}},
+	}
+
+	graph := chart.Chart{
+		Background: chart.Style{
+			Padding: chart.Box{Top: 20, Left: 20},
+		},
+	}
+	for index := range series {
+		series[index].Style = chart.Style{StrokeColor: chart.GetDefaultColor(index)}
+		graph.Series = append(graph.Series, series[index])
+	}
+	for _, s := range series {
+		graph.Series = append(graph.Series, chart.LastValueAnnotationSeries(s))
+	}
+	graph.Elements = []chart.Renderable{
+		chart.LegendLeft(&graph),
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
@@ -0,0 +1,46 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	/*
+		A bubble series adds a third and fourth dimension to a scatter plot; here each service is placed by its
+		request rate and latency, sized by its number of hosts and colored by its error rate.
+	*/
+	series := chart.BubbleSeries{
+		Name:               "Services",
+		XValues:            []float64{120, 340, 560, 810, 950, 1200, 1430, 1610, 1850},
+		YValues:            []float64{45, 120, 80, 210, 65, 150, 95, 260, 130},
+		Sizes:              []float64{4, 18, 9, 42, 6, 120, 30, 75, 12},
+		ColorValues:        []float64{0.1, 0.8, 0.3, 2.4, 0.2, 1.1, 0.6, 3.2, 0.4},
+		SizeValueFormatter: chart.IntValueFormatter,
+	}
+
+	graph := chart.Chart{
+		Background: chart.Style{
+			Padding: chart.Box{Top: 40, Left: 40, Right: 40, Bottom: 20},
+		},
+		XAxis: chart.XAxis{
+			Name:  "Requests per Second",
+			Range: &chart.ContinuousRange{Min: 0, Max: 2000},
+		},
+		YAxis: chart.YAxis{
+			Name:  "Latency (ms)",
+			Range: &chart.ContinuousRange{Min: 0, Max: 300},
+		},
+		Series: []chart.Series{series},
+	}
+	graph.Elements = []chart.Renderable{
+		chart.BubbleSizeLegend(series),
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
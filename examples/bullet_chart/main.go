@@ -0,0 +1,34 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	/*
+		A bullet chart shows a measure against a target over qualitative ranges; here the revenue to date is compared
+		to the goal for the year, over ranges for poor, satisfactory and good.
+	*/
+	graph := chart.BulletChart{
+		Title:    "Revenue",
+		Subtitle: "USD (1,000s)",
+		Width:    400,
+		Height:   60,
+		Ranges: []chart.BulletRange{
+			{Max: 150},
+			{Max: 225},
+			{Max: 300},
+		},
+		Measure:        270,
+		Target:         250,
+		ValueFormatter: chart.IntValueFormatter,
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
@@ -0,0 +1,54 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func main() {
+	/*
+		A calendar heatmap shows a value per day; here the deploys per day over two years are colored by bucket,
+		with a column per week and a row per weekday.
+	*/
+	random := rand.New(rand.NewSource(42))
+
+	var times []time.Time
+	var values []float64
+	for day := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC); day.Year() < 2026; day = day.AddDate(0, 0, 1) {
+		// there are fewer deploys on weekends, and none on most of them.
+		deploys := random.Intn(9)
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			deploys = random.Intn(3) - 1
+		}
+		if deploys <= 0 {
+			continue
+		}
+		times = append(times, day)
+		values = append(values, float64(deploys))
+	}
+
+	graph := chart.CalendarHeatmapChart{
+		Title:     "Deploys per Day",
+		Width:     1024,
+		Height:    360,
+		WeekStart: time.Monday,
+		Buckets: []chart.CalendarHeatmapBucket{
+			{Min: 1, Style: chart.Style{FillColor: drawing.ColorFromHex("9be9a8")}},
+			{Min: 3, Style: chart.Style{FillColor: drawing.ColorFromHex("40c463")}},
+			{Min: 5, Style: chart.Style{FillColor: drawing.ColorFromHex("30a14e")}},
+			{Min: 7, Style: chart.Style{FillColor: drawing.ColorFromHex("216e39")}},
+		},
+		Times:  times,
+		Values: values,
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
@@ -0,0 +1,58 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func main() {
+	/*
+	   In this example we use a categorical x-axis on a regular chart, which lets us mix bars
+	   with line series (here a target line) plotted against the same categories.
+	*/
+
+	regions := &chart.CategoricalRange{
+		Categories: []string{"North", "South", "East", "West", "Central"},
+	}
+
+	graph := chart.Chart{
+		Background: chart.Style{
+			Padding: chart.Box{Top: 40},
+		},
+		XAxis: chart.XAxis{
+			Range: regions,
+		},
+		YAxis: chart.YAxis{
+			Name: "Orders",
+		},
+		Series: []chart.Series{
+			chart.BarSeries{
+				Name: "Orders",
+				Values: []chart.Value{
+					{Value: 120},
+					{Value: 95},
+					{Value: 143},
+					{Value: 80, Style: chart.Style{FillColor: drawing.ColorRed}},
+					{Value: 110},
+				},
+			},
+			chart.ContinuousSeries{
+				Name: "Target",
+				Style: chart.Style{
+					StrokeColor:     drawing.ColorBlack,
+					StrokeDashArray: []float64{5.0, 5.0},
+				},
+				XValues: []float64{regions.GetMin(), regions.GetMax()},
+				YValues: []float64{100, 100},
+			},
+		},
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
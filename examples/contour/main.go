@@ -0,0 +1,68 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"math"
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+// latency is a capacity model of the p99 latency in milliseconds at a request rate and a concurrency limit.
+func latency(rate, concurrency float64) float64 {
+	capacity := 1600 * concurrency / (concurrency + 8)
+	return 20 + concurrency/2 + 15*math.Pow(rate/capacity, 2)
+}
+
+func main() {
+	/*
+		A contour series draws the lines of equal value of a grid; here the latency of a service over
+		its request rate and concurrency limit, with the bands between the lines filled and a color bar
+		drawn in the left padding of the chart.
+	*/
+	var rates, concurrencies []float64
+	for rate := 0.0; rate <= 1600; rate += 40 {
+		rates = append(rates, rate)
+	}
+	for concurrency := 4.0; concurrency <= 64; concurrency += 2 {
+		concurrencies = append(concurrencies, concurrency)
+	}
+	values := make([][]float64, len(concurrencies))
+	for row, concurrency := range concurrencies {
+		values[row] = make([]float64, len(rates))
+		for column, rate := range rates {
+			values[row][column] = latency(rate, concurrency)
+		}
+	}
+
+	series := chart.ContourSeries{
+		Name:           "Latency",
+		XValues:        rates,
+		YValues:        concurrencies,
+		Values:         values,
+		Levels:         []float64{30, 40, 50, 60, 80, 100, 125, 150},
+		Filled:         true,
+		ValueFormatter: chart.IntValueFormatter,
+	}
+
+	graph := chart.Chart{
+		Background: chart.Style{
+			Padding: chart.Box{Top: 20, Left: 60, Right: 20, Bottom: 20},
+		},
+		XAxis: chart.XAxis{
+			Name:           "Requests per Second",
+			ValueFormatter: chart.IntValueFormatter,
+		},
+		YAxis: chart.YAxis{
+			Name:           "Concurrency Limit",
+			ValueFormatter: chart.IntValueFormatter,
+		},
+		Series: []chart.Series{series},
+	}
+	graph.Elements = []chart.Renderable{chart.ContourColorBar(series)}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
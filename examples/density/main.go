@@ -0,0 +1,70 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"math/rand"
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func main() {
+	/*
+		A density series draws a kernel density estimate of samples, a smooth alternative to a histogram;
+		here the same samples are estimated with a gaussian kernel as an area and an epanechnikov kernel as a line.
+	*/
+	random := rand.New(rand.NewSource(3))
+	samples := make([]float64, 500)
+	for index := range samples {
+		if index%3 == 0 {
+			samples[index] = 8 + random.NormFloat64()*1.5
+		} else {
+			samples[index] = 3 + random.NormFloat64()
+		}
+	}
+
+	graph := chart.Chart{
+		Background: chart.Style{
+			Padding: chart.Box{Top: 40},
+		},
+		XAxis: chart.XAxis{
+			Name: "Value",
+		},
+		YAxis: chart.YAxis{
+			Name: "Density",
+		},
+		Series: []chart.Series{
+			&chart.DensitySeries{
+				Name: "Gaussian",
+				Style: chart.Style{
+					StrokeColor: chart.ColorBlue,
+					FillColor:   chart.ColorBlue.WithAlpha(64),
+				},
+				Density: chart.KernelDensity{
+					Samples: samples,
+				},
+			},
+			&chart.DensitySeries{
+				Name: "Epanechnikov",
+				Style: chart.Style{
+					StrokeColor:     drawing.ColorBlack,
+					StrokeDashArray: []float64{5.0, 5.0},
+				},
+				Density: chart.KernelDensity{
+					Samples:           samples,
+					Kernel:            chart.EpanechnikovKernel,
+					BandwidthSelector: chart.ScottBandwidth,
+				},
+			},
+		},
+	}
+	graph.Elements = []chart.Renderable{
+		chart.LegendThin(&graph),
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
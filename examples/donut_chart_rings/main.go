@@ -0,0 +1,51 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	/*
+		Nested rings make a sunburst: the inner ring is requests by team, the outer ring is requests by service within each team.
+		The outer ring's values are ordered by team and add up to each team's value, so the slices line up,
+		and `InheritColors` colors each service like its team.
+	*/
+	donut := chart.DonutChart{
+		Width:         512,
+		Height:        512,
+		StartAngle:    -90,
+		RingGap:       4,
+		CenterText:    "1,240",
+		CenterCaption: "requests / s",
+		Rings: []chart.DonutRing{
+			{
+				Values: []chart.Value{
+					{Value: 620, Label: "Search"},
+					{Value: 380, Label: "Checkout"},
+					{Value: 240, Label: "Accounts"},
+				},
+			},
+			{
+				InheritColors: true,
+				Thickness:     0.75,
+				Values: []chart.Value{
+					{Value: 400, Label: "query"},
+					{Value: 150, Label: "suggest"},
+					{Value: 70, Label: "index"},
+					{Value: 250, Label: "cart"},
+					{Value: 130, Label: "payments"},
+					{Value: 180, Label: "login"},
+					{Value: 60, Label: "profile"},
+				},
+			},
+		},
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	donut.Render(chart.PNG, f)
+}
@@ -0,0 +1,69 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"math"
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	usageSeries := usage()
+
+	forecastSeries := &chart.ForecastSeries{
+		Name:         "Forecast",
+		InnerSeries:  usageSeries,
+		Method:       chart.ForecastMethodHoltWinters,
+		SeasonLength: 12,
+		Steps:        18,
+		Style: chart.Style{
+			StrokeColor: chart.ColorRed,
+			StrokeWidth: 2,
+		},
+	}
+
+	graph := chart.Chart{
+		Background: chart.Style{
+			Padding: chart.Box{Top: 40},
+		},
+		XAxis: chart.XAxis{
+			ValueFormatter: chart.TimeValueFormatterWithFormat("Jan 2006"),
+		},
+		YAxis: chart.YAxis{
+			Name: "Storage (TB)",
+		},
+		Series: []chart.Series{
+			usageSeries,
+			forecastSeries,
+		},
+	}
+	graph.Elements = []chart.Renderable{
+		chart.LegendThin(&graph),
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
+
+// usage returns four years of monthly storage usage; growing, with a peak in the holiday season.
+func usage() chart.TimeSeries {
+	r := rand.New(rand.NewSource(3))
+	ts := chart.TimeSeries{
+		Name: "Usage",
+		Style: chart.Style{
+			StrokeColor: chart.ColorBlue,
+			StrokeWidth: 2,
+		},
+	}
+	for month := 0; month < 48; month++ {
+		ts.XValues = append(ts.XValues, time.Date(2021, time.Month(month+1), 1, 0, 0, 0, 0, time.UTC))
+		season := 12 * math.Cos(2*math.Pi*float64(month-11)/12)
+		ts.YValues = append(ts.YValues, 120+2.5*float64(month)+season+r.NormFloat64()*4)
+	}
+	return ts
+}
@@ -0,0 +1,71 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"math"
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func main() {
+	graph := chart.Chart{
+		Background: chart.Style{
+			Padding: chart.Box{Top: 40},
+		},
+		XAxis: chart.XAxis{
+			Range: &chart.ContinuousRange{Min: -2 * math.Pi, Max: 2 * math.Pi},
+		},
+		YAxis: chart.YAxis{
+			Range: &chart.ContinuousRange{Min: -4, Max: 4},
+		},
+		Series: []chart.Series{
+			chart.FunctionSeries{
+				Name:     "tan(x)",
+				Function: math.Tan,
+				Style: chart.Style{
+					StrokeColor: chart.ColorBlue,
+					StrokeWidth: 2,
+				},
+			},
+			chart.FunctionSeries{
+				Name:     "√x",
+				Function: math.Sqrt,
+				Style: chart.Style{
+					StrokeColor: chart.ColorGreen,
+					StrokeWidth: 2,
+				},
+			},
+			chart.FunctionSeries{
+				Name:     "floor(x) / 2",
+				Function: func(x float64) float64 { return math.Floor(x) / 2 },
+				Style: chart.Style{
+					StrokeColor: chart.ColorOrange,
+					StrokeWidth: 2,
+				},
+			},
+			chart.ParametricSeries{
+				Name: "Rose",
+				Function: func(t float64) (x, y float64) {
+					r := 3 * math.Cos(4*t)
+					return r * math.Cos(t), r * math.Sin(t)
+				},
+				Start: 0,
+				End:   2 * math.Pi,
+				Style: chart.Style{
+					StrokeColor: drawing.ColorFromHex("999999"),
+					StrokeWidth: 1,
+				},
+			},
+		},
+	}
+	graph.Elements = []chart.Renderable{
+		chart.LegendThin(&graph),
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
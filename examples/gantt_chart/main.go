@@ -0,0 +1,44 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"os"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	/*
+		A gantt chart draws tasks as time intervals on rows that share a time axis; here a deploy pipeline
+		is grouped into swimlanes by stage, with arrows for the dependencies between jobs.
+	*/
+	start := time.Date(2024, time.March, 12, 9, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return start.Add(time.Duration(minutes) * time.Minute)
+	}
+
+	graph := chart.GanttChart{
+		Title:  "Release 2024.03.12",
+		Width:  900,
+		Height: 420,
+		Now:    at(118),
+		Tasks: []chart.GanttTask{
+			{Name: "compile", Lane: "Build", Intervals: []chart.GanttInterval{{Start: at(0), End: at(14), Label: "go build"}}},
+			{Name: "image", Lane: "Build", Intervals: []chart.GanttInterval{{Start: at(14), End: at(26), Label: "docker"}}, DependsOn: []string{"compile"}},
+			{Name: "unit", Lane: "Test", Intervals: []chart.GanttInterval{{Start: at(14), End: at(41), Label: "go test"}}, DependsOn: []string{"compile"}},
+			{Name: "integration", Lane: "Test", Intervals: []chart.GanttInterval{
+				{Start: at(26), End: at(52), Label: "attempt 1"},
+				{Start: at(58), End: at(83), Label: "attempt 2"},
+			}, DependsOn: []string{"image"}},
+			{Name: "approval", Lane: "Deploy", Milestones: []chart.GanttMilestone{{Time: at(90), Label: "signed off"}}, DependsOn: []string{"unit", "integration"}},
+			{Name: "canary", Lane: "Deploy", Intervals: []chart.GanttInterval{{Start: at(90), End: at(125), Label: "5%"}}, DependsOn: []string{"approval"}},
+			{Name: "rollout", Lane: "Deploy", Intervals: []chart.GanttInterval{{Start: at(125), End: at(160), Label: "100%"}}, DependsOn: []string{"canary"}},
+		},
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
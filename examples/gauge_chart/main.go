@@ -0,0 +1,37 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func main() {
+	/*
+		A gauge chart shows a single value against a range; here the CPU usage of a host is shown on a 270 degree dial
+		with bands for the warning and critical thresholds.
+	*/
+	graph := chart.GaugeChart{
+		Title:  "web-01",
+		Width:  480,
+		Height: 420,
+		Min:    0,
+		Max:    100,
+		Value:  72,
+		Sweep:  270,
+		Bands: []chart.GaugeBand{
+			{Min: 0, Max: 60, Style: chart.Style{FillColor: drawing.ColorFromHex("5cb85c")}},
+			{Min: 60, Max: 85, Style: chart.Style{FillColor: drawing.ColorFromHex("f0ad4e")}},
+			{Min: 85, Max: 100, Style: chart.Style{FillColor: drawing.ColorFromHex("d9534f")}},
+		},
+		ValueFormatter: chart.IntValueFormatter,
+		Caption:        "CPU %",
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
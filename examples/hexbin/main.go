@@ -0,0 +1,57 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"math"
+	"math/rand"
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+// requests returns the sizes in kilobytes and latencies in milliseconds of a number of requests.
+func requests(count int) (sizes, latencies []float64) {
+	random := rand.New(rand.NewSource(1))
+	sizes, latencies = make([]float64, count), make([]float64, count)
+	for index := range sizes {
+		sizes[index] = math.Exp(3 + 0.6*random.NormFloat64())
+		latencies[index] = 20 + 1.5*sizes[index] + 12*math.Exp(0.4*random.NormFloat64())
+	}
+	return
+}
+
+func main() {
+	/*
+		A hexbin series aggregates too many points to draw one by one into hexagons colored by how many
+		points fell into them; the log count keeps the sparse hexagons apart from the empty canvas.
+	*/
+	sizes, latencies := requests(200000)
+
+	graph := chart.Chart{
+		Background: chart.Style{
+			Padding: chart.Box{Top: 20, Left: 60, Right: 20, Bottom: 20},
+		},
+		XAxis: chart.XAxis{
+			Name:           "Request Size (KB)",
+			ValueFormatter: chart.IntValueFormatter,
+		},
+		YAxis: chart.YAxis{
+			Name:           "Latency (ms)",
+			ValueFormatter: chart.IntValueFormatter,
+		},
+		Series: []chart.Series{
+			chart.HexbinSeries{
+				Name:      "Requests",
+				XValues:   sizes,
+				YValues:   latencies,
+				Aggregate: chart.BinAggregateLogCount,
+			},
+		},
+	}
+	graph.Elements = []chart.Renderable{chart.BinColorScale(&graph)}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
@@ -0,0 +1,62 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"math"
+	"math/rand"
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+// requests returns the sizes in kilobytes, latencies in milliseconds and cache hit ratios of a number of requests.
+func requests(count int) (sizes, latencies, hitRatios []float64) {
+	random := rand.New(rand.NewSource(1))
+	sizes, latencies, hitRatios = make([]float64, count), make([]float64, count), make([]float64, count)
+	for index := range sizes {
+		sizes[index] = math.Exp(3 + 0.6*random.NormFloat64())
+		hitRatios[index] = random.Float64()
+		latencies[index] = 20 + 1.5*sizes[index]*(1.2-hitRatios[index]) + 12*math.Exp(0.4*random.NormFloat64())
+	}
+	return
+}
+
+func main() {
+	/*
+		A 2d histogram series aggregates points into a grid of bins; here each bin is colored by the mean
+		cache hit ratio of the requests in it rather than by how many there are.
+	*/
+	sizes, latencies, hitRatios := requests(100000)
+
+	graph := chart.Chart{
+		Background: chart.Style{
+			Padding: chart.Box{Top: 20, Left: 60, Right: 20, Bottom: 20},
+		},
+		XAxis: chart.XAxis{
+			Name:           "Request Size (KB)",
+			ValueFormatter: chart.IntValueFormatter,
+		},
+		YAxis: chart.YAxis{
+			Name:           "Latency (ms)",
+			ValueFormatter: chart.IntValueFormatter,
+		},
+		Series: []chart.Series{
+			chart.Histogram2DSeries{
+				Name:           "Requests",
+				XValues:        sizes,
+				YValues:        latencies,
+				Values:         hitRatios,
+				XBins:          40,
+				YBins:          25,
+				Aggregate:      chart.BinAggregateMean,
+				ValueFormatter: chart.PercentValueFormatter,
+			},
+		},
+	}
+	graph.Elements = []chart.Renderable{chart.BinColorScale(&graph)}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
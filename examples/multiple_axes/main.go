@@ -0,0 +1,59 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+
+	/*
+	   In this example we plot three series with very different scales, giving each its own y-axis.
+
+	   The primary and secondary axes are used as normal, and a third axis is added with `YAxes`;
+	   series are mapped to it with `chart.YAxisAdditional(0)`. Axes that share a side of the canvas
+	   are stacked so they don't overlap.
+	*/
+
+	xvalues := []float64{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0}
+
+	graph := chart.Chart{
+		YAxis: chart.YAxis{
+			Name: "CPU %",
+		},
+		YAxisSecondary: chart.YAxis{
+			Name: "Requests / sec",
+		},
+		YAxes: []chart.YAxis{
+			{
+				Name: "Latency (ms)",
+			},
+		},
+		Series: []chart.Series{
+			chart.ContinuousSeries{
+				Name:    "CPU %",
+				XValues: xvalues,
+				YValues: []float64{12, 18, 25, 40, 65, 72, 70, 55},
+			},
+			chart.ContinuousSeries{
+				Name:    "Requests / sec",
+				YAxis:   chart.YAxisSecondary,
+				XValues: xvalues,
+				YValues: []float64{1200, 1800, 2600, 4100, 6400, 7000, 6900, 5200},
+			},
+			chart.ContinuousSeries{
+				Name:    "Latency (ms)",
+				YAxis:   chart.YAxisAdditional(0),
+				XValues: xvalues,
+				YValues: []float64{0.8, 0.9, 1.1, 1.6, 3.2, 4.8, 4.1, 2.0},
+			},
+		},
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
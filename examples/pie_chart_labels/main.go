@@ -0,0 +1,41 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	/*
+		Labels can be drawn outside of the pie with leader lines, which keeps small slices readable.
+		Slices below `OtherThreshold` are merged into a single "Other" slice, and `Exploded` moves slices out from the center.
+	*/
+	pie := chart.PieChart{
+		Width:          640,
+		Height:         480,
+		StartAngle:     -90,
+		LabelPosition:  chart.PieLabelPositionOutside,
+		ValueFormatter: chart.PercentValueFormatter,
+		OtherThreshold: 0.03,
+		Exploded:       []int{1},
+		Values: []chart.Value{
+			{Value: 40, Label: "Chrome"},
+			{Value: 20, Label: "Safari"},
+			{Value: 10, Label: "Edge"},
+			{Value: 8, Label: "Firefox"},
+			{Value: 5, Label: "Samsung Internet"},
+			{Value: 4, Label: "Opera"},
+			{Value: 1.5, Label: "UC Browser"},
+			{Value: 1, Label: "Brave"},
+			{Value: 0.8, Label: "Vivaldi"},
+			{Value: 0.5, Label: "Yandex"},
+		},
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	pie.Render(chart.PNG, f)
+}
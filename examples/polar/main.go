@@ -0,0 +1,69 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	/*
+		A polar chart wraps the x-axis around a circle and uses the y-axis as the distance from its center;
+		here the requests per hour of two days are drawn around the clock, with midnight at the top.
+	*/
+	hours := []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24}
+	weekday := []float64{120, 80, 60, 50, 55, 90, 210, 480, 820, 950, 990, 1010, 940, 980, 1020, 990, 930, 860, 640, 480, 390, 300, 220, 160, 120}
+	weekend := []float64{260, 220, 170, 120, 90, 80, 100, 160, 260, 420, 560, 640, 690, 700, 680, 650, 610, 580, 560, 540, 500, 440, 380, 320, 260}
+
+	var ticks []chart.Tick
+	for hour := 0; hour <= 24; hour += 3 {
+		ticks = append(ticks, chart.Tick{Value: float64(hour), Label: fmt.Sprintf("%02d:00", hour%24)})
+	}
+
+	graph := chart.Chart{
+		Title:  "Requests per Hour",
+		Width:  600,
+		Height: 600,
+		Polar:  true,
+		XAxis: chart.XAxis{
+			Range: &chart.ContinuousRange{Min: 0, Max: 24},
+			Ticks: ticks,
+		},
+		YAxis: chart.YAxis{
+			Range:          &chart.ContinuousRange{Min: 0, Max: 1200},
+			ValueFormatter: chart.IntValueFormatter,
+		},
+		Series: []chart.Series{
+			chart.ContinuousSeries{
+				Name:    "Weekday",
+				XValues: hours,
+				YValues: weekday,
+				Style: chart.Style{
+					StrokeColor: chart.ColorBlue,
+					StrokeWidth: 2,
+					FillColor:   chart.ColorBlue.WithAlpha(48),
+				},
+			},
+			chart.ContinuousSeries{
+				Name:    "Weekend",
+				XValues: hours,
+				YValues: weekend,
+				Style: chart.Style{
+					StrokeColor: chart.ColorRed,
+					StrokeWidth: 2,
+					FillColor:   chart.ColorRed.WithAlpha(48),
+				},
+			},
+		},
+	}
+	graph.Elements = []chart.Renderable{
+		chart.LegendLeft(&graph),
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
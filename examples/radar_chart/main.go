@@ -0,0 +1,41 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	/*
+		A radar chart compares series across several axes; here service scorecards are compared on a shared 0-100 scale,
+		except for the incident count which has its own range.
+	*/
+	graph := chart.RadarChart{
+		Title:  "Service Scorecards",
+		Width:  640,
+		Height: 520,
+		Axes: []chart.RadarAxis{
+			{Name: "Availability"},
+			{Name: "Latency"},
+			{Name: "Test Coverage"},
+			{Name: "Incidents", Range: &chart.ContinuousRange{Min: 0, Max: 10}, ValueFormatter: chart.IntValueFormatter},
+			{Name: "Documentation"},
+			{Name: "On-call Health"},
+		},
+		Series: []chart.RadarSeries{
+			{Name: "payments", Values: []float64{95, 70, 82, 3, 60, 75}},
+			{Name: "search", Values: []float64{88, 92, 54, 7, 40, 55}},
+			{Name: "accounts", Values: []float64{72, 65, 90, 1, 85, 90}},
+		},
+	}
+	graph.Elements = []chart.Renderable{
+		chart.RadarLegend(&graph),
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
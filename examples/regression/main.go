@@ -0,0 +1,77 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"math"
+	"math/rand"
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	/*
+		Regression series fit a curve to an inner series and expose the fit; here the daily active users of a
+		product are fitted with an exponential curve and its 95% prediction band, and with a LOESS curve that
+		follows the values without a model, like the bump of a launch. The annotation shows the fitted equation and its R².
+	*/
+	random := rand.New(rand.NewSource(1))
+	var days, users []float64
+	for day := 1.0; day <= 90; day++ {
+		days = append(days, day)
+		launch := 1 + 0.4*math.Exp(-(day-55)*(day-55)/50)
+		users = append(users, 120*math.Exp(0.025*day)*launch*math.Exp(0.1*random.NormFloat64()))
+	}
+
+	mainSeries := chart.ContinuousSeries{
+		Name: "Daily Active Users",
+		Style: chart.Style{
+			StrokeWidth: chart.Disabled,
+			DotWidth:    3,
+		},
+		XValues: days,
+		YValues: users,
+	}
+
+	exponentialSeries := &chart.ExponentialRegressionSeries{
+		Name:        "Exponential Fit",
+		InnerSeries: mainSeries,
+		Band:        chart.RegressionBandPrediction,
+	}
+
+	loessSeries := &chart.LOESSRegressionSeries{
+		Name:        "LOESS",
+		InnerSeries: mainSeries,
+		Span:        0.3,
+		Style: chart.Style{
+			StrokeColor:     chart.ColorAlternateGray,
+			StrokeDashArray: []float64{5, 5},
+		},
+	}
+
+	graph := chart.Chart{
+		Background: chart.Style{
+			Padding: chart.Box{Top: 40},
+		},
+		XAxis: chart.XAxis{
+			Name:           "Day",
+			ValueFormatter: chart.IntValueFormatter,
+		},
+		YAxis: chart.YAxis{
+			Name:           "Users",
+			ValueFormatter: chart.IntValueFormatter,
+		},
+		Series: []chart.Series{
+			mainSeries,
+			exponentialSeries,
+			loessSeries,
+			chart.RegressionAnnotationSeries(exponentialSeries),
+		},
+	}
+	graph.Elements = []chart.Renderable{chart.LegendThin(&graph)}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
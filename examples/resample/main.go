@@ -0,0 +1,81 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"math"
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func main() {
+	raw := samples()
+
+	meanSeries := raw.Resample(chart.ResampleFiveMinutes, chart.ResampleMean, chart.ResampleFillNone)
+	meanSeries.Name = "Mean (5m)"
+	meanSeries.Style = chart.Style{
+		StrokeColor: chart.ColorBlue,
+		StrokeWidth: 2,
+	}
+
+	p95Series := raw.Resample(chart.ResampleFiveMinutes, chart.ResampleP95, chart.ResampleFillNone)
+	p95Series.Name = "p95 (5m)"
+	p95Series.Style = chart.Style{
+		StrokeColor: chart.ColorRed,
+		StrokeWidth: 2,
+	}
+
+	raw.Name = "Samples"
+	raw.Style = chart.Style{
+		StrokeWidth: chart.Disabled,
+		DotWidth:    1.5,
+		DotColor:    drawing.ColorFromHex("bbbbbb"),
+	}
+
+	graph := chart.Chart{
+		Background: chart.Style{
+			Padding: chart.Box{Top: 40},
+		},
+		XAxis: chart.XAxis{
+			ValueFormatter: chart.TimeValueFormatterWithFormat("15:04"),
+		},
+		YAxis: chart.YAxis{
+			Name: "Latency (ms)",
+		},
+		Series: []chart.Series{
+			raw,
+			meanSeries,
+			p95Series,
+		},
+	}
+	graph.Elements = []chart.Renderable{
+		chart.LegendThin(&graph),
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
+
+// samples returns request latencies sampled at irregular times over six hours, with a half hour outage.
+func samples() chart.TimeSeries {
+	r := rand.New(rand.NewSource(7))
+	start := time.Date(2024, 5, 17, 9, 0, 0, 0, time.UTC)
+	outageStart, outageEnd := start.Add(150*time.Minute), start.Add(185*time.Minute)
+
+	var ts chart.TimeSeries
+	for t := start; t.Before(start.Add(6 * time.Hour)); t = t.Add(time.Duration(r.ExpFloat64() * float64(40*time.Second))) {
+		if !t.Before(outageStart) && t.Before(outageEnd) {
+			continue
+		}
+		hours := t.Sub(start).Hours()
+		base := 40 + 15*math.Sin(hours*math.Pi/3)
+		ts.XValues = append(ts.XValues, t)
+		ts.YValues = append(ts.YValues, base*math.Exp(r.NormFloat64()*0.35))
+	}
+	return ts
+}
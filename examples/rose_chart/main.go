@@ -0,0 +1,39 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func main() {
+	/*
+		A rose chart draws each value as a wedge whose area is proportional to the value;
+		here it shows how many hours of the month the wind blew from each direction.
+	*/
+	directions := []string{"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE", "S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW"}
+	hours := []float64{38, 22, 14, 9, 12, 18, 26, 31, 44, 58, 83, 96, 77, 52, 41, 36}
+
+	var values []chart.Value
+	for index, direction := range directions {
+		values = append(values, chart.Value{Label: direction, Value: hours[index]})
+	}
+
+	graph := chart.RoseChart{
+		Title:  "Wind Direction (hours)",
+		Width:  600,
+		Height: 600,
+		SliceStyle: chart.Style{
+			FillColor: drawing.ColorFromHex("2b8cbe"),
+		},
+		ValueFormatter: chart.IntValueFormatter,
+		Values:         values,
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
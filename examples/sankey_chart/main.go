@@ -0,0 +1,42 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	/*
+		A sankey chart shows flows between nodes; here requests per second flow from the edge through the services
+		that handle them to the stores they read from.
+	*/
+	graph := chart.SankeyChart{
+		Title:          "Requests per Second",
+		Width:          1024,
+		Height:         520,
+		ValueFormatter: chart.IntValueFormatter,
+		Links: []chart.SankeyLink{
+			{Source: "edge", Target: "gateway", Value: 1200},
+			{Source: "edge", Target: "static", Value: 300},
+			{Source: "gateway", Target: "auth", Value: 350},
+			{Source: "gateway", Target: "catalog", Value: 550},
+			{Source: "gateway", Target: "orders", Value: 300},
+			{Source: "auth", Target: "users", Value: 350},
+			{Source: "catalog", Target: "search", Value: 250},
+			{Source: "catalog", Target: "cache", Value: 300},
+			{Source: "orders", Target: "users", Value: 80},
+			{Source: "orders", Target: "postgres", Value: 220},
+			{Source: "users", Target: "postgres", Value: 300},
+			{Source: "users", Target: "cache", Value: 130},
+			{Source: "search", Target: "elasticsearch", Value: 250},
+			{Source: "static", Target: "cdn", Value: 300},
+		},
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
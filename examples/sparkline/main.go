@@ -0,0 +1,30 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	/*
+		A sparkline is a tiny chart without axes or padding, sized to sit in a line of text or a table cell;
+		here the response times of the last day are drawn with the slowest, the fastest and the latest marked.
+	*/
+	graph := chart.Sparkline{
+		Type:    chart.SparklineTypeArea,
+		Width:   100,
+		Height:  20,
+		Markers: chart.SparklineMarkerMin | chart.SparklineMarkerMax | chart.SparklineMarkerLast,
+		Values: []float64{
+			112, 118, 109, 121, 135, 128, 142, 139, 151, 148, 133, 126,
+			119, 124, 131, 144, 162, 158, 147, 138, 129, 122, 117, 125,
+		},
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
@@ -0,0 +1,44 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	/*
+	   In this example we have more categories than there is room for horizontal labels.
+
+	   The x-axis detects that the labels would overlap and rotates them; set `TickLabelLayout`
+	   to `chart.TickLabelLayoutThin` to instead only draw every nth label, or `chart.TickLabelLayoutNone`
+	   to draw the labels as is.
+	*/
+
+	var categories []string
+	var values []chart.Value
+	for index := 0; index < 16; index++ {
+		categories = append(categories, fmt.Sprintf("Warehouse %d", index+1))
+		values = append(values, chart.Value{Value: float64(20 + (index*37)%50)})
+	}
+
+	graph := chart.Chart{
+		Width: 640,
+		Background: chart.Style{
+			Padding: chart.Box{Top: 20},
+		},
+		XAxis: chart.XAxis{
+			Range: &chart.CategoricalRange{Categories: categories},
+		},
+		Series: []chart.Series{
+			chart.BarSeries{Values: values},
+		},
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
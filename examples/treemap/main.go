@@ -0,0 +1,51 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	/*
+		A treemap draws hierarchical values as nested rectangles; groups are colored from the color palette
+		and labels that don't fit their rectangle are truncated.
+	*/
+	treemap := chart.TreemapChart{
+		Title:  "Monthly Cloud Cost",
+		Width:  800,
+		Height: 600,
+		ValueFormatter: func(v interface{}) string {
+			return chart.FloatValueFormatterWithFormat(v, "$%0.0fk")
+		},
+		Values: []chart.TreemapValue{
+			{Label: "Compute", Children: []chart.TreemapValue{
+				{Label: "web", Value: 42},
+				{Label: "batch jobs", Value: 28},
+				{Label: "ci runners", Value: 12},
+				{Label: "bastion", Value: 1},
+			}},
+			{Label: "Storage", Children: []chart.TreemapValue{
+				{Label: "object storage", Value: 24},
+				{Label: "block volumes", Value: 11},
+				{Label: "snapshots and backups", Value: 6},
+			}},
+			{Label: "Databases", Children: []chart.TreemapValue{
+				{Label: "primary cluster", Value: 30},
+				{Label: "read replicas", Value: 14},
+				{Label: "cache", Value: 5},
+			}},
+			{Label: "Network", Children: []chart.TreemapValue{
+				{Label: "egress", Value: 9},
+				{Label: "load balancers", Value: 4},
+				{Label: "dns", Value: 0.5},
+			}},
+		},
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	treemap.Render(chart.PNG, f)
+}
@@ -0,0 +1,56 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"math/rand"
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	/*
+		A violin series shows the distribution of the samples of each category; here the response times of three
+		regions, one of which has a second, slower mode, are drawn with a box plot inside each violin.
+	*/
+	random := rand.New(rand.NewSource(7))
+	normal := func(count int, mean, stddev float64) []float64 {
+		samples := make([]float64, count)
+		for index := range samples {
+			samples[index] = mean + random.NormFloat64()*stddev
+		}
+		return samples
+	}
+
+	regions := &chart.CategoricalRange{
+		Categories: []string{"us-east", "eu-west", "ap-south"},
+	}
+
+	graph := chart.Chart{
+		Background: chart.Style{
+			Padding: chart.Box{Top: 40},
+		},
+		XAxis: chart.XAxis{
+			Range: regions,
+		},
+		YAxis: chart.YAxis{
+			Name: "Response Time (ms)",
+		},
+		Series: []chart.Series{
+			chart.ViolinSeries{
+				Name:  "Response Time",
+				Inner: chart.ViolinInnerBox,
+				Violins: []chart.Violin{
+					{Label: "us-east", Samples: normal(400, 120, 15)},
+					{Label: "eu-west", Samples: append(normal(300, 110, 12), normal(100, 180, 10)...)},
+					{Label: "ap-south", Samples: normal(400, 150, 30)},
+				},
+			},
+		},
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
@@ -0,0 +1,46 @@
+package main
+
+//go:generate go run main.go
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func main() {
+	/*
+		A waterfall chart shows how a starting value changes through positive and negative contributions;
+		here the cash position of a quarter is walked from the opening balance to the closing balance,
+		with a subtotal after the operating items.
+	*/
+	graph := chart.WaterfallChart{
+		Title:      "Q3 Cash Flow",
+		Width:      800,
+		Height:     480,
+		BarWidth:   56,
+		BarSpacing: 24,
+		YAxis: chart.YAxis{
+			ValueFormatter: func(v interface{}) string {
+				return fmt.Sprintf("%.0fk", v.(float64)/1000)
+			},
+		},
+		Bars: []chart.WaterfallBar{
+			{Label: "Opening", Value: 420000, Type: chart.WaterfallBarTypeStart},
+			{Label: "Sales", Value: 310000},
+			{Label: "Services", Value: 95000},
+			{Label: "Payroll", Value: -240000},
+			{Label: "Rent", Value: -45000},
+			{Label: "Operating", Type: chart.WaterfallBarTypeTotal},
+			{Label: "Equipment", Value: -130000},
+			{Label: "Loan", Value: 80000},
+			{Label: "Taxes", Value: -60000},
+			{Label: "Closing", Type: chart.WaterfallBarTypeTotal},
+		},
+	}
+
+	f, _ := os.Create("output.png")
+	defer f.Close()
+	graph.Render(chart.PNG, f)
+}
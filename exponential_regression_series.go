@@ -0,0 +1,132 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series                = (*ExponentialRegressionSeries)(nil)
+	_ ValuesProvider        = (*ExponentialRegressionSeries)(nil)
+	_ BoundedValuesProvider = (*ExponentialRegressionSeries)(nil)
+	_ FirstValuesProvider   = (*ExponentialRegressionSeries)(nil)
+	_ LastValuesProvider    = (*ExponentialRegressionSeries)(nil)
+	_ RegressionProvider    = (*ExponentialRegressionSeries)(nil)
+)
+
+// exponentialRegressionModel fits y = a*e^(b*x).
+var exponentialRegressionModel = linearizedRegressionModel{
+	Name:       "exponential",
+	TransformX: regressionIdentity,
+	TransformY: math.Log,
+	InverseY:   math.Exp,
+	PositiveY:  true,
+}
+
+// ExponentialRegressionSeries draws the exponential curve y = a*e^(b*x) fitted to the values of an inner series.
+//
+// The curve is fitted as a line through the logarithms of the y-values, so they must be positive.
+type ExponentialRegressionSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Limit       int
+	Offset      int
+	InnerSeries ValuesProvider
+
+	// Band is the band drawn around the curve with the `Confidence` level, which defaults to 95%.
+	Band       RegressionBand
+	BandStyle  Style
+	Confidence float64
+
+	regression *regression
+}
+
+// GetName returns the name of the series.
+func (ers ExponentialRegressionSeries) GetName() string {
+	return ers.Name
+}
+
+// GetStyle returns the line style.
+func (ers ExponentialRegressionSeries) GetStyle() Style {
+	return ers.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ers ExponentialRegressionSeries) GetYAxis() YAxisType {
+	return ers.YAxis
+}
+
+// GetConfidence returns the confidence level of the band or the default.
+func (ers ExponentialRegressionSeries) GetConfidence() float64 {
+	if ers.Confidence <= 0 || ers.Confidence >= 1 {
+		return DefaultRegressionConfidence
+	}
+	return ers.Confidence
+}
+
+// Len returns the number of values in the window of the inner series.
+func (ers *ExponentialRegressionSeries) Len() int {
+	return ers.getRegression().Len()
+}
+
+// GetValues returns a value of the inner series and its fitted value.
+func (ers *ExponentialRegressionSeries) GetValues(index int) (x, y float64) {
+	return ers.getRegression().GetValues(index)
+}
+
+// GetBoundedValues returns a value of the inner series and the band at it.
+func (ers *ExponentialRegressionSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	return ers.getRegression().GetBoundedValues(index)
+}
+
+// GetFirstValues returns the first value of the window and its fitted value.
+func (ers *ExponentialRegressionSeries) GetFirstValues() (x, y float64) {
+	return ers.getRegression().GetFirstValues()
+}
+
+// GetLastValues returns the last value of the window and its fitted value.
+func (ers *ExponentialRegressionSeries) GetLastValues() (x, y float64) {
+	return ers.getRegression().GetLastValues()
+}
+
+// GetCoefficients returns the a and b coefficients of y = a*e^(b*x).
+func (ers *ExponentialRegressionSeries) GetCoefficients() []float64 {
+	return ers.getRegression().Coefficients
+}
+
+// GetRSquared returns the coefficient of determination of the fitted values.
+func (ers *ExponentialRegressionSeries) GetRSquared() float64 {
+	return ers.getRegression().GetRSquared()
+}
+
+// GetResiduals returns the differences between the values and the fitted values.
+func (ers *ExponentialRegressionSeries) GetResiduals() []float64 {
+	return ers.getRegression().GetResiduals()
+}
+
+// GetEquation returns the fitted equation.
+func (ers *ExponentialRegressionSeries) GetEquation() string {
+	coefficients := ers.GetCoefficients()
+	return fmt.Sprintf("y = %se^(%sx)", formatRegressionCoefficient(coefficients[0]), formatRegressionCoefficient(coefficients[1]))
+}
+
+// Render renders the series.
+func (ers *ExponentialRegressionSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := ers.Style.InheritFrom(defaults)
+	drawRegression(r, canvasBox, xrange, yrange, style, ers.BandStyle, ers.Band, ers.getRegression())
+}
+
+// Validate validates the series.
+func (ers *ExponentialRegressionSeries) Validate() error {
+	return validateLinearizedRegression(exponentialRegressionModel, ers.InnerSeries, ers.Offset, ers.Limit)
+}
+
+func (ers *ExponentialRegressionSeries) getRegression() *regression {
+	if ers.regression == nil {
+		ers.regression = fitLinearizedRegression(exponentialRegressionModel, ers.InnerSeries, ers.Offset, ers.Limit, ers.Band, ers.GetConfidence())
+	}
+	return ers.regression
+}
@@ -0,0 +1,368 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// ForecastMethod is an enum for the exponential smoothing a forecast series fits.
+type ForecastMethod int
+
+const (
+	// ForecastMethodSimple smooths the level of the values; the forecast is flat.
+	ForecastMethodSimple ForecastMethod = 0
+	// ForecastMethodHolt smooths the level and the trend of the values (Holt's linear method); the forecast
+	// follows the trend.
+	ForecastMethodHolt ForecastMethod = 1
+	// ForecastMethodHoltWinters smooths the level, the trend and an additive season of the values (the
+	// Holt-Winters method); the forecast follows the trend and repeats the season.
+	ForecastMethodHoltWinters ForecastMethod = 2
+)
+
+// Interface Assertions.
+var (
+	_ Series                    = (*ForecastSeries)(nil)
+	_ ValuesProvider            = (*ForecastSeries)(nil)
+	_ BoundedValuesProvider     = (*ForecastSeries)(nil)
+	_ FirstValuesProvider       = (*ForecastSeries)(nil)
+	_ LastValuesProvider        = (*ForecastSeries)(nil)
+	_ BoundedLastValuesProvider = (*ForecastSeries)(nil)
+)
+
+// ForecastSeries forecasts the values of an inner series past its end with exponential smoothing, and draws the
+// forecast as a dashed line from the last value with a band of the prediction interval around it.
+//
+// The x-values of the forecast are spaced as the x-values of the inner series are on average, so the inner
+// series should be evenly spaced, as a `TimeSeries` resampled into intervals is.
+//
+// The smoothing factors that aren't set are fitted to the inner series by minimizing the squared errors of
+// predicting each value from those before it.
+type ForecastSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	InnerSeries ValuesProvider
+	Method      ForecastMethod
+
+	// Steps is the number of values forecast past the end of the inner series.
+	Steps int
+	// SeasonLength is the number of values in a season of the Holt-Winters method.
+	SeasonLength int
+
+	// Alpha, Beta and Gamma are the smoothing factors of the level, trend and season, between 0 and 1.
+	Alpha float64
+	Beta  float64
+	Gamma float64
+
+	// Confidence is the confidence level of the prediction interval, which defaults to 95%.
+	Confidence float64
+	BandStyle  Style
+
+	forecast *forecast
+}
+
+// forecast is the values forecast by a forecast series, starting at the last value of its inner series.
+type forecast struct {
+	XValues []float64
+	YValues []float64
+	Lower   []float64
+	Upper   []float64
+
+	Alpha, Beta, Gamma float64
+}
+
+// GetName returns the name of the series.
+func (fs ForecastSeries) GetName() string {
+	return fs.Name
+}
+
+// GetStyle returns the line style.
+func (fs ForecastSeries) GetStyle() Style {
+	return fs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (fs ForecastSeries) GetYAxis() YAxisType {
+	return fs.YAxis
+}
+
+// GetSteps returns the number of values forecast or the default.
+func (fs ForecastSeries) GetSteps() int {
+	if fs.Steps <= 0 {
+		return DefaultForecastSteps
+	}
+	return fs.Steps
+}
+
+// GetConfidence returns the confidence level of the prediction interval or the default.
+func (fs ForecastSeries) GetConfidence() float64 {
+	if fs.Confidence <= 0 || fs.Confidence >= 1 {
+		return DefaultForecastConfidence
+	}
+	return fs.Confidence
+}
+
+// GetParameters returns the smoothing factors of the level, trend and season, as set or as fitted.
+func (fs *ForecastSeries) GetParameters() (alpha, beta, gamma float64) {
+	f := fs.getForecast()
+	return f.Alpha, f.Beta, f.Gamma
+}
+
+// Len returns the number of values; the last value of the inner series and the forecast values.
+func (fs *ForecastSeries) Len() int {
+	return len(fs.getForecast().XValues)
+}
+
+// GetValues returns a forecast value; the first is the last value of the inner series.
+func (fs *ForecastSeries) GetValues(index int) (x, y float64) {
+	f := fs.getForecast()
+	return f.XValues[index], f.YValues[index]
+}
+
+// GetBoundedValues returns the prediction interval of a forecast value.
+func (fs *ForecastSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	f := fs.getForecast()
+	return f.XValues[index], f.Upper[index], f.Lower[index]
+}
+
+// GetFirstValues returns the last value of the inner series, where the forecast starts.
+func (fs *ForecastSeries) GetFirstValues() (x, y float64) {
+	if fs.Len() == 0 {
+		return
+	}
+	return fs.GetValues(0)
+}
+
+// GetLastValues returns the last forecast value.
+func (fs *ForecastSeries) GetLastValues() (x, y float64) {
+	if fs.Len() == 0 {
+		return
+	}
+	return fs.GetValues(fs.Len() - 1)
+}
+
+// GetBoundedLastValues returns the prediction interval of the last forecast value.
+func (fs *ForecastSeries) GetBoundedLastValues() (x, y1, y2 float64) {
+	if fs.Len() == 0 {
+		return
+	}
+	return fs.GetBoundedValues(fs.Len() - 1)
+}
+
+// Render renders the series.
+func (fs *ForecastSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if fs.Len() == 0 {
+		return
+	}
+	style := fs.Style.InheritFrom(defaults.InheritFrom(Style{
+		StrokeDashArray: []float64{5.0, 5.0},
+	}))
+	Draw.BoundedSeries(r, canvasBox, xrange, yrange, fs.BandStyle.InheritFrom(Style{
+		FillColor: style.GetStrokeColor().WithAlpha(DefaultForecastBandAlpha),
+	}), fs)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, fs)
+}
+
+// Validate validates the series.
+func (fs *ForecastSeries) Validate() error {
+	if fs.InnerSeries == nil {
+		return fmt.Errorf("forecast series requires InnerSeries to be set")
+	}
+	for _, parameter := range []float64{fs.Alpha, fs.Beta, fs.Gamma} {
+		if parameter < 0 || parameter > 1 {
+			return fmt.Errorf("forecast series requires its smoothing factors to be between 0 and 1")
+		}
+	}
+	switch fs.Method {
+	case ForecastMethodHolt:
+		if fs.InnerSeries.Len() < 2 {
+			return fmt.Errorf("forecast series requires at least 2 values for holt's method")
+		}
+	case ForecastMethodHoltWinters:
+		if fs.SeasonLength < 2 {
+			return fmt.Errorf("forecast series requires SeasonLength to be at least 2 for the holt-winters method")
+		}
+		if fs.InnerSeries.Len() < 2*fs.SeasonLength {
+			return fmt.Errorf("forecast series requires at least 2 seasons of values for the holt-winters method")
+		}
+	default:
+		if fs.InnerSeries.Len() < 1 {
+			return fmt.Errorf("forecast series requires at least 1 value")
+		}
+	}
+	return nil
+}
+
+func (fs *ForecastSeries) getForecast() *forecast {
+	if fs.forecast == nil {
+		fs.forecast = fs.computeForecast()
+	}
+	return fs.forecast
+}
+
+func (fs *ForecastSeries) computeForecast() *forecast {
+	f := &forecast{}
+	length := fs.InnerSeries.Len()
+	if fs.Validate() != nil {
+		return f
+	}
+	values := make([]float64, length)
+	for index := range values {
+		_, values[index] = fs.InnerSeries.GetValues(index)
+	}
+
+	f.Alpha, f.Beta, f.Gamma = fs.fitParameters(values)
+	smoothing := newExponentialSmoothing(values, fs.Method, fs.SeasonLength, f.Alpha, f.Beta, f.Gamma)
+
+	// the prediction intervals widen with the steps as the errors in the level, trend and season add up.
+	z := normalQuantile(1 - (1-fs.GetConfidence())/2)
+	variance := smoothing.Variance()
+	beta, gamma := f.Alpha*f.Beta, f.Gamma*(1-f.Alpha)
+	lastX, lastY := fs.InnerSeries.GetValues(length - 1)
+	f.XValues, f.YValues = append(f.XValues, lastX), append(f.YValues, lastY)
+	f.Lower, f.Upper = append(f.Lower, lastY), append(f.Upper, lastY)
+	for step := 1; step <= fs.GetSteps(); step++ {
+		h := float64(step)
+		factor := 1 + (h-1)*(f.Alpha*f.Alpha+f.Alpha*beta*h+beta*beta*h*(2*h-1)/6)
+		if fs.Method == ForecastMethodHoltWinters {
+			k := float64((step - 1) / fs.SeasonLength)
+			factor += gamma * k * (2*f.Alpha + gamma + beta*float64(fs.SeasonLength)*(k+1))
+		}
+		y := smoothing.Forecast(step)
+		spread := z * math.Sqrt(variance*factor)
+		f.XValues = append(f.XValues, getExtrapolatedXValue(fs.InnerSeries, length-1+step))
+		f.YValues = append(f.YValues, y)
+		f.Lower = append(f.Lower, y-spread)
+		f.Upper = append(f.Upper, y+spread)
+	}
+	return f
+}
+
+// fitParameters returns the smoothing factors of the series, fitting those that aren't set with a coarse grid
+// search followed by a pattern search.
+func (fs *ForecastSeries) fitParameters(values []float64) (alpha, beta, gamma float64) {
+	parameters := []float64{fs.Alpha, fs.Beta, fs.Gamma}
+	count := 1 + int(fs.Method)
+	var free []int
+	for index := 0; index < count; index++ {
+		if parameters[index] == 0 {
+			free = append(free, index)
+		}
+	}
+	if fs.Method != ForecastMethodHoltWinters {
+		parameters[2] = 0
+	}
+	if fs.Method == ForecastMethodSimple {
+		parameters[1] = 0
+	}
+	errors := func(p []float64) float64 {
+		return newExponentialSmoothing(values, fs.Method, fs.SeasonLength, p[0], p[1], p[2]).SSE
+	}
+
+	if len(free) > 0 {
+		grid := []float64{0.1, 0.3, 0.5, 0.7, 0.9}
+		best, bestErrors := append([]float64{}, parameters...), math.Inf(1)
+		candidate := append([]float64{}, parameters...)
+		for combination := 0; combination < int(math.Pow(float64(len(grid)), float64(len(free)))); combination++ {
+			for position, index := range free {
+				candidate[index] = grid[(combination/int(math.Pow(float64(len(grid)), float64(position))))%len(grid)]
+			}
+			if e := errors(candidate); e < bestErrors {
+				best, bestErrors = append(best[:0], candidate...), e
+			}
+		}
+
+		for step := 0.1; step >= 0.001; step /= 2 {
+			for improved := true; improved; {
+				improved = false
+				for _, index := range free {
+					for _, direction := range []float64{-1, 1} {
+						copy(candidate, best)
+						candidate[index] = math.Max(0.001, math.Min(0.999, best[index]+direction*step))
+						if e := errors(candidate); e < bestErrors {
+							best, bestErrors, improved = append(best[:0], candidate...), e, true
+						}
+					}
+				}
+			}
+		}
+		parameters = best
+	}
+	return parameters[0], parameters[1], parameters[2]
+}
+
+// exponentialSmoothing is the state of exponential smoothing after the last of a series of values.
+type exponentialSmoothing struct {
+	Level  float64
+	Trend  float64
+	Season []float64
+
+	// SSE is the sum of the squared errors of predicting each value from those before it, and Count the
+	// number of values predicted.
+	SSE   float64
+	Count int
+	Len   int
+}
+
+// newExponentialSmoothing smooths values with a method and its smoothing factors.
+//
+// The level starts at the first value and the trend at the difference of the first two, or for the Holt-Winters
+// method at the mean of the first season and the difference of the means of the first two seasons, with the
+// season starting at the differences of the values of the first season from its mean.
+func newExponentialSmoothing(values []float64, method ForecastMethod, seasonLength int, alpha, beta, gamma float64) exponentialSmoothing {
+	es := exponentialSmoothing{Len: len(values)}
+	start := 1
+	switch method {
+	case ForecastMethodSimple:
+		es.Level = values[0]
+	case ForecastMethodHolt:
+		es.Level, es.Trend = values[0], values[1]-values[0]
+	case ForecastMethodHoltWinters:
+		first := ValueSequence(values[:seasonLength]...).Average()
+		second := ValueSequence(values[seasonLength : 2*seasonLength]...).Average()
+		es.Level, es.Trend = first, (second-first)/float64(seasonLength)
+		es.Season = make([]float64, seasonLength)
+		for index := range es.Season {
+			es.Season[index] = values[index] - first
+		}
+		start = seasonLength
+	}
+
+	for index := start; index < len(values); index++ {
+		var season float64
+		if es.Season != nil {
+			season = es.Season[index%seasonLength]
+		}
+		value, level := values[index], es.Level
+		errorValue := value - (level + es.Trend + season)
+		es.SSE += errorValue * errorValue
+		es.Count++
+
+		es.Level = alpha*(value-season) + (1-alpha)*(level+es.Trend)
+		if method != ForecastMethodSimple {
+			es.Trend = beta*(es.Level-level) + (1-beta)*es.Trend
+		}
+		if es.Season != nil {
+			es.Season[index%seasonLength] = gamma*(value-es.Level) + (1-gamma)*season
+		}
+	}
+	return es
+}
+
+// Forecast returns the value forecast a number of steps past the last value.
+func (es exponentialSmoothing) Forecast(steps int) float64 {
+	value := es.Level + float64(steps)*es.Trend
+	if es.Season != nil {
+		value += es.Season[(es.Len-1+steps)%len(es.Season)]
+	}
+	return value
+}
+
+// Variance returns the variance of the errors of predicting each value from those before it.
+func (es exponentialSmoothing) Variance() float64 {
+	if es.Count == 0 {
+		return 0
+	}
+	return es.SSE / float64(es.Count)
+}
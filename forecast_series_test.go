@@ -0,0 +1,122 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestExponentialSmoothing(t *testing.T) {
+	es := newExponentialSmoothing([]float64{1, 3, 2}, ForecastMethodSimple, 0, 0.5, 0, 0)
+	// the level moves halfway to each value; 1, 2 and 2.
+	testutil.AssertEqual(t, 2.0, es.Level)
+	testutil.AssertEqual(t, 4.0, es.SSE)
+	testutil.AssertEqual(t, 2, es.Count)
+	testutil.AssertEqual(t, 2.0, es.Forecast(5))
+	testutil.AssertEqual(t, 2.0, es.Variance())
+}
+
+func TestForecastSeriesHolt(t *testing.T) {
+	fs := &ForecastSeries{
+		InnerSeries: mockValuesProvider{
+			X: LinearRange(1.0, 20.0),
+			Y: LinearRangeWithStep(3.0, 41.0, 2.0),
+		},
+		Method: ForecastMethodHolt,
+		Steps:  5,
+	}
+	testutil.AssertNil(t, fs.Validate())
+	testutil.AssertEqual(t, 6, fs.Len())
+
+	// the first value is the last value of the inner series.
+	x, y := fs.GetFirstValues()
+	testutil.AssertEqual(t, 20.0, x)
+	testutil.AssertEqual(t, 41.0, y)
+
+	// a line forecasts its continuation with no error.
+	x, y = fs.GetLastValues()
+	testutil.AssertInDelta(t, 25.0, x, 0.0000001)
+	testutil.AssertInDelta(t, 51.0, y, 0.0001)
+	_, y1, y2 := fs.GetBoundedLastValues()
+	testutil.AssertInDelta(t, y1, y2, 0.0001)
+}
+
+func TestForecastSeriesHoltWinters(t *testing.T) {
+	var xvalues, yvalues []float64
+	for index := 0; index < 60; index++ {
+		xvalues = append(xvalues, float64(index))
+		yvalues = append(yvalues, 100+float64(index)+10*math.Sin(2*math.Pi*float64(index)/6)+3*math.Sin(float64(index)*7.3))
+	}
+	fs := &ForecastSeries{
+		InnerSeries:  ContinuousSeries{XValues: xvalues, YValues: yvalues},
+		Method:       ForecastMethodHoltWinters,
+		SeasonLength: 6,
+		Steps:        12,
+	}
+
+	alpha, beta, gamma := fs.GetParameters()
+	testutil.AssertTrue(t, alpha > 0 && alpha < 1)
+	testutil.AssertTrue(t, beta > 0 && beta < 1)
+	testutil.AssertTrue(t, gamma > 0 && gamma < 1)
+
+	// the forecast follows the trend and the season, and the interval widens with the steps.
+	var previousWidth float64
+	for index := 1; index < fs.Len(); index++ {
+		x, y := fs.GetValues(index)
+		expected := 100 + x + 10*math.Sin(2*math.Pi*x/6)
+		testutil.AssertInDelta(t, expected, y, 6)
+
+		_, y1, y2 := fs.GetBoundedValues(index)
+		testutil.AssertTrue(t, y1 > y && y > y2)
+		testutil.AssertTrue(t, y1-y2 >= previousWidth)
+		previousWidth = y1 - y2
+	}
+}
+
+func TestForecastSeriesSimple(t *testing.T) {
+	fs := &ForecastSeries{
+		InnerSeries: mockValuesProvider{
+			X: LinearRange(1.0, 10.0),
+			Y: []float64{5, 5, 5, 5, 5, 5, 5, 5, 5, 5},
+		},
+		Alpha: 0.3,
+	}
+	alpha, beta, gamma := fs.GetParameters()
+	testutil.AssertEqual(t, 0.3, alpha)
+	testutil.AssertEqual(t, 0.0, beta)
+	testutil.AssertEqual(t, 0.0, gamma)
+	testutil.AssertEqual(t, DefaultForecastSteps+1, fs.Len())
+
+	x, y := fs.GetLastValues()
+	testutil.AssertEqual(t, 22.0, x)
+	testutil.AssertEqual(t, 5.0, y)
+}
+
+func TestForecastSeriesValidate(t *testing.T) {
+	inner := mockValuesProvider{X: LinearRange(1.0, 10.0), Y: LinearRange(1.0, 10.0)}
+	testutil.AssertNotNil(t, (&ForecastSeries{}).Validate())
+	testutil.AssertNotNil(t, (&ForecastSeries{InnerSeries: inner, Alpha: 2}).Validate())
+	testutil.AssertNotNil(t, (&ForecastSeries{InnerSeries: inner, Method: ForecastMethodHoltWinters}).Validate())
+	testutil.AssertNotNil(t, (&ForecastSeries{InnerSeries: inner, Method: ForecastMethodHoltWinters, SeasonLength: 6}).Validate())
+	testutil.AssertNil(t, (&ForecastSeries{InnerSeries: inner, Method: ForecastMethodHoltWinters, SeasonLength: 5}).Validate())
+}
+
+func TestForecastSeriesRender(t *testing.T) {
+	inner := ContinuousSeries{XValues: LinearRange(1.0, 30.0), YValues: RandomValuesWithMax(30, 100)}
+	graph := Chart{
+		Series: []Series{
+			inner,
+			&ForecastSeries{InnerSeries: inner, Method: ForecastMethodHolt},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, graph.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+
+	b.Reset()
+	testutil.AssertNil(t, graph.Render(SVG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
@@ -0,0 +1,197 @@
+package chart
+
+import "math"
+
+// functionPoint is a sample of a plotted function at a parameter, and where it is on the canvas.
+type functionPoint struct {
+	T, X, Y float64
+	PX, PY  float64
+	// Finite is false if the function isn't defined at the parameter, and the line breaks there.
+	Finite bool
+}
+
+// functionPlotter samples a function of a parameter adaptively for the canvas it's drawn on; more finely where
+// the curve bends, breaking the line where the function jumps or isn't finite.
+type functionPlotter struct {
+	CanvasBox Box
+	XRange    Range
+	YRange    Range
+	Function  func(t float64) (x, y float64)
+
+	xvalues []float64
+	yvalues []float64
+}
+
+// Plot returns the samples of the function between two parameters, with NaN y-values where the line breaks,
+// clipped to the ranges.
+func (fp *functionPlotter) Plot(start, end float64) (xvalues, yvalues []float64) {
+	intervals := MaxInt(fp.CanvasBox.Width()/DefaultFunctionSampleSpacing, 1)
+	previous := fp.point(start)
+	fp.add(previous)
+	for index := 1; index <= intervals; index++ {
+		next := fp.point(start + (end-start)*float64(index)/float64(intervals))
+		fp.refine(previous, next, 0)
+		fp.add(next)
+		previous = next
+	}
+	return clipFunctionLine(fp.xvalues, fp.yvalues, fp.XRange, fp.YRange)
+}
+
+// point samples the function at a parameter.
+func (fp *functionPlotter) point(t float64) functionPoint {
+	x, y := fp.Function(t)
+	p := functionPoint{T: t, X: x, Y: y}
+	if math.IsNaN(x) || math.IsInf(x, 0) || math.IsNaN(y) || math.IsInf(y, 0) {
+		return p
+	}
+	p.Finite = true
+	p.PX = float64(fp.XRange.Translate(clampToRange(x, fp.XRange)))
+	p.PY = float64(fp.YRange.Translate(clampToRange(y, fp.YRange)))
+	return p
+}
+
+// add adds a sample to the line, or a break if it isn't finite.
+func (fp *functionPlotter) add(p functionPoint) {
+	if !p.Finite {
+		if len(fp.yvalues) > 0 && !math.IsNaN(fp.yvalues[len(fp.yvalues)-1]) {
+			fp.xvalues, fp.yvalues = append(fp.xvalues, p.X), append(fp.yvalues, math.NaN())
+		}
+		return
+	}
+	fp.xvalues, fp.yvalues = append(fp.xvalues, p.X), append(fp.yvalues, p.Y)
+}
+
+// refine adds the samples between two samples the line needs to follow the curve; it halves the interval while
+// the curve strays from the line between them, or while it crosses the edge of where the function is defined.
+func (fp *functionPlotter) refine(a, b functionPoint, depth int) {
+	if !a.Finite && !b.Finite {
+		return
+	}
+	m := fp.point((a.T + b.T) / 2)
+	if a.Finite && b.Finite && m.Finite && functionIsStraight(a, b, m) {
+		return
+	}
+	if depth >= DefaultFunctionMaxDepth {
+		if a.Finite && b.Finite && fp.isJump(a, b) {
+			fp.add(functionPoint{X: m.X})
+		}
+		return
+	}
+	fp.refine(a, m, depth+1)
+	fp.add(m)
+	fp.refine(m, b, depth+1)
+}
+
+// isJump returns if the function jumps between two samples; the gap between them doesn't close as the interval
+// is halved, as it would if the function were continuous.
+func (fp *functionPlotter) isJump(a, b functionPoint) bool {
+	gap := math.Hypot(b.PX-a.PX, b.PY-a.PY)
+	for iteration := 0; iteration < 2*DefaultFunctionMaxDepth; iteration++ {
+		if gap <= DefaultFunctionTolerance {
+			return false
+		}
+		m := fp.point((a.T + b.T) / 2)
+		if !m.Finite {
+			return true
+		}
+		left, right := math.Hypot(m.PX-a.PX, m.PY-a.PY), math.Hypot(b.PX-m.PX, b.PY-m.PY)
+		if math.Max(left, right) < 0.75*gap {
+			return false
+		}
+		if left > right {
+			b, gap = m, left
+		} else {
+			a, gap = m, right
+		}
+	}
+	return true
+}
+
+// functionIsStraight returns if the line between two samples follows the curve through a sample between them;
+// the line is short, and the sample is near it and splits it roughly in half, as it doesn't where the curve jumps.
+func functionIsStraight(a, b, m functionPoint) bool {
+	chord := math.Hypot(b.PX-a.PX, b.PY-a.PY)
+	if chord <= DefaultFunctionTolerance {
+		return true
+	}
+	if chord > DefaultFunctionSampleSpacing || functionChordDistance(a, b, m) > DefaultFunctionTolerance {
+		return false
+	}
+	return math.Max(math.Hypot(m.PX-a.PX, m.PY-a.PY), math.Hypot(b.PX-m.PX, b.PY-m.PY)) < 0.75*chord
+}
+
+// functionChordDistance returns how far in pixels a sample is from the line between two others.
+func functionChordDistance(a, b, m functionPoint) float64 {
+	dx, dy := b.PX-a.PX, b.PY-a.PY
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return math.Hypot(m.PX-a.PX, m.PY-a.PY)
+	}
+	return math.Abs(dx*(a.PY-m.PY)-dy*(a.PX-m.PX)) / length
+}
+
+// clampToRange clamps a value to a range widened by its delta on each side, so it translates to a pixel not too
+// far outside the canvas.
+func clampToRange(value float64, r Range) float64 {
+	return math.Max(r.GetMin()-r.GetDelta(), math.Min(value, r.GetMax()+r.GetDelta()))
+}
+
+// clipFunctionLine clips a line with breaks at NaN y-values to the ranges, breaking it where it leaves them.
+func clipFunctionLine(xvalues, yvalues []float64, xrange, yrange Range) (clippedX, clippedY []float64) {
+	xmin, xmax := xrange.GetMin(), xrange.GetMax()
+	ymin, ymax := yrange.GetMin(), yrange.GetMax()
+	inside := func(x, y float64) bool {
+		return x >= xmin && x <= xmax && y >= ymin && y <= ymax
+	}
+	add := func(x, y float64) {
+		if math.IsNaN(y) && (len(clippedY) == 0 || math.IsNaN(clippedY[len(clippedY)-1])) {
+			return
+		}
+		clippedX, clippedY = append(clippedX, x), append(clippedY, y)
+	}
+
+	for index := range xvalues {
+		x, y := xvalues[index], yvalues[index]
+		if index == 0 || math.IsNaN(y) || math.IsNaN(yvalues[index-1]) {
+			if math.IsNaN(y) || !inside(x, y) {
+				add(x, math.NaN())
+			} else {
+				add(x, y)
+			}
+			continue
+		}
+
+		// the segment from the previous value is clipped to the ranges (Liang-Barsky).
+		x0, y0 := xvalues[index-1], yvalues[index-1]
+		dx, dy := x-x0, y-y0
+		t0, t1 := 0.0, 1.0
+		visible := true
+		for _, edge := range [][2]float64{{-dx, x0 - xmin}, {dx, xmax - x0}, {-dy, y0 - ymin}, {dy, ymax - y0}} {
+			p, q := edge[0], edge[1]
+			if p == 0 {
+				if q < 0 {
+					visible = false
+				}
+				continue
+			}
+			if r := q / p; p < 0 {
+				t0 = math.Max(t0, r)
+			} else {
+				t1 = math.Min(t1, r)
+			}
+		}
+		if !visible || t0 > t1 {
+			add(x, math.NaN())
+			continue
+		}
+		if t0 > 0 {
+			add(x0, math.NaN())
+			add(x0+t0*dx, y0+t0*dy)
+		}
+		add(x0+t1*dx, y0+t1*dy)
+		if t1 < 1 {
+			add(x, math.NaN())
+		}
+	}
+	return
+}
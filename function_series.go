@@ -0,0 +1,117 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series              = (*FunctionSeries)(nil)
+	_ ValuesProvider      = (*FunctionSeries)(nil)
+	_ FirstValuesProvider = (*FunctionSeries)(nil)
+	_ LastValuesProvider  = (*FunctionSeries)(nil)
+)
+
+// FunctionSeries plots a function of x, such as a theoretical curve to compare values to.
+//
+// The function is sampled for the canvas it's drawn on; more finely where it bends, so it is smooth at any size.
+// The line breaks where the function jumps, or where it is NaN or infinite, and where it leaves the ranges of
+// the chart.
+//
+// The function is plotted between `Start` and `End`, or across the x-range of the chart if they're unset; the
+// function then doesn't count towards the ranges of the chart, so other series have to set them.
+type FunctionSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Function func(x float64) float64
+
+	Start float64
+	End   float64
+}
+
+// GetName returns the name of the series.
+func (fs FunctionSeries) GetName() string {
+	return fs.Name
+}
+
+// GetStyle returns the line style.
+func (fs FunctionSeries) GetStyle() Style {
+	return fs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (fs FunctionSeries) GetYAxis() YAxisType {
+	return fs.YAxis
+}
+
+// Len returns the number of evenly spaced values between the start and the end used for the ranges of the
+// chart, or zero if they're unset.
+func (fs FunctionSeries) Len() int {
+	if fs.Function == nil || fs.Start >= fs.End {
+		return 0
+	}
+	return DefaultFunctionSamples
+}
+
+// GetValues returns one of the evenly spaced values between the start and the end; the y-value is NaN if the
+// function isn't finite there.
+func (fs FunctionSeries) GetValues(index int) (x, y float64) {
+	x = fs.Start + (fs.End-fs.Start)*float64(index)/float64(DefaultFunctionSamples-1)
+	y = fs.Function(x)
+	if math.IsInf(y, 0) {
+		y = math.NaN()
+	}
+	return
+}
+
+// GetFirstValues returns the value at the start.
+func (fs FunctionSeries) GetFirstValues() (x, y float64) {
+	if fs.Len() == 0 {
+		return
+	}
+	return fs.GetValues(0)
+}
+
+// GetLastValues returns the value at the end.
+func (fs FunctionSeries) GetLastValues() (x, y float64) {
+	if fs.Len() == 0 {
+		return
+	}
+	return fs.GetValues(DefaultFunctionSamples - 1)
+}
+
+// Render renders the series.
+func (fs FunctionSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	start, end := fs.Start, fs.End
+	if start >= end {
+		start, end = xrange.GetMin(), xrange.GetMax()
+	}
+	plotter := &functionPlotter{
+		CanvasBox: canvasBox,
+		XRange:    xrange,
+		YRange:    yrange,
+		Function: func(x float64) (float64, float64) {
+			return x, fs.Function(x)
+		},
+	}
+	xvalues, yvalues := plotter.Plot(start, end)
+	if len(xvalues) == 0 {
+		return
+	}
+	style := fs.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, ContinuousSeries{XValues: xvalues, YValues: yvalues})
+}
+
+// Validate validates the series.
+func (fs FunctionSeries) Validate() error {
+	if fs.Function == nil {
+		return fmt.Errorf("function series requires Function to be set")
+	}
+	if fs.Start > fs.End {
+		return fmt.Errorf("function series requires Start to be before End")
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func testFunctionPlotter(xmin, xmax, ymin, ymax float64, function func(x float64) float64) *functionPlotter {
+	return &functionPlotter{
+		CanvasBox: Box{Top: 0, Left: 0, Right: 300, Bottom: 100},
+		XRange:    &ContinuousRange{Min: xmin, Max: xmax, Domain: 300},
+		YRange:    &ContinuousRange{Min: ymin, Max: ymax, Domain: 100},
+		Function: func(x float64) (float64, float64) {
+			return x, function(x)
+		},
+	}
+}
+
+func TestFunctionPlotterAsymptote(t *testing.T) {
+	xvalues, yvalues := testFunctionPlotter(0, 3, -4, 4, math.Tan).Plot(0, 3)
+	testutil.AssertEqual(t, len(xvalues), len(yvalues))
+
+	var breaks []int
+	for index, y := range yvalues {
+		if math.IsNaN(y) {
+			breaks = append(breaks, index)
+			continue
+		}
+		testutil.AssertTrue(t, y >= -4 && y <= 4)
+	}
+	testutil.AssertLen(t, breaks, 1)
+
+	// the line leaves the top of the range and comes back from the bottom.
+	index := breaks[0]
+	testutil.AssertInDelta(t, math.Atan(4), xvalues[index-1], 0.01)
+	testutil.AssertInDelta(t, 4.0, yvalues[index-1], 0.0001)
+	testutil.AssertInDelta(t, _pi-math.Atan(4), xvalues[index+1], 0.01)
+	testutil.AssertInDelta(t, -4.0, yvalues[index+1], 0.0001)
+}
+
+func TestFunctionPlotterJumps(t *testing.T) {
+	_, yvalues := testFunctionPlotter(0, 4, 0, 4, math.Floor).Plot(0.5, 3.5)
+
+	var breaks int
+	for _, y := range yvalues {
+		if math.IsNaN(y) {
+			breaks++
+		}
+	}
+	testutil.AssertEqual(t, 3, breaks)
+}
+
+func TestFunctionPlotterUndefined(t *testing.T) {
+	xvalues, yvalues := testFunctionPlotter(-1, 1, 0, 1, math.Sqrt).Plot(-1, 1)
+	testutil.AssertNotEmpty(t, xvalues)
+
+	// the line starts close to where the function is first defined.
+	testutil.AssertTrue(t, xvalues[0] >= 0 && xvalues[0] < 0.001)
+	testutil.AssertTrue(t, yvalues[0] < 0.01)
+	testutil.AssertInDelta(t, 1.0, yvalues[len(yvalues)-1], 0.0001)
+}
+
+func TestClipFunctionLine(t *testing.T) {
+	unit := &ContinuousRange{Min: 0, Max: 1}
+
+	xvalues, yvalues := clipFunctionLine([]float64{0, 2}, []float64{0, 2}, unit, unit)
+	testutil.AssertEqual(t, []float64{0, 1, 2}, xvalues)
+	testutil.AssertLen(t, yvalues, 3)
+	testutil.AssertEqual(t, 1.0, yvalues[1])
+	testutil.AssertTrue(t, math.IsNaN(yvalues[2]))
+
+	xvalues, yvalues = clipFunctionLine([]float64{-1, 0.5}, []float64{0.5, 0.5}, unit, unit)
+	testutil.AssertEqual(t, []float64{0, 0.5}, xvalues)
+	testutil.AssertEqual(t, []float64{0.5, 0.5}, yvalues)
+
+	xvalues, _ = clipFunctionLine([]float64{2, 3}, []float64{2, 3}, unit, unit)
+	testutil.AssertEmpty(t, xvalues)
+}
+
+func TestFunctionSeries(t *testing.T) {
+	fs := FunctionSeries{
+		Function: func(x float64) float64 {
+			return 1 / (x - 1)
+		},
+		Start: -1,
+		End:   1,
+	}
+	testutil.AssertNil(t, fs.Validate())
+	testutil.AssertEqual(t, DefaultFunctionSamples, fs.Len())
+
+	x, y := fs.GetFirstValues()
+	testutil.AssertEqual(t, -1.0, x)
+	testutil.AssertEqual(t, -0.5, y)
+
+	// the function isn't finite at the end.
+	x, y = fs.GetLastValues()
+	testutil.AssertEqual(t, 1.0, x)
+	testutil.AssertTrue(t, math.IsNaN(y))
+
+	testutil.AssertZero(t, FunctionSeries{Function: math.Sin}.Len())
+}
+
+func TestFunctionSeriesValidate(t *testing.T) {
+	testutil.AssertNotNil(t, FunctionSeries{}.Validate())
+	testutil.AssertNotNil(t, FunctionSeries{Function: math.Sin, Start: 1, End: 0}.Validate())
+	testutil.AssertNil(t, FunctionSeries{Function: math.Sin}.Validate())
+}
+
+func TestFunctionSeriesRender(t *testing.T) {
+	graph := Chart{
+		XAxis: XAxis{Range: &ContinuousRange{Min: -_2pi, Max: _2pi}},
+		YAxis: YAxis{Range: &ContinuousRange{Min: -4, Max: 4}},
+		Series: []Series{
+			FunctionSeries{Function: math.Tan},
+			FunctionSeries{Function: math.Sqrt, Start: 0, End: 4},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, graph.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+
+	b.Reset()
+	testutil.AssertNil(t, graph.Render(SVG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
@@ -0,0 +1,685 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/golang/freetype/truetype"
+)
+
+// GanttInterval is a span of time a task runs for.
+type GanttInterval struct {
+	Start time.Time
+	End   time.Time
+	// Label is drawn inside the interval if it fits.
+	Label string
+	Style Style
+}
+
+// GanttMilestone is a point in time marked on a task.
+type GanttMilestone struct {
+	Time  time.Time
+	Label string
+	Style Style
+}
+
+// GanttTask is a row of a gantt chart.
+type GanttTask struct {
+	// ID identifies the task in the `DependsOn` of other tasks; it defaults to the name.
+	ID   string
+	Name string
+	// Lane is the swimlane the task is grouped into; tasks in the same lane are drawn together, in the order the lanes first appear.
+	Lane  string
+	Style Style
+
+	Intervals  []GanttInterval
+	Milestones []GanttMilestone
+	// DependsOn are the ids of the tasks this task waits for; an arrow is drawn from the end of each to the start of this task.
+	DependsOn []string
+}
+
+// GetID returns the id of the task or its name.
+func (gt GanttTask) GetID() string {
+	if gt.ID == "" {
+		return gt.Name
+	}
+	return gt.ID
+}
+
+// Bounds returns the earliest and the latest time of the intervals and the milestones of the task.
+func (gt GanttTask) Bounds() (start, end time.Time) {
+	var times []time.Time
+	for _, interval := range gt.Intervals {
+		times = append(times, interval.Start, interval.End)
+	}
+	for _, milestone := range gt.Milestones {
+		times = append(times, milestone.Time)
+	}
+	return TimeMinMax(times...)
+}
+
+// ganttRow is a task positioned on a row of a gantt chart.
+type ganttRow struct {
+	Task int
+	Lane int
+}
+
+// GanttChart is a chart that draws tasks as time intervals on rows that share a time axis.
+type GanttChart struct {
+	Title      string
+	TitleStyle Style
+
+	ColorPalette ColorPalette
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+
+	// XAxis is the time axis; without ticks it gets ticks on round times.
+	XAxis XAxis
+
+	TaskLabelStyle  Style
+	LaneStyle       Style
+	DependencyStyle Style
+
+	// Now, if set, is drawn as a vertical line.
+	Now      time.Time
+	NowStyle Style
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	Tasks    []GanttTask
+	Elements []Renderable
+}
+
+// GetDPI returns the dpi for the chart.
+func (gc GanttChart) GetDPI(defaults ...float64) float64 {
+	if gc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return gc.DPI
+}
+
+// GetFont returns the text font.
+func (gc GanttChart) GetFont() *truetype.Font {
+	if gc.Font == nil {
+		return gc.defaultFont
+	}
+	return gc.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (gc GanttChart) GetWidth() int {
+	if gc.Width == 0 {
+		return DefaultChartWidth
+	}
+	return gc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (gc GanttChart) GetHeight() int {
+	if gc.Height == 0 {
+		return DefaultChartHeight
+	}
+	return gc.Height
+}
+
+// Validate validates the chart.
+func (gc GanttChart) Validate() error {
+	if len(gc.Tasks) == 0 {
+		return errors.New("please provide at least one task")
+	}
+	ids := map[string]bool{}
+	for _, task := range gc.Tasks {
+		if len(task.Intervals) == 0 && len(task.Milestones) == 0 {
+			return fmt.Errorf("gantt task %q must have at least (1) interval or milestone", task.Name)
+		}
+		for _, interval := range task.Intervals {
+			if interval.End.Before(interval.Start) {
+				return fmt.Errorf("gantt task %q has an interval that ends before it starts", task.Name)
+			}
+		}
+		ids[task.GetID()] = true
+	}
+	for _, task := range gc.Tasks {
+		for _, id := range task.DependsOn {
+			if !ids[id] {
+				return fmt.Errorf("gantt task %q depends on unknown task %q", task.Name, id)
+			}
+		}
+	}
+	return nil
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (gc GanttChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := gc.Validate(); err != nil {
+		return err
+	}
+
+	r, err := rp(gc.GetWidth(), gc.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if gc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		gc.defaultFont = defaultFont
+	}
+	r.SetDPI(gc.GetDPI(DefaultDPI))
+
+	lanes, rows := gc.getRows()
+	xr := gc.getRange()
+	if xr.GetMax()-xr.GetMin() == 0 {
+		return fmt.Errorf("invalid data range; cannot be zero")
+	}
+
+	canvasBox := gc.getCanvasBox(r)
+	plotBox := gc.getPlotBox(r, canvasBox, lanes)
+	xr.SetDomain(plotBox.Width())
+	xt := gc.getTicks(r, xr)
+	if !gc.XAxis.Style.Hidden {
+		// make room for the axis, then measure it again as the ticks depend on the width.
+		axisBox := gc.XAxis.Measure(r, plotBox, xr, gc.styleDefaultsAxes(), xt)
+		plotBox.Bottom -= axisBox.Height()
+		if axisBox.Right > canvasBox.Right {
+			plotBox.Right -= axisBox.Right - canvasBox.Right
+		}
+		xr.SetDomain(plotBox.Width())
+		xt = gc.getTicks(r, xr)
+	}
+
+	gc.drawBackground(r)
+	gc.drawCanvas(r, canvasBox)
+	gc.drawLanes(r, canvasBox, plotBox, lanes, rows)
+	if !gc.XAxis.Style.Hidden {
+		gc.getXAxis().Render(r, plotBox, xr, gc.styleDefaultsAxes(), xt)
+	}
+	gc.drawTaskLabels(r, canvasBox, plotBox, lanes, rows)
+	gc.drawDependencies(r, plotBox, xr, rows)
+	gc.drawTasks(r, plotBox, xr, lanes, rows)
+	gc.drawNow(r, plotBox, xr)
+	gc.drawTitle(r)
+	for _, a := range gc.Elements {
+		a(r, canvasBox, gc.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+func (gc GanttChart) drawBackground(r Renderer) {
+	Draw.Box(r, Box{
+		Right:  gc.GetWidth(),
+		Bottom: gc.GetHeight(),
+	}, gc.getBackgroundStyle())
+}
+
+func (gc GanttChart) drawCanvas(r Renderer, canvasBox Box) {
+	Draw.Box(r, canvasBox, gc.getCanvasStyle())
+}
+
+func (gc GanttChart) drawTitle(r Renderer) {
+	if len(gc.Title) > 0 && !gc.TitleStyle.Hidden {
+		Draw.TextWithin(r, gc.Title, gc.Box(), gc.styleDefaultsTitle())
+	}
+}
+
+// drawLanes shades every other swimlane, separates them and draws their names to the left of the task names.
+func (gc GanttChart) drawLanes(r Renderer, canvasBox, plotBox Box, lanes []string, rows []ganttRow) {
+	style := gc.getLaneStyle()
+	if !gc.hasLanes(lanes) || style.Hidden {
+		return
+	}
+	for lane, name := range lanes {
+		first, last := -1, -1
+		for index, row := range rows {
+			if row.Lane == lane {
+				if first < 0 {
+					first = index
+				}
+				last = index
+			}
+		}
+		laneBox := Box{
+			Top:    gc.getRowTop(plotBox, len(rows), first),
+			Left:   canvasBox.Left,
+			Right:  plotBox.Right,
+			Bottom: gc.getRowTop(plotBox, len(rows), last+1),
+		}
+		if lane%2 == 0 {
+			Draw.Box(r, laneBox, Style{FillColor: style.GetFillColor()})
+		}
+		if lane > 0 {
+			style.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(laneBox.Left, laneBox.Top)
+			r.LineTo(laneBox.Right, laneBox.Top)
+			r.Stroke()
+			r.ResetStyle()
+		}
+		if name != "" {
+			labelStyle := style.GetTextOptions()
+			tb := Draw.MeasureText(r, name, labelStyle)
+			Draw.Text(r, name, laneBox.Left+DefaultGanttLabelPadding, laneBox.Top+(laneBox.Height()+tb.Height())>>1, labelStyle)
+		}
+	}
+}
+
+func (gc GanttChart) drawTaskLabels(r Renderer, canvasBox, plotBox Box, lanes []string, rows []ganttRow) {
+	style := gc.getTaskLabelStyle()
+	if style.Hidden {
+		return
+	}
+	left := canvasBox.Left + gc.getLaneLabelWidth(r, lanes)
+	for index, row := range rows {
+		name := gc.Tasks[row.Task].Name
+		if name == "" {
+			continue
+		}
+		top, bottom := gc.getRowTop(plotBox, len(rows), index), gc.getRowTop(plotBox, len(rows), index+1)
+		tb := Draw.MeasureText(r, name, style)
+		Draw.Text(r, name, left+DefaultGanttLabelPadding, top+(bottom-top+tb.Height())>>1, style)
+	}
+}
+
+func (gc GanttChart) drawTasks(r Renderer, plotBox Box, xr Range, lanes []string, rows []ganttRow) {
+	for index, row := range rows {
+		task := gc.Tasks[row.Task]
+		style := gc.getTaskStyle(row, gc.hasLanes(lanes))
+		if style.Hidden {
+			continue
+		}
+
+		top, bottom := gc.getRowTop(plotBox, len(rows), index), gc.getRowTop(plotBox, len(rows), index+1)
+		inset := int(float64(bottom-top) * (1 - DefaultGanttBarHeight) / 2.0)
+		for _, interval := range task.Intervals {
+			intervalStyle := interval.Style.InheritFrom(style)
+			barBox := Box{
+				Top:    top + inset,
+				Left:   plotBox.Left + xr.Translate(TimeToFloat64(interval.Start)),
+				Right:  plotBox.Left + xr.Translate(TimeToFloat64(interval.End)),
+				Bottom: bottom - inset,
+			}
+			Draw.Box(r, barBox, intervalStyle)
+
+			if interval.Label != "" {
+				labelStyle := gc.getIntervalLabelStyle(intervalStyle)
+				label := Text.Truncate(r, interval.Label, barBox.Width()-2*DefaultGanttLabelPadding, labelStyle)
+				if label != "" && label != DefaultTextEllipsis {
+					tb := Draw.MeasureText(r, label, labelStyle)
+					Draw.Text(r, label, barBox.Left+DefaultGanttLabelPadding, barBox.Top+(barBox.Height()+tb.Height())>>1, labelStyle)
+				}
+			}
+		}
+
+		cy := (top + bottom) >> 1
+		size := (bottom - top - 2*inset) >> 1
+		for _, milestone := range task.Milestones {
+			milestoneStyle := milestone.Style.InheritFrom(style)
+			mx := plotBox.Left + xr.Translate(TimeToFloat64(milestone.Time))
+			milestoneStyle.GetFillAndStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(mx, cy-size)
+			r.LineTo(mx+size, cy)
+			r.LineTo(mx, cy+size)
+			r.LineTo(mx-size, cy)
+			r.Close()
+			r.FillStroke()
+			r.ResetStyle()
+
+			if milestone.Label != "" {
+				labelStyle := gc.getTaskLabelStyle()
+				tb := Draw.MeasureText(r, milestone.Label, labelStyle)
+				Draw.Text(r, milestone.Label, mx+size+DefaultGanttLabelPadding, cy+tb.Height()>>1, labelStyle)
+			}
+		}
+	}
+}
+
+// drawDependencies draws an arrow from the end of each task to the start of each task that depends on it.
+func (gc GanttChart) drawDependencies(r Renderer, plotBox Box, xr Range, rows []ganttRow) {
+	style := gc.getDependencyStyle()
+	if style.Hidden {
+		return
+	}
+
+	rowOf := map[string]int{}
+	for index, row := range rows {
+		rowOf[gc.Tasks[row.Task].GetID()] = index
+	}
+	for index, row := range rows {
+		task := gc.Tasks[row.Task]
+		start, _ := task.Bounds()
+		for _, id := range task.DependsOn {
+			from := rowOf[id]
+			_, end := gc.Tasks[rows[from].Task].Bounds()
+			points := gc.getDependencyPath(
+				plotBox.Left+xr.Translate(TimeToFloat64(end)), gc.getRowMiddle(plotBox, len(rows), from),
+				plotBox.Left+xr.Translate(TimeToFloat64(start)), gc.getRowMiddle(plotBox, len(rows), index),
+				gc.getRowTop(plotBox, len(rows), MaxInt(from, index)),
+			)
+
+			style.GetStrokeOptions().WriteToRenderer(r)
+			for pointIndex, p := range points {
+				if pointIndex == 0 {
+					r.MoveTo(p.X, p.Y)
+				} else {
+					r.LineTo(p.X, p.Y)
+				}
+			}
+			r.Stroke()
+
+			// the arrow head points right, into the start of the task.
+			tip := points[len(points)-1]
+			head := DefaultGanttArrowSize
+			style.GetFillAndStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(tip.X, tip.Y)
+			r.LineTo(tip.X-head, tip.Y-head>>1)
+			r.LineTo(tip.X-head, tip.Y+head>>1)
+			r.Close()
+			r.FillStroke()
+			r.ResetStyle()
+		}
+	}
+}
+
+// getDependencyPath returns the elbowed path of a dependency arrow from the end of one task to the start of another;
+// when the second task starts before the first ends the path doubles back along the boundary between the rows.
+func (gc GanttChart) getDependencyPath(x1, y1, x2, y2, between int) []Point {
+	gap := DefaultGanttArrowSize
+	if x2-gap >= x1+gap {
+		return []Point{{X: x1, Y: y1}, {X: x1 + gap, Y: y1}, {X: x1 + gap, Y: y2}, {X: x2, Y: y2}}
+	}
+	return []Point{
+		{X: x1, Y: y1},
+		{X: x1 + gap, Y: y1},
+		{X: x1 + gap, Y: between},
+		{X: x2 - gap, Y: between},
+		{X: x2 - gap, Y: y2},
+		{X: x2, Y: y2},
+	}
+}
+
+func (gc GanttChart) drawNow(r Renderer, plotBox Box, xr Range) {
+	if gc.Now.IsZero() {
+		return
+	}
+	style := gc.getNowStyle()
+	now := TimeToFloat64(gc.Now)
+	if style.Hidden || now < xr.GetMin() || now > xr.GetMax() {
+		return
+	}
+	x := plotBox.Left + xr.Translate(now)
+	style.GetStrokeOptions().WriteToRenderer(r)
+	r.MoveTo(x, plotBox.Top)
+	r.LineTo(x, plotBox.Bottom)
+	r.Stroke()
+	r.ResetStyle()
+}
+
+// getRows returns the lanes in the order they first appear and the rows with the tasks grouped by lane.
+func (gc GanttChart) getRows() (lanes []string, rows []ganttRow) {
+	laneIndex := map[string]int{}
+	for _, task := range gc.Tasks {
+		if _, ok := laneIndex[task.Lane]; !ok {
+			laneIndex[task.Lane] = len(lanes)
+			lanes = append(lanes, task.Lane)
+		}
+	}
+	for lane := range lanes {
+		for index, task := range gc.Tasks {
+			if laneIndex[task.Lane] == lane {
+				rows = append(rows, ganttRow{Task: index, Lane: lane})
+			}
+		}
+	}
+	return
+}
+
+// getRange returns the time range of the x-axis, spanning every task unless the axis has a range; a range of a
+// single time, as of only milestones, is padded by half a day on each side.
+func (gc GanttChart) getRange() Range {
+	if gc.XAxis.Range != nil && !gc.XAxis.Range.IsZero() {
+		return gc.XAxis.Range
+	}
+	var times []time.Time
+	for _, task := range gc.Tasks {
+		start, end := task.Bounds()
+		times = append(times, start, end)
+	}
+	min, max := TimeMinMax(times...)
+	if min.Equal(max) {
+		min, max = min.Add(-12*time.Hour), max.Add(12*time.Hour)
+	}
+	return &ContinuousRange{Min: TimeToFloat64(min), Max: TimeToFloat64(max)}
+}
+
+func (gc GanttChart) getTicks(r Renderer, xr Range) []Tick {
+	if len(gc.XAxis.Ticks) > 0 {
+		return gc.XAxis.Ticks
+	}
+	if tp, isTickProvider := xr.(TicksProvider); isTickProvider {
+		return tp.GetTicks(r, gc.styleDefaultsAxes(), gc.XAxis.ValueFormatter)
+	}
+	return GenerateTimeTicks(r, xr, gc.XAxis.Style.InheritFrom(gc.styleDefaultsAxes()), gc.XAxis.ValueFormatter)
+}
+
+// getXAxis returns the x-axis with vertical grid lines by default.
+func (gc GanttChart) getXAxis() XAxis {
+	xa := gc.XAxis
+	xa.GridMajorStyle = xa.GridMajorStyle.InheritFrom(Style{
+		StrokeColor: gc.GetColorPalette().AxisStrokeColor().WithAlpha(48),
+		StrokeWidth: DefaultAxisLineWidth,
+	})
+	return xa
+}
+
+// getPlotBox returns the part of the canvas the tasks are drawn in, to the right of the lane and task names.
+func (gc GanttChart) getPlotBox(r Renderer, canvasBox Box, lanes []string) Box {
+	plotBox := canvasBox
+	plotBox.Left += gc.getLaneLabelWidth(r, lanes) + DefaultGanttLabelPadding
+
+	style := gc.getTaskLabelStyle()
+	if !style.Hidden {
+		var width int
+		for _, task := range gc.Tasks {
+			if task.Name != "" {
+				width = MaxInt(width, Draw.MeasureText(r, task.Name, style).Width())
+			}
+		}
+		plotBox.Left += width + DefaultGanttLabelPadding
+	}
+	plotBox.Right -= DefaultGanttLabelPadding
+	return plotBox
+}
+
+// hasLanes returns if the tasks are grouped into swimlanes, i.e. not all in one unnamed lane.
+func (gc GanttChart) hasLanes(lanes []string) bool {
+	return len(lanes) > 1 || (len(lanes) == 1 && lanes[0] != "")
+}
+
+// getLaneLabelWidth returns the width of the column of lane names, if any of the lanes are named.
+func (gc GanttChart) getLaneLabelWidth(r Renderer, lanes []string) int {
+	style := gc.getLaneStyle()
+	if !gc.hasLanes(lanes) || style.Hidden {
+		return 0
+	}
+	var width int
+	for _, lane := range lanes {
+		if lane != "" {
+			width = MaxInt(width, Draw.MeasureText(r, lane, style.GetTextOptions()).Width()+DefaultGanttLabelPadding)
+		}
+	}
+	return width
+}
+
+// getRowTop returns the top of a row; the rows share the height of the plot box.
+func (gc GanttChart) getRowTop(plotBox Box, rows, index int) int {
+	return plotBox.Top + int(math.Round(float64(plotBox.Height())*float64(index)/float64(rows)))
+}
+
+func (gc GanttChart) getRowMiddle(plotBox Box, rows, index int) int {
+	return (gc.getRowTop(plotBox, rows, index) + gc.getRowTop(plotBox, rows, index+1)) >> 1
+}
+
+func (gc GanttChart) getCanvasBox(r Renderer) Box {
+	canvasBox := gc.Box()
+	if len(gc.Title) > 0 && !gc.TitleStyle.Hidden {
+		titleBox := Draw.MeasureText(r, gc.Title, gc.styleDefaultsTitle())
+		canvasBox.Top += titleBox.Height() + DefaultTitleTop
+	}
+	return canvasBox
+}
+
+// getTaskStyle returns the style of the task on a row; tasks are colored by their lane, or by their row without lanes.
+func (gc GanttChart) getTaskStyle(row ganttRow, byLane bool) Style {
+	colorIndex := row.Task
+	if byLane {
+		colorIndex = row.Lane
+	}
+	color := gc.GetColorPalette().GetSeriesColor(colorIndex)
+	return gc.Tasks[row.Task].Style.InheritFrom(Style{
+		FillColor:   color,
+		StrokeColor: color,
+		StrokeWidth: DefaultStrokeWidth,
+	})
+}
+
+func (gc GanttChart) getIntervalLabelStyle(intervalStyle Style) Style {
+	return Style{
+		FontColor: intervalStyle.GetFontColor(ColorWhite),
+		FontSize:  intervalStyle.GetFontSize(DefaultAxisFontSize),
+		Font:      intervalStyle.GetFont(gc.GetFont()),
+	}
+}
+
+func (gc GanttChart) getTaskLabelStyle() Style {
+	return gc.TaskLabelStyle.InheritFrom(Style{
+		FontColor: gc.GetColorPalette().TextColor(),
+		FontSize:  DefaultFontSize,
+		Font:      gc.GetFont(),
+	})
+}
+
+func (gc GanttChart) getLaneStyle() Style {
+	return gc.LaneStyle.InheritFrom(Style{
+		FillColor:   gc.GetColorPalette().AxisStrokeColor().WithAlpha(16),
+		StrokeColor: gc.GetColorPalette().AxisStrokeColor().WithAlpha(64),
+		StrokeWidth: DefaultAxisLineWidth,
+		FontColor:   gc.GetColorPalette().TextColor(),
+		FontSize:    DefaultFontSize,
+		Font:        gc.GetFont(),
+	})
+}
+
+func (gc GanttChart) getDependencyStyle() Style {
+	return gc.DependencyStyle.InheritFrom(Style{
+		StrokeColor: gc.GetColorPalette().AxisStrokeColor(),
+		FillColor:   gc.GetColorPalette().AxisStrokeColor(),
+		StrokeWidth: DefaultAxisLineWidth,
+	})
+}
+
+func (gc GanttChart) getNowStyle() Style {
+	return gc.NowStyle.InheritFrom(Style{
+		StrokeColor: ColorRed,
+		StrokeWidth: DefaultSeriesLineWidth,
+	})
+}
+
+func (gc GanttChart) getBackgroundStyle() Style {
+	return gc.Background.InheritFrom(gc.styleDefaultsBackground())
+}
+
+func (gc GanttChart) getCanvasStyle() Style {
+	return gc.Canvas.InheritFrom(gc.styleDefaultsCanvas())
+}
+
+func (gc GanttChart) styleDefaultsCanvas() Style {
+	return Style{
+		FillColor:   gc.GetColorPalette().CanvasColor(),
+		StrokeColor: gc.GetColorPalette().CanvasStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (gc GanttChart) styleDefaultsBackground() Style {
+	return Style{
+		FillColor:   gc.GetColorPalette().BackgroundColor(),
+		StrokeColor: gc.GetColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (gc GanttChart) styleDefaultsAxes() Style {
+	return Style{
+		StrokeColor: gc.GetColorPalette().AxisStrokeColor(),
+		StrokeWidth: DefaultAxisLineWidth,
+		Font:        gc.GetFont(),
+		FontSize:    DefaultAxisFontSize,
+		FontColor:   gc.GetColorPalette().TextColor(),
+	}
+}
+
+func (gc GanttChart) styleDefaultsElements() Style {
+	return Style{
+		Font: gc.GetFont(),
+	}
+}
+
+func (gc GanttChart) styleDefaultsTitle() Style {
+	return gc.TitleStyle.InheritFrom(Style{
+		FontColor:           gc.GetColorPalette().TextColor(),
+		Font:                gc.GetFont(),
+		FontSize:            gc.getTitleFontSize(),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+func (gc GanttChart) getTitleFontSize() float64 {
+	effectiveDimension := MinInt(gc.GetWidth(), gc.GetHeight())
+	if effectiveDimension >= 2048 {
+		return 48
+	} else if effectiveDimension >= 1024 {
+		return 24
+	} else if effectiveDimension >= 512 {
+		return 18
+	} else if effectiveDimension >= 256 {
+		return 12
+	}
+	return 10
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (gc GanttChart) GetColorPalette() ColorPalette {
+	if gc.ColorPalette != nil {
+		return gc.ColorPalette
+	}
+	return DefaultColorPalette
+}
+
+// Box returns the chart bounds as a box.
+func (gc GanttChart) Box() Box {
+	dpr := gc.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
+	dpb := gc.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
+
+	return Box{
+		Top:    gc.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
+		Left:   gc.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
+		Right:  gc.GetWidth() - dpr,
+		Bottom: gc.GetHeight() - dpb,
+	}
+}
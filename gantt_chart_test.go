@@ -0,0 +1,146 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestGanttChart(t *testing.T) {
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	start := time.Date(2024, time.March, 12, 9, 0, 0, 0, time.UTC)
+	gc := GanttChart{
+		Title: "Test Title",
+		Font:  f,
+		Now:   start.Add(30 * time.Minute),
+		Tasks: []GanttTask{
+			{Name: "build", Lane: "ci", Intervals: []GanttInterval{{Start: start, End: start.Add(10 * time.Minute), Label: "compile"}}},
+			{Name: "deploy", Lane: "cd", Intervals: []GanttInterval{{Start: start.Add(20 * time.Minute), End: start.Add(40 * time.Minute)}}},
+			{Name: "release", Lane: "cd", Milestones: []GanttMilestone{{Time: start.Add(45 * time.Minute), Label: "v1"}}, DependsOn: []string{"deploy"}},
+		},
+	}
+	testutil.AssertNil(t, gc.Render(PNG, bytes.NewBuffer([]byte{})))
+	testutil.AssertNil(t, gc.Render(SVG, bytes.NewBuffer([]byte{})))
+
+	r, err := PNG(gc.GetWidth(), gc.GetHeight())
+	testutil.AssertNil(t, err)
+	canvasBox := gc.getCanvasBox(r)
+	lanes, rows := gc.getRows()
+
+	// the plot leaves room for the lane and task names on its left.
+	plotBox := gc.getPlotBox(r, canvasBox, lanes)
+	laneWidth := gc.getLaneLabelWidth(r, lanes)
+	testutil.AssertTrue(t, laneWidth > 0)
+	testutil.AssertTrue(t, plotBox.Left > canvasBox.Left+laneWidth+DefaultGanttLabelPadding)
+	testutil.AssertEqual(t, canvasBox.Right-DefaultGanttLabelPadding, plotBox.Right)
+
+	// the rows share the height of the plot.
+	testutil.AssertEqual(t, plotBox.Top, gc.getRowTop(plotBox, len(rows), 0))
+	testutil.AssertEqual(t, plotBox.Bottom, gc.getRowTop(plotBox, len(rows), len(rows)))
+	testutil.AssertEqual(t, plotBox.Top+plotBox.Height()/6, gc.getRowMiddle(plotBox, len(rows), 0))
+}
+
+func TestGanttChartValidate(t *testing.T) {
+	start := time.Date(2024, time.March, 12, 9, 0, 0, 0, time.UTC)
+	gc := GanttChart{
+		Tasks: []GanttTask{
+			{Name: "build", Intervals: []GanttInterval{{Start: start, End: start.Add(10 * time.Minute)}}},
+			{Name: "deploy", Intervals: []GanttInterval{{Start: start.Add(20 * time.Minute), End: start.Add(40 * time.Minute)}}, DependsOn: []string{"build"}},
+			{Name: "release", Milestones: []GanttMilestone{{Time: start.Add(45 * time.Minute)}}},
+		},
+	}
+	testutil.AssertNil(t, gc.Validate())
+
+	gc.Tasks[1].DependsOn = []string{"unknown"}
+	testutil.AssertNotNil(t, gc.Validate())
+	gc.Tasks[1].DependsOn = nil
+
+	gc.Tasks[0].Intervals[0].End = start.Add(-time.Minute)
+	testutil.AssertNotNil(t, gc.Validate())
+	gc.Tasks[0].Intervals[0].End = start.Add(10 * time.Minute)
+
+	gc.Tasks[2].Milestones = nil
+	testutil.AssertNotNil(t, gc.Validate())
+
+	gc.Tasks = nil
+	testutil.AssertNotNil(t, gc.Validate())
+}
+
+func TestGanttChartGetRows(t *testing.T) {
+	gc := GanttChart{
+		Tasks: []GanttTask{
+			{Name: "build", Lane: "ci"},
+			{Name: "deploy", Lane: "cd"},
+			{Name: "test", Lane: "ci"},
+			{Name: "release", Lane: "cd"},
+		},
+	}
+	lanes, rows := gc.getRows()
+
+	// the tasks are grouped by lane, in the order the lanes first appear.
+	testutil.AssertEqual(t, []string{"ci", "cd"}, lanes)
+	testutil.AssertLen(t, rows, 4)
+	testutil.AssertEqual(t, ganttRow{Task: 0, Lane: 0}, rows[0])
+	testutil.AssertEqual(t, ganttRow{Task: 2, Lane: 0}, rows[1])
+	testutil.AssertEqual(t, ganttRow{Task: 1, Lane: 1}, rows[2])
+	testutil.AssertEqual(t, ganttRow{Task: 3, Lane: 1}, rows[3])
+}
+
+func TestGanttChartGetRange(t *testing.T) {
+	start := time.Date(2024, time.March, 12, 9, 0, 0, 0, time.UTC)
+	gc := GanttChart{
+		Tasks: []GanttTask{
+			{Name: "build", Intervals: []GanttInterval{{Start: start, End: start.Add(10 * time.Minute)}}},
+			{Name: "test", Intervals: []GanttInterval{{Start: start.Add(5 * time.Minute), End: start.Add(15 * time.Minute)}}},
+			{Name: "release", Milestones: []GanttMilestone{{Time: start.Add(45 * time.Minute)}}},
+		},
+	}
+	xr := gc.getRange()
+
+	// the range spans the intervals and the milestones.
+	testutil.AssertEqual(t, TimeToFloat64(start), xr.GetMin())
+	testutil.AssertEqual(t, TimeToFloat64(start.Add(45*time.Minute)), xr.GetMax())
+}
+
+func TestGanttChartMilestonesOnly(t *testing.T) {
+	at := time.Date(2024, time.March, 12, 9, 0, 0, 0, time.UTC)
+	gc := GanttChart{
+		Tasks: []GanttTask{
+			{Name: "release", Milestones: []GanttMilestone{{Time: at, Label: "v1"}}},
+			{Name: "announce", Milestones: []GanttMilestone{{Time: at}}},
+		},
+	}
+	testutil.AssertNil(t, gc.Validate())
+
+	xr := gc.getRange()
+	testutil.AssertEqual(t, TimeToFloat64(at.Add(-12*time.Hour)), xr.GetMin())
+	testutil.AssertEqual(t, TimeToFloat64(at.Add(12*time.Hour)), xr.GetMax())
+	testutil.AssertNil(t, gc.Render(PNG, bytes.NewBuffer([]byte{})))
+
+	// a single task of no length is padded the same way.
+	gc = GanttChart{
+		Tasks: []GanttTask{
+			{Name: "cutover", Intervals: []GanttInterval{{Start: at, End: at}}},
+		},
+	}
+	testutil.AssertNil(t, gc.Render(PNG, bytes.NewBuffer([]byte{})))
+}
+
+func TestGanttChartGetDependencyPath(t *testing.T) {
+	gc := GanttChart{}
+
+	// a task that starts after its dependency ends gets an elbow.
+	path := gc.getDependencyPath(10, 10, 50, 30, 20)
+	testutil.AssertLen(t, path, 4)
+	testutil.AssertEqual(t, Point{X: 50, Y: 30}, path[3])
+
+	// a task that starts before its dependency ends gets a path that doubles back between the rows.
+	path = gc.getDependencyPath(50, 10, 20, 30, 20)
+	testutil.AssertLen(t, path, 6)
+	testutil.AssertEqual(t, 20, path[2].Y)
+	testutil.AssertEqual(t, Point{X: 20, Y: 30}, path[5])
+}
@@ -0,0 +1,577 @@
+package chart
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/golang/freetype/truetype"
+)
+
+// GaugeIndicator is an enum for how a gauge chart shows its value.
+type GaugeIndicator int
+
+const (
+	// GaugeIndicatorUnset is the unset state for the indicator; it behaves like `GaugeIndicatorNeedle`.
+	GaugeIndicatorUnset GaugeIndicator = 0
+	// GaugeIndicatorNeedle points a needle at the value over the bands.
+	GaugeIndicatorNeedle GaugeIndicator = 1
+	// GaugeIndicatorArc fills the gauge up to the value with the color of the band the value is in,
+	// and draws the bands as a thin strip around it.
+	GaugeIndicatorArc GaugeIndicator = 2
+)
+
+// GaugeBand is a colored range of a gauge chart, such as a threshold for a warning.
+type GaugeBand struct {
+	Min   float64
+	Max   float64
+	Style Style
+}
+
+// Contains returns if the value is within the band.
+func (gb GaugeBand) Contains(value float64) bool {
+	return value >= gb.Min && value <= gb.Max
+}
+
+// GaugeChart is a chart that shows a single value against a minimum and a maximum on a dial.
+type GaugeChart struct {
+	Title      string
+	TitleStyle Style
+
+	ColorPalette ColorPalette
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+
+	Min   float64
+	Max   float64
+	Value float64
+
+	// Sweep is the angle in degrees the gauge spans, up to 360 and centered on twelve o'clock; it defaults to a
+	// semicircle.
+	Sweep float64
+	// Thickness is the thickness of the dial as a fraction of its radius.
+	Thickness float64
+
+	// TrackStyle is the style of the dial where it isn't covered by a band.
+	TrackStyle Style
+	Bands      []GaugeBand
+
+	Indicator      GaugeIndicator
+	IndicatorStyle Style
+
+	// MajorTicks is the number of intervals between labeled ticks.
+	MajorTicks int
+	// MinorTicks is the number of intervals between unlabeled ticks within each major interval.
+	MinorTicks int
+	TickStyle  Style
+
+	// ValueFormatter formats the tick labels and the value in the center.
+	ValueFormatter ValueFormatter
+	ValueStyle     Style
+
+	// Caption is drawn under the value.
+	Caption      string
+	CaptionStyle Style
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	Elements []Renderable
+}
+
+// GetDPI returns the dpi for the chart.
+func (gc GaugeChart) GetDPI(defaults ...float64) float64 {
+	if gc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return gc.DPI
+}
+
+// GetFont returns the text font.
+func (gc GaugeChart) GetFont() *truetype.Font {
+	if gc.Font == nil {
+		return gc.defaultFont
+	}
+	return gc.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (gc GaugeChart) GetWidth() int {
+	if gc.Width == 0 {
+		return DefaultChartWidth
+	}
+	return gc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (gc GaugeChart) GetHeight() int {
+	if gc.Height == 0 {
+		return DefaultChartHeight
+	}
+	return gc.Height
+}
+
+// GetSweep returns the angle in degrees the gauge spans or the default.
+func (gc GaugeChart) GetSweep() float64 {
+	if gc.Sweep <= 0 {
+		return DefaultGaugeSweep
+	}
+	return math.Min(gc.Sweep, 360)
+}
+
+// GetThickness returns the thickness of the dial as a fraction of its radius or the default.
+func (gc GaugeChart) GetThickness() float64 {
+	if gc.Thickness <= 0 {
+		return DefaultGaugeThickness
+	}
+	return math.Min(gc.Thickness, 1)
+}
+
+// GetMajorTicks returns the number of major tick intervals or the default.
+func (gc GaugeChart) GetMajorTicks() int {
+	if gc.MajorTicks <= 0 {
+		return DefaultGaugeMajorTicks
+	}
+	return gc.MajorTicks
+}
+
+// GetMinorTicks returns the number of minor tick intervals per major interval or the default.
+func (gc GaugeChart) GetMinorTicks() int {
+	if gc.MinorTicks <= 0 {
+		return DefaultGaugeMinorTicks
+	}
+	return gc.MinorTicks
+}
+
+// GetValueFormatter returns the value formatter or the default.
+func (gc GaugeChart) GetValueFormatter() ValueFormatter {
+	if gc.ValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return gc.ValueFormatter
+}
+
+// Validate validates the chart.
+func (gc GaugeChart) Validate() error {
+	for _, value := range []float64{gc.Min, gc.Max, gc.Value} {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return fmt.Errorf("gauge chart min, max and value must be finite; min: %v max: %v value: %v", gc.Min, gc.Max, gc.Value)
+		}
+	}
+	if gc.Sweep < 0 || gc.Sweep > 360 || math.IsNaN(gc.Sweep) {
+		return fmt.Errorf("gauge chart sweep must be within (0, 360] degrees; sweep: %v", gc.Sweep)
+	}
+	if gc.Max <= gc.Min {
+		return fmt.Errorf("gauge chart max must be greater than min; min: %v max: %v", gc.Min, gc.Max)
+	}
+	for _, band := range gc.Bands {
+		if math.IsNaN(band.Min) || math.IsNaN(band.Max) || band.Max <= band.Min {
+			return fmt.Errorf("gauge band max must be greater than min; min: %v max: %v", band.Min, band.Max)
+		}
+	}
+	return nil
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (gc GaugeChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := gc.Validate(); err != nil {
+		return err
+	}
+
+	r, err := rp(gc.GetWidth(), gc.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if gc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		gc.defaultFont = defaultFont
+	}
+	r.SetDPI(gc.GetDPI(DefaultDPI))
+
+	canvasBox := gc.getCanvasBox(r)
+	gc.drawBackground(r)
+	gc.drawCanvas(r, canvasBox)
+
+	cx, cy, radius := gc.getDial(r, canvasBox)
+	inner := radius * (1 - gc.GetThickness())
+
+	if gc.Indicator == GaugeIndicatorArc {
+		// the bands are a strip around the outside of the track the value fills.
+		strip := (radius - inner) * DefaultGaugeBandStrip
+		gc.drawTrack(r, cx, cy, inner, radius-strip-DefaultGaugeBandSpacing)
+		gc.drawArc(r, cx, cy, inner, radius-strip-DefaultGaugeBandSpacing)
+		gc.drawBands(r, cx, cy, radius-strip, radius)
+	} else {
+		gc.drawTrack(r, cx, cy, inner, radius)
+		gc.drawBands(r, cx, cy, inner, radius)
+	}
+	gc.drawTicks(r, cx, cy, inner)
+	if gc.Indicator != GaugeIndicatorArc {
+		gc.drawNeedle(r, cx, cy, inner+(radius-inner)/2.0)
+	}
+	gc.drawValue(r, cx, cy)
+	gc.drawTitle(r)
+	for _, a := range gc.Elements {
+		a(r, canvasBox, gc.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+func (gc GaugeChart) drawBackground(r Renderer) {
+	Draw.Box(r, Box{
+		Right:  gc.GetWidth(),
+		Bottom: gc.GetHeight(),
+	}, gc.getBackgroundStyle())
+}
+
+func (gc GaugeChart) drawCanvas(r Renderer, canvasBox Box) {
+	Draw.Box(r, canvasBox, gc.getCanvasStyle())
+}
+
+func (gc GaugeChart) drawTitle(r Renderer) {
+	if len(gc.Title) > 0 && !gc.TitleStyle.Hidden {
+		Draw.TextWithin(r, gc.Title, gc.Box(), gc.styleDefaultsTitle())
+	}
+}
+
+func (gc GaugeChart) drawTrack(r Renderer, cx, cy int, inner, outer float64) {
+	style := gc.getTrackStyle()
+	if style.Hidden {
+		return
+	}
+	gc.drawSector(r, cx, cy, inner, outer, gc.Min, gc.Max, style)
+}
+
+func (gc GaugeChart) drawBands(r Renderer, cx, cy int, inner, outer float64) {
+	for index, band := range gc.Bands {
+		style := gc.getBandStyle(index)
+		if style.Hidden {
+			continue
+		}
+		gc.drawSector(r, cx, cy, inner, outer, band.Min, band.Max, style)
+	}
+}
+
+func (gc GaugeChart) drawArc(r Renderer, cx, cy int, inner, outer float64) {
+	style := gc.getArcStyle()
+	if style.Hidden {
+		return
+	}
+	gc.drawSector(r, cx, cy, inner, outer, gc.Min, gc.Value, style)
+}
+
+// drawSector draws the part of the dial between two values, clamped to the range of the gauge.
+func (gc GaugeChart) drawSector(r Renderer, cx, cy int, inner, outer, from, to float64, style Style) {
+	start, end := gc.getValueAngle(from), gc.getValueAngle(to)
+	if end <= start {
+		return
+	}
+	// the renderer measures angles from three o'clock.
+	Draw.AnnularSector(r, cx, cy, inner, outer, start-_pi2, end-start, style)
+}
+
+// drawTicks draws the ticks along the inside of the dial with a label for each major tick.
+func (gc GaugeChart) drawTicks(r Renderer, cx, cy int, radius float64) {
+	style := gc.getTickStyle()
+	if style.Hidden {
+		return
+	}
+
+	major, minor := gc.GetMajorTicks(), gc.GetMinorTicks()
+	vf := gc.GetValueFormatter()
+	for index := 0; index <= major*minor; index++ {
+		value := gc.Min + (gc.Max-gc.Min)*float64(index)/float64(major*minor)
+		angle := gc.getValueAngle(value)
+
+		length := DefaultGaugeMinorTickLength
+		if index%minor == 0 {
+			length = DefaultGaugeMajorTickLength
+		}
+		x0, y0 := CirclePoint(cx, cy, radius, angle)
+		x1, y1 := CirclePoint(cx, cy, radius-length, angle)
+		style.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(x0, y0)
+		r.LineTo(x1, y1)
+		r.Stroke()
+		r.ResetStyle()
+
+		// a full circle would draw the labels of the min and the max on top of each other.
+		if index%minor != 0 || (index == major*minor && gc.GetSweep() >= 360) {
+			continue
+		}
+		label := vf(value)
+		tb := Draw.MeasureText(r, label, style)
+		lx, ly := CirclePoint(cx, cy, radius-length-DefaultGaugeLabelPadding, angle)
+		lx, ly = polarLabelPosition(lx, ly, 0, angle+_pi, tb)
+		Draw.Text(r, label, lx, ly, style)
+	}
+}
+
+// drawNeedle draws a needle from the center of the dial to the value.
+func (gc GaugeChart) drawNeedle(r Renderer, cx, cy int, length float64) {
+	style := gc.getNeedleStyle()
+	if style.Hidden {
+		return
+	}
+	angle := gc.getValueAngle(gc.Value)
+	width := DefaultGaugeNeedleWidth
+	tx, ty := CirclePoint(cx, cy, length, angle)
+	lx, ly := CirclePoint(cx, cy, width/2.0, angle-_pi2)
+	rx, ry := CirclePoint(cx, cy, width/2.0, angle+_pi2)
+
+	style.GetFillAndStrokeOptions().WriteToRenderer(r)
+	r.MoveTo(lx, ly)
+	r.LineTo(tx, ty)
+	r.LineTo(rx, ry)
+	r.Close()
+	r.FillStroke()
+	r.Circle(width, cx, cy)
+	r.FillStroke()
+	r.ResetStyle()
+}
+
+// drawValue draws the value and the caption centered under the middle of the dial; with a needle they go
+// under its hub instead.
+func (gc GaugeChart) drawValue(r Renderer, cx, cy int) {
+	valueStyle, captionStyle := gc.getValueStyle(), gc.getCaptionStyle()
+
+	y := cy
+	if gc.Indicator != GaugeIndicatorArc {
+		y += int(DefaultGaugeNeedleWidth) + DefaultGaugeLabelPadding
+	}
+	if !valueStyle.Hidden {
+		value := gc.GetValueFormatter()(gc.Value)
+		tb := Draw.MeasureText(r, value, valueStyle)
+		if gc.Indicator == GaugeIndicatorArc {
+			// the value sits in the middle of the dial, just above its center.
+			Draw.Text(r, value, cx-tb.Width()>>1, y, valueStyle)
+		} else {
+			y += tb.Height()
+			Draw.Text(r, value, cx-tb.Width()>>1, y, valueStyle)
+		}
+		y += DefaultGaugeLabelPadding
+	}
+	if gc.Caption != "" && !captionStyle.Hidden {
+		tb := Draw.MeasureText(r, gc.Caption, captionStyle)
+		Draw.Text(r, gc.Caption, cx-tb.Width()>>1, y+tb.Height(), captionStyle)
+	}
+}
+
+// getValueAngle returns the angle in radians, clockwise from twelve o'clock, a value is drawn at;
+// values outside the range of the gauge are pinned to its ends.
+func (gc GaugeChart) getValueAngle(value float64) float64 {
+	sweep := DegreesToRadians(gc.GetSweep())
+	value = math.Max(gc.Min, math.Min(value, gc.Max))
+	return -sweep/2.0 + sweep*(value-gc.Min)/(gc.Max-gc.Min)
+}
+
+// getDial returns the center and the radius of the largest dial that fits the canvas with the text below its center.
+func (gc GaugeChart) getDial(r Renderer, canvasBox Box) (cx, cy int, radius float64) {
+	half := DegreesToRadians(gc.GetSweep()) / 2.0
+
+	// the extent of the dial, in multiples of its radius, to the side of and below its center.
+	side := 1.0
+	if half < _pi2 {
+		side = math.Sin(half)
+	}
+	below := math.Max(-math.Cos(half), 0)
+
+	text := float64(gc.getTextHeight(r))
+	width, height := float64(canvasBox.Width()), float64(canvasBox.Height())
+	radius = math.Min(width/(2*side), height/(1+below))
+	if radius*below < text {
+		radius = math.Min(radius, height-text)
+	}
+	radius = math.Max(radius, 0)
+
+	extent := radius + math.Max(radius*below, text)
+	cx = canvasBox.Left + canvasBox.Width()>>1
+	cy = canvasBox.Top + int((height-extent)/2.0+radius)
+	return
+}
+
+// getTextHeight returns the height of the text under the center of the dial.
+func (gc GaugeChart) getTextHeight(r Renderer) int {
+	var height int
+	if gc.Indicator != GaugeIndicatorArc {
+		height += int(DefaultGaugeNeedleWidth) + DefaultGaugeLabelPadding
+		if valueStyle := gc.getValueStyle(); !valueStyle.Hidden {
+			height += Draw.MeasureText(r, gc.GetValueFormatter()(gc.Value), valueStyle).Height() + DefaultGaugeLabelPadding
+		}
+	}
+	if captionStyle := gc.getCaptionStyle(); gc.Caption != "" && !captionStyle.Hidden {
+		height += Draw.MeasureText(r, gc.Caption, captionStyle).Height() + DefaultGaugeLabelPadding
+	}
+	return height
+}
+
+func (gc GaugeChart) getCanvasBox(r Renderer) Box {
+	canvasBox := gc.Box()
+	if len(gc.Title) > 0 && !gc.TitleStyle.Hidden {
+		titleBox := Draw.MeasureText(r, gc.Title, gc.styleDefaultsTitle())
+		canvasBox.Top += titleBox.Height() + DefaultTitleTop
+	}
+	return canvasBox
+}
+
+func (gc GaugeChart) getTrackStyle() Style {
+	return gc.TrackStyle.InheritFrom(Style{
+		FillColor: gc.GetColorPalette().AxisStrokeColor().WithAlpha(48),
+	})
+}
+
+func (gc GaugeChart) getBandStyle(index int) Style {
+	return gc.Bands[index].Style.InheritFrom(Style{
+		FillColor: gc.GetColorPalette().GetSeriesColor(index),
+	})
+}
+
+// getArcStyle returns the style of the arc filled up to the value, which takes the color of the band the value is in.
+func (gc GaugeChart) getArcStyle() Style {
+	defaults := Style{
+		FillColor: gc.GetColorPalette().GetSeriesColor(0),
+	}
+	for index, band := range gc.Bands {
+		if band.Contains(gc.Value) {
+			defaults.FillColor = gc.getBandStyle(index).GetFillColor()
+		}
+	}
+	return gc.IndicatorStyle.InheritFrom(defaults)
+}
+
+func (gc GaugeChart) getNeedleStyle() Style {
+	return gc.IndicatorStyle.InheritFrom(Style{
+		FillColor:   gc.GetColorPalette().TextColor(),
+		StrokeColor: gc.GetColorPalette().TextColor(),
+		StrokeWidth: DefaultAxisLineWidth,
+	})
+}
+
+func (gc GaugeChart) getTickStyle() Style {
+	return gc.TickStyle.InheritFrom(Style{
+		StrokeColor: gc.GetColorPalette().AxisStrokeColor(),
+		StrokeWidth: DefaultAxisLineWidth,
+		FontColor:   gc.GetColorPalette().TextColor(),
+		FontSize:    DefaultAxisFontSize,
+		Font:        gc.GetFont(),
+	})
+}
+
+func (gc GaugeChart) getValueStyle() Style {
+	return gc.ValueStyle.InheritFrom(Style{
+		FontColor: gc.GetColorPalette().TextColor(),
+		FontSize:  gc.getScaledFontSize() * 2.0,
+		Font:      gc.GetFont(),
+	})
+}
+
+func (gc GaugeChart) getCaptionStyle() Style {
+	return gc.CaptionStyle.InheritFrom(Style{
+		FontColor: gc.GetColorPalette().TextColor(),
+		FontSize:  gc.getScaledFontSize(),
+		Font:      gc.GetFont(),
+	})
+}
+
+func (gc GaugeChart) getScaledFontSize() float64 {
+	effectiveDimension := MinInt(gc.GetWidth(), gc.GetHeight())
+	if effectiveDimension >= 2048 {
+		return 48.0
+	} else if effectiveDimension >= 1024 {
+		return 24.0
+	} else if effectiveDimension > 512 {
+		return 18.0
+	} else if effectiveDimension > 256 {
+		return 12.0
+	}
+	return 10.0
+}
+
+func (gc GaugeChart) getBackgroundStyle() Style {
+	return gc.Background.InheritFrom(gc.styleDefaultsBackground())
+}
+
+func (gc GaugeChart) getCanvasStyle() Style {
+	return gc.Canvas.InheritFrom(gc.styleDefaultsCanvas())
+}
+
+func (gc GaugeChart) styleDefaultsCanvas() Style {
+	return Style{
+		FillColor:   gc.GetColorPalette().CanvasColor(),
+		StrokeColor: gc.GetColorPalette().CanvasStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (gc GaugeChart) styleDefaultsBackground() Style {
+	return Style{
+		FillColor:   gc.GetColorPalette().BackgroundColor(),
+		StrokeColor: gc.GetColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (gc GaugeChart) styleDefaultsElements() Style {
+	return Style{
+		Font: gc.GetFont(),
+	}
+}
+
+func (gc GaugeChart) styleDefaultsTitle() Style {
+	return gc.TitleStyle.InheritFrom(Style{
+		FontColor:           gc.GetColorPalette().TextColor(),
+		Font:                gc.GetFont(),
+		FontSize:            gc.getTitleFontSize(),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+func (gc GaugeChart) getTitleFontSize() float64 {
+	effectiveDimension := MinInt(gc.GetWidth(), gc.GetHeight())
+	if effectiveDimension >= 2048 {
+		return 48
+	} else if effectiveDimension >= 1024 {
+		return 24
+	} else if effectiveDimension >= 512 {
+		return 18
+	} else if effectiveDimension >= 256 {
+		return 12
+	}
+	return 10
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (gc GaugeChart) GetColorPalette() ColorPalette {
+	if gc.ColorPalette != nil {
+		return gc.ColorPalette
+	}
+	return DefaultColorPalette
+}
+
+// Box returns the chart bounds as a box.
+func (gc GaugeChart) Box() Box {
+	dpr := gc.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
+	dpb := gc.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
+
+	return Box{
+		Top:    gc.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
+		Left:   gc.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
+		Right:  gc.GetWidth() - dpr,
+		Bottom: gc.GetHeight() - dpb,
+	}
+}
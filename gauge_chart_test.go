@@ -0,0 +1,118 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestGaugeChart(t *testing.T) {
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	for _, indicator := range []GaugeIndicator{GaugeIndicatorNeedle, GaugeIndicatorArc} {
+		gc := GaugeChart{
+			Title:     "Test Title",
+			Font:      f,
+			Min:       0,
+			Max:       100,
+			Value:     72,
+			Indicator: indicator,
+			Bands: []GaugeBand{
+				{Min: 0, Max: 60},
+				{Min: 60, Max: 85},
+				{Min: 85, Max: 100},
+			},
+			Caption: "Test Caption",
+		}
+		testutil.AssertNil(t, gc.Render(PNG, bytes.NewBuffer([]byte{})))
+		testutil.AssertNil(t, gc.Render(SVG, bytes.NewBuffer([]byte{})))
+
+		// the dial is centered in the canvas with the text below its center.
+		r, err := PNG(gc.GetWidth(), gc.GetHeight())
+		testutil.AssertNil(t, err)
+		canvasBox := gc.getCanvasBox(r)
+		cx, cy, radius := gc.getDial(r, canvasBox)
+		testutil.AssertEqual(t, canvasBox.Left+canvasBox.Width()>>1, cx)
+		testutil.AssertTrue(t, radius > 0 && radius <= float64(canvasBox.Width())/2)
+		testutil.AssertTrue(t, float64(cy)-radius >= float64(canvasBox.Top))
+		testutil.AssertTrue(t, cy+gc.getTextHeight(r) <= canvasBox.Bottom)
+	}
+}
+
+func TestGaugeChartValidate(t *testing.T) {
+	gc := GaugeChart{
+		Min: 0,
+		Max: 100,
+		Bands: []GaugeBand{
+			{Min: 0, Max: 60},
+			{Min: 60, Max: 85},
+		},
+	}
+	testutil.AssertNil(t, gc.Validate())
+
+	gc.Bands[1].Max = math.NaN()
+	testutil.AssertNotNil(t, gc.Validate())
+
+	gc.Bands[1].Max = 50
+	testutil.AssertNotNil(t, gc.Validate())
+
+	gc.Bands = nil
+	gc.Sweep = 360
+	testutil.AssertNil(t, gc.Validate())
+
+	// a sweep of zero is unset, and the gauge is a semicircle.
+	gc.Sweep = 0
+	testutil.AssertNil(t, gc.Validate())
+
+	for _, sweep := range []float64{-90, 400, math.NaN()} {
+		gc.Sweep = sweep
+		testutil.AssertNotNil(t, gc.Validate())
+	}
+	gc.Sweep = 0
+
+	gc.Value = math.NaN()
+	testutil.AssertNotNil(t, gc.Validate())
+	testutil.AssertNotNil(t, gc.Render(PNG, bytes.NewBuffer([]byte{})))
+
+	gc.Value = 50
+	gc.Max = math.Inf(1)
+	testutil.AssertNotNil(t, gc.Validate())
+
+	gc.Max = gc.Min
+	testutil.AssertNotNil(t, gc.Validate())
+}
+
+func TestGaugeChartGetValueAngle(t *testing.T) {
+	gc := GaugeChart{Min: 0, Max: 100}
+
+	// a semicircle spans from nine o'clock to three o'clock, and values outside the range are pinned.
+	testutil.AssertEqual(t, -_pi2, gc.getValueAngle(0))
+	testutil.AssertEqual(t, 0.0, gc.getValueAngle(50))
+	testutil.AssertEqual(t, _pi2, gc.getValueAngle(100))
+	testutil.AssertEqual(t, _pi2, gc.getValueAngle(150))
+
+	gc.Sweep = 270
+	testutil.AssertTrue(t, math.Abs(gc.getValueAngle(0)+_3pi2/2.0) < 0.0001)
+}
+
+func TestGaugeChartGetArcStyle(t *testing.T) {
+	gc := GaugeChart{
+		Min:   0,
+		Max:   100,
+		Value: 72,
+		Bands: []GaugeBand{
+			{Min: 0, Max: 60},
+			{Min: 60, Max: 85},
+			{Min: 85, Max: 100},
+		},
+	}
+
+	// the arc takes the color of the band the value is in.
+	testutil.AssertEqual(t, gc.getBandStyle(1).GetFillColor(), gc.getArcStyle().GetFillColor())
+
+	gc.Value = 90
+	testutil.AssertEqual(t, gc.getBandStyle(2).GetFillColor(), gc.getArcStyle().GetFillColor())
+}
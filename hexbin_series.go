@@ -0,0 +1,213 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Interface Assertions.
+var (
+	_ Series         = (*HexbinSeries)(nil)
+	_ ValuesProvider = (*HexbinSeries)(nil)
+	_ binnedSeries   = (*HexbinSeries)(nil)
+)
+
+// HexbinSeries aggregates points into a grid of hexagons colored by the number of points in them, or the mean
+// of their values, for scatter plots with too many points to draw one by one.
+//
+// The hexagons are laid out on the canvas, so they are regular whatever the ranges of the axes.
+type HexbinSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	XValues []float64
+	YValues []float64
+	// Values are the values of the points averaged by `BinAggregateMean`.
+	Values []float64
+
+	// Radius is the radius in pixels of the hexagons.
+	Radius float64
+
+	Aggregate     BinAggregate
+	ColorProvider ColorProvider
+	// ValueFormatter formats the values of the `BinColorScale`.
+	ValueFormatter ValueFormatter
+}
+
+// hexbinKey is the column and row of a hexagon; odd rows are shifted right by half a hexagon.
+type hexbinKey struct {
+	Column, Row int
+}
+
+// GetName returns the name of the series.
+func (hs HexbinSeries) GetName() string {
+	return hs.Name
+}
+
+// GetStyle returns the series style.
+func (hs HexbinSeries) GetStyle() Style {
+	return hs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (hs HexbinSeries) GetYAxis() YAxisType {
+	return hs.YAxis
+}
+
+// Len returns the number of points.
+func (hs HexbinSeries) Len() int {
+	return len(hs.XValues)
+}
+
+// GetValues gets the x,y values at a given index.
+func (hs HexbinSeries) GetValues(index int) (x, y float64) {
+	return hs.XValues[index], hs.YValues[index]
+}
+
+// GetRadius returns the radius of the hexagons or the default.
+func (hs HexbinSeries) GetRadius() float64 {
+	if hs.Radius <= 0 {
+		return DefaultHexbinRadius
+	}
+	return hs.Radius
+}
+
+// getBins returns the points in each hexagon of the canvas.
+func (hs HexbinSeries) getBins(canvasBox Box, xrange, yrange Range) map[hexbinKey]*bin {
+	bins := map[hexbinKey]*bin{}
+	for index := range hs.XValues {
+		if math.IsNaN(hs.XValues[index]) || math.IsNaN(hs.YValues[index]) {
+			continue
+		}
+		x := float64(xrange.Translate(hs.XValues[index]))
+		y := float64(canvasBox.Height() - yrange.Translate(hs.YValues[index]))
+		key := hs.getKey(x, y)
+
+		b, ok := bins[key]
+		if !ok {
+			b = &bin{}
+			bins[key] = b
+		}
+		if len(hs.Values) > 0 {
+			b.add(hs.Values[index])
+		} else {
+			b.add(0)
+		}
+	}
+	return bins
+}
+
+// getKey returns the hexagon a point of the canvas is in; the one with the nearest center of those in the rows
+// above and below the point.
+func (hs HexbinSeries) getKey(x, y float64) hexbinKey {
+	dx, dy := hs.getSpacing()
+
+	var nearest hexbinKey
+	distance := math.Inf(1)
+	for row := math.Floor(y / dy); row <= math.Floor(y/dy)+1; row++ {
+		key := hexbinKey{Column: int(math.Round(x/dx - hexbinShift(row))), Row: int(row)}
+		cx, cy := hs.getCenter(key)
+		if d := math.Hypot(x-cx, y-cy); d < distance {
+			nearest, distance = key, d
+		}
+	}
+	return nearest
+}
+
+// getCenter returns the center of a hexagon on the canvas.
+func (hs HexbinSeries) getCenter(key hexbinKey) (x, y float64) {
+	dx, dy := hs.getSpacing()
+	return (float64(key.Column) + hexbinShift(float64(key.Row))) * dx, float64(key.Row) * dy
+}
+
+// getSpacing returns the distances between the centers of neighbouring hexagons in a row and between rows.
+func (hs HexbinSeries) getSpacing() (dx, dy float64) {
+	radius := hs.GetRadius()
+	return radius * math.Sqrt(3), radius * 1.5
+}
+
+// hexbinShift returns how far the hexagons of a row are shifted right, in hexagons.
+func hexbinShift(row float64) float64 {
+	if int(row)&1 == 1 {
+		return 0.5
+	}
+	return 0
+}
+
+func (hs HexbinSeries) getColorScale(canvasBox Box, xrange, yrange Range) binColorScale {
+	var bins []bin
+	for _, b := range hs.getBins(canvasBox, xrange, yrange) {
+		bins = append(bins, *b)
+	}
+	return newBinColorScale(bins, hs.Aggregate, hs.ColorProvider, hs.ValueFormatter)
+}
+
+// Render renders the series.
+func (hs HexbinSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	bins := hs.getBins(canvasBox, xrange, yrange)
+	var values []bin
+	for _, b := range bins {
+		values = append(values, *b)
+	}
+	scale := newBinColorScale(values, hs.Aggregate, hs.ColorProvider, hs.ValueFormatter)
+
+	// the hexagons are drawn row by row so the output doesn't depend on the order of the map.
+	keys := make([]hexbinKey, 0, len(bins))
+	for key := range bins {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Row != keys[j].Row {
+			return keys[i].Row < keys[j].Row
+		}
+		return keys[i].Column < keys[j].Column
+	})
+
+	radius := hs.GetRadius()
+	for _, key := range keys {
+		b := bins[key]
+		cx, cy := hs.getCenter(key)
+		cx += float64(canvasBox.Left)
+		cy += float64(canvasBox.Top)
+
+		// the hexagons are stroked in their own color unless the style sets one, so neighbours leave no seams.
+		color := scale.GetColor(b.value(hs.Aggregate))
+		style := hs.Style.InheritFrom(Style{
+			FillColor:   color,
+			StrokeColor: color,
+			StrokeWidth: DefaultAxisLineWidth,
+		})
+		style.GetFillAndStrokeOptions().WriteToRenderer(r)
+		for corner := 0; corner < 6; corner++ {
+			angle := _pi/6 + float64(corner)*_pi/3
+			x, y := int(cx+radius*math.Cos(angle)), int(cy+radius*math.Sin(angle))
+			if corner == 0 {
+				r.MoveTo(x, y)
+			} else {
+				r.LineTo(x, y)
+			}
+		}
+		r.Close()
+		r.FillStroke()
+		r.ResetStyle()
+	}
+}
+
+// Validate validates the series.
+func (hs HexbinSeries) Validate() error {
+	if len(hs.XValues) == 0 {
+		return fmt.Errorf("hexbin series; must have xvalues set")
+	}
+	if len(hs.XValues) != len(hs.YValues) {
+		return fmt.Errorf("hexbin series; must have the same number of xvalues and yvalues")
+	}
+	if hs.Aggregate == BinAggregateMean && len(hs.Values) != len(hs.XValues) {
+		return fmt.Errorf("hexbin series; must have a value for each xvalue to aggregate the mean")
+	}
+	if len(hs.Values) > 0 && len(hs.Values) != len(hs.XValues) {
+		return fmt.Errorf("hexbin series; must have the same number of values as xvalues")
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestHexbinSeries(t *testing.T) {
+	expected := map[BinAggregate][2]float64{
+		BinAggregateCount:    {1, 3},
+		BinAggregateLogCount: {0, math.Log10(3)},
+		BinAggregateMean:     {2, 5},
+	}
+	for aggregate, bounds := range expected {
+		hs := HexbinSeries{
+			XValues:   []float64{0, 0, 0, 1, 2},
+			YValues:   []float64{0, 0, 0, 1, 2},
+			Values:    []float64{1, 2, 3, 4, 5},
+			Aggregate: aggregate,
+		}
+		graph := Chart{
+			Background: Style{
+				Padding: Box{Left: 60},
+			},
+			Series: []Series{hs},
+		}
+		graph.Elements = []Renderable{BinColorScale(&graph)}
+		testutil.AssertNil(t, graph.Render(PNG, bytes.NewBuffer([]byte{})))
+		testutil.AssertNil(t, graph.Render(SVG, bytes.NewBuffer([]byte{})))
+
+		// the points at the origin share a hexagon, and the others are a hexagon each.
+		canvasBox := Box{Right: 200, Bottom: 200}
+		xrange := &ContinuousRange{Min: 0, Max: 2, Domain: 200}
+		yrange := &ContinuousRange{Min: 0, Max: 2, Domain: 200}
+		bins := hs.getBins(canvasBox, xrange, yrange)
+		testutil.AssertLen(t, bins, 3)
+		testutil.AssertEqual(t, 3, bins[hs.getKey(0, 200)].Count)
+
+		scale := hs.getColorScale(canvasBox, xrange, yrange)
+		testutil.AssertInDelta(t, bounds[0], scale.Min, 0.0001)
+		testutil.AssertInDelta(t, bounds[1], scale.Max, 0.0001)
+	}
+}
+
+func TestHexbinSeriesGetKey(t *testing.T) {
+	hs := HexbinSeries{Radius: 10}
+
+	// every point falls into the hexagon with the nearest center.
+	random := rand.New(rand.NewSource(1))
+	for index := 0; index < 1000; index++ {
+		x, y := random.Float64()*200, random.Float64()*200
+		key := hs.getKey(x, y)
+		cx, cy := hs.getCenter(key)
+		distance := math.Hypot(x-cx, y-cy)
+
+		for _, neighbour := range []hexbinKey{
+			{key.Column - 1, key.Row}, {key.Column + 1, key.Row},
+			{key.Column - 1, key.Row - 1}, {key.Column, key.Row - 1}, {key.Column + 1, key.Row - 1},
+			{key.Column - 1, key.Row + 1}, {key.Column, key.Row + 1}, {key.Column + 1, key.Row + 1},
+		} {
+			nx, ny := hs.getCenter(neighbour)
+			testutil.AssertTrue(t, distance <= math.Hypot(x-nx, y-ny)+0.0001)
+		}
+	}
+}
+
+func TestHexbinSeriesValidate(t *testing.T) {
+	hs := HexbinSeries{
+		XValues: []float64{0, 1, 2},
+		YValues: []float64{0, 1, 2},
+		Values:  []float64{1, 2, 3},
+	}
+	testutil.AssertNil(t, hs.Validate())
+
+	hs.YValues = []float64{0, 1}
+	testutil.AssertNotNil(t, hs.Validate())
+
+	hs.YValues = []float64{0, 1, 2}
+	hs.Values = nil
+	testutil.AssertNil(t, hs.Validate())
+	hs.Aggregate = BinAggregateMean
+	testutil.AssertNotNil(t, hs.Validate())
+}
@@ -0,0 +1,171 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series         = (*Histogram2DSeries)(nil)
+	_ ValuesProvider = (*Histogram2DSeries)(nil)
+	_ binnedSeries   = (*Histogram2DSeries)(nil)
+)
+
+// Histogram2DSeries aggregates points into a grid of rectangular bins over the range of the points, colored by
+// the number of points in them or the mean of their values.
+type Histogram2DSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	XValues []float64
+	YValues []float64
+	// Values are the values of the points averaged by `BinAggregateMean`.
+	Values []float64
+
+	// XBins and YBins are the number of bins along each axis.
+	XBins int
+	YBins int
+
+	Aggregate     BinAggregate
+	ColorProvider ColorProvider
+	// ValueFormatter formats the values of the `BinColorScale`.
+	ValueFormatter ValueFormatter
+}
+
+// GetName returns the name of the series.
+func (hs Histogram2DSeries) GetName() string {
+	return hs.Name
+}
+
+// GetStyle returns the series style.
+func (hs Histogram2DSeries) GetStyle() Style {
+	return hs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (hs Histogram2DSeries) GetYAxis() YAxisType {
+	return hs.YAxis
+}
+
+// Len returns the number of points.
+func (hs Histogram2DSeries) Len() int {
+	return len(hs.XValues)
+}
+
+// GetValues gets the x,y values at a given index.
+func (hs Histogram2DSeries) GetValues(index int) (x, y float64) {
+	return hs.XValues[index], hs.YValues[index]
+}
+
+// GetXBins returns the number of bins along the x-axis or the default.
+func (hs Histogram2DSeries) GetXBins() int {
+	if hs.XBins <= 0 {
+		return DefaultHistogram2DBins
+	}
+	return hs.XBins
+}
+
+// GetYBins returns the number of bins along the y-axis or the default.
+func (hs Histogram2DSeries) GetYBins() int {
+	if hs.YBins <= 0 {
+		return DefaultHistogram2DBins
+	}
+	return hs.YBins
+}
+
+// getBins returns the points in each bin, row by row from the smallest y-value, and the bounds of the bins.
+func (hs Histogram2DSeries) getBins() (bins []bin, minx, maxx, miny, maxy float64) {
+	xbins, ybins := hs.GetXBins(), hs.GetYBins()
+	bins = make([]bin, xbins*ybins)
+	minx, maxx = ValueSequence(hs.XValues...).MinMax()
+	miny, maxy = ValueSequence(hs.YValues...).MinMax()
+
+	for index := range hs.XValues {
+		if math.IsNaN(hs.XValues[index]) || math.IsNaN(hs.YValues[index]) {
+			continue
+		}
+		column := histogram2DBin(hs.XValues[index], minx, maxx, xbins)
+		row := histogram2DBin(hs.YValues[index], miny, maxy, ybins)
+		if len(hs.Values) > 0 {
+			bins[row*xbins+column].add(hs.Values[index])
+		} else {
+			bins[row*xbins+column].add(0)
+		}
+	}
+	return
+}
+
+// histogram2DBin returns the bin of a value; the largest value falls into the last bin.
+func histogram2DBin(value, min, max float64, count int) int {
+	if max <= min {
+		return 0
+	}
+	return MinInt(int(float64(count)*(value-min)/(max-min)), count-1)
+}
+
+func (hs Histogram2DSeries) getColorScale(canvasBox Box, xrange, yrange Range) binColorScale {
+	bins, _, _, _, _ := hs.getBins()
+	return newBinColorScale(hs.getFilledBins(bins), hs.Aggregate, hs.ColorProvider, hs.ValueFormatter)
+}
+
+// getFilledBins returns the bins that have points.
+func (hs Histogram2DSeries) getFilledBins(bins []bin) []bin {
+	var filled []bin
+	for _, b := range bins {
+		if b.Count > 0 {
+			filled = append(filled, b)
+		}
+	}
+	return filled
+}
+
+// Render renders the series.
+func (hs Histogram2DSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if len(hs.XValues) == 0 {
+		return
+	}
+	bins, minx, maxx, miny, maxy := hs.getBins()
+	scale := newBinColorScale(hs.getFilledBins(bins), hs.Aggregate, hs.ColorProvider, hs.ValueFormatter)
+
+	xbins, ybins := hs.GetXBins(), hs.GetYBins()
+	binWidth, binHeight := (maxx-minx)/float64(xbins), (maxy-miny)/float64(ybins)
+	for index, b := range bins {
+		if b.Count == 0 {
+			continue
+		}
+		column, row := index%xbins, index/xbins
+		x0, y0 := minx+float64(column)*binWidth, miny+float64(row)*binHeight
+
+		// the bins are stroked in their own color unless the style sets one, so neighbours leave no seams.
+		color := scale.GetColor(b.value(hs.Aggregate))
+		Draw.Box(r, Box{
+			Top:    canvasBox.Bottom - yrange.Translate(y0+binHeight),
+			Left:   canvasBox.Left + xrange.Translate(x0),
+			Right:  canvasBox.Left + xrange.Translate(x0+binWidth),
+			Bottom: canvasBox.Bottom - yrange.Translate(y0),
+		}, hs.Style.InheritFrom(Style{
+			FillColor:   color,
+			StrokeColor: color,
+			StrokeWidth: DefaultAxisLineWidth,
+		}))
+	}
+}
+
+// Validate validates the series.
+func (hs Histogram2DSeries) Validate() error {
+	if len(hs.XValues) == 0 {
+		return fmt.Errorf("2d histogram series; must have xvalues set")
+	}
+	if len(hs.XValues) != len(hs.YValues) {
+		return fmt.Errorf("2d histogram series; must have the same number of xvalues and yvalues")
+	}
+	if hs.Aggregate == BinAggregateMean && len(hs.Values) != len(hs.XValues) {
+		return fmt.Errorf("2d histogram series; must have a value for each xvalue to aggregate the mean")
+	}
+	if len(hs.Values) > 0 && len(hs.Values) != len(hs.XValues) {
+		return fmt.Errorf("2d histogram series; must have the same number of values as xvalues")
+	}
+	return nil
+}
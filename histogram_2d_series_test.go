@@ -0,0 +1,71 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestHistogram2DSeries(t *testing.T) {
+	hs := Histogram2DSeries{
+		XValues:   []float64{0, 1, 2, 3, 4, 4},
+		YValues:   []float64{0, 1, 2, 3, 4, 4},
+		Values:    []float64{1, 2, 3, 4, 5, 7},
+		Aggregate: BinAggregateMean,
+	}
+	graph := Chart{
+		Series: []Series{hs},
+	}
+	graph.Elements = []Renderable{BinColorScale(&graph)}
+
+	testutil.AssertNil(t, graph.Render(PNG, bytes.NewBuffer([]byte{})))
+	testutil.AssertNil(t, graph.Render(SVG, bytes.NewBuffer([]byte{})))
+
+	// the ranges cover the points, and the two points in the last bin are averaged.
+	xrange, yrange, _ := graph.getRanges()
+	testutil.AssertEqual(t, 0.0, xrange.GetMin())
+	testutil.AssertEqual(t, 4.0, xrange.GetMax())
+	testutil.AssertEqual(t, 4.0, yrange.GetMax())
+	scale := hs.getColorScale(Box{}, xrange, yrange)
+	testutil.AssertEqual(t, 1.0, scale.Min)
+	testutil.AssertEqual(t, 6.0, scale.Max)
+}
+
+func TestHistogram2DSeriesGetBins(t *testing.T) {
+	hs := Histogram2DSeries{
+		XValues: []float64{0, 1, 2, 3, 4, 4},
+		YValues: []float64{0, 0, 0, 4, 4, 4},
+		XBins:   2,
+		YBins:   2,
+	}
+	bins, minx, maxx, miny, maxy := hs.getBins()
+	testutil.AssertEqual(t, 0.0, minx)
+	testutil.AssertEqual(t, 4.0, maxx)
+	testutil.AssertEqual(t, 0.0, miny)
+	testutil.AssertEqual(t, 4.0, maxy)
+
+	// the largest values fall into the last bins.
+	testutil.AssertEqual(t, 2, bins[0].Count)
+	testutil.AssertEqual(t, 1, bins[1].Count)
+	testutil.AssertEqual(t, 0, bins[2].Count)
+	testutil.AssertEqual(t, 3, bins[3].Count)
+	testutil.AssertLen(t, hs.getFilledBins(bins), 3)
+}
+
+func TestHistogram2DSeriesValidate(t *testing.T) {
+	hs := Histogram2DSeries{
+		XValues: []float64{0, 1},
+		YValues: []float64{0, 1},
+	}
+	testutil.AssertNil(t, hs.Validate())
+
+	hs.Aggregate = BinAggregateMean
+	testutil.AssertNotNil(t, hs.Validate())
+
+	hs.Values = []float64{1, 2}
+	testutil.AssertNil(t, hs.Validate())
+
+	hs.YValues = nil
+	testutil.AssertNotNil(t, hs.Validate())
+}
@@ -0,0 +1,278 @@
+package chart
+
+import "math"
+
+const (
+	// DefaultIchimokuConversionPeriod is the default number of periods of the conversion line (tenkan-sen).
+	DefaultIchimokuConversionPeriod = 9
+	// DefaultIchimokuBasePeriod is the default number of periods of the base line (kijun-sen).
+	DefaultIchimokuBasePeriod = 26
+	// DefaultIchimokuLeadingSpanPeriod is the default number of periods of the second leading span (senkou span b).
+	DefaultIchimokuLeadingSpanPeriod = 52
+	// DefaultIchimokuDisplacement is the default number of periods the leading spans are shifted forward and the
+	// lagging span back.
+	DefaultIchimokuDisplacement = 26
+)
+
+// Interface Assertions.
+var (
+	_ Series                    = (*IchimokuSeries)(nil)
+	_ BoundedValuesProvider     = (*IchimokuSeries)(nil)
+	_ FirstValuesProvider       = (*IchimokuSeries)(nil)
+	_ LastValuesProvider        = (*IchimokuSeries)(nil)
+	_ BoundedLastValuesProvider = (*IchimokuSeries)(nil)
+)
+
+// IchimokuSeries draws the ichimoku cloud (ichimoku kinko hyo) of a price series.
+//
+// The conversion and base lines are halfway between the highest high and the lowest low of the last 9 and 26
+// periods. The cloud is between the two leading spans, shifted 26 periods forward; the first is halfway between
+// the conversion and base lines and the second is halfway between the highest high and lowest low of the last
+// 52 periods. The lagging span is the close shifted 26 periods back.
+//
+// The close is the value of the inner series; the high and low are the values of their series, or the close if
+// they aren't set. The x-values past the end of the inner series are spaced as its x-values are on average.
+//
+// `Style` is the style of the cloud where the first leading span is above the second and `BearishStyle` where it
+// is below. The lines are hidden by setting `Hidden` on their styles.
+type IchimokuSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	ConversionPeriod  int
+	BasePeriod        int
+	LeadingSpanPeriod int
+	Displacement      int
+	InnerSeries       ValuesProvider
+	HighSeries        ValuesProvider
+	LowSeries         ValuesProvider
+
+	BearishStyle    Style
+	ConversionStyle Style
+	BaseStyle       Style
+	LaggingStyle    Style
+}
+
+// GetName returns the name of the time series.
+func (ics IchimokuSeries) GetName() string {
+	return ics.Name
+}
+
+// GetStyle returns the cloud style.
+func (ics IchimokuSeries) GetStyle() Style {
+	return ics.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ics IchimokuSeries) GetYAxis() YAxisType {
+	return ics.YAxis
+}
+
+// GetPeriods returns the periods of the conversion line, the base line and the second leading span.
+func (ics IchimokuSeries) GetPeriods() (conversion, base, leadingSpan int) {
+	if ics.ConversionPeriod == 0 {
+		conversion = DefaultIchimokuConversionPeriod
+	} else {
+		conversion = ics.ConversionPeriod
+	}
+	if ics.BasePeriod == 0 {
+		base = DefaultIchimokuBasePeriod
+	} else {
+		base = ics.BasePeriod
+	}
+	if ics.LeadingSpanPeriod == 0 {
+		leadingSpan = DefaultIchimokuLeadingSpanPeriod
+	} else {
+		leadingSpan = ics.LeadingSpanPeriod
+	}
+	return
+}
+
+// GetDisplacement returns the number of periods the leading spans are shifted forward and the lagging span back.
+func (ics IchimokuSeries) GetDisplacement() int {
+	if ics.Displacement == 0 {
+		return DefaultIchimokuDisplacement
+	}
+	return ics.Displacement
+}
+
+// Len returns the number of elements in the series.
+func (ics IchimokuSeries) Len() int {
+	return ics.InnerSeries.Len()
+}
+
+// GetConversionValues gets the conversion line at a given index.
+func (ics IchimokuSeries) GetConversionValues(index int) (x, y float64) {
+	if ics.InnerSeries == nil {
+		return
+	}
+	conversion, _, _ := ics.GetPeriods()
+	x, _ = ics.InnerSeries.GetValues(index)
+	y = ics.getMidpoint(index, conversion)
+	return
+}
+
+// GetValues gets the base line at a given index.
+func (ics IchimokuSeries) GetValues(index int) (x, y float64) {
+	if ics.InnerSeries == nil {
+		return
+	}
+	_, base, _ := ics.GetPeriods()
+	x, _ = ics.InnerSeries.GetValues(index)
+	y = ics.getMidpoint(index, base)
+	return
+}
+
+// GetBoundedValues gets the leading spans computed at a given index, at the x-value they're shifted forward to.
+func (ics IchimokuSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	if ics.InnerSeries == nil {
+		return
+	}
+	_, conversion := ics.GetConversionValues(index)
+	_, base := ics.GetValues(index)
+	_, _, leadingSpan := ics.GetPeriods()
+	x = getExtrapolatedXValue(ics.InnerSeries, index+ics.GetDisplacement())
+	y1 = (conversion + base) / 2
+	y2 = ics.getMidpoint(index, leadingSpan)
+	return
+}
+
+// GetFirstValues returns the first value of the base line.
+func (ics IchimokuSeries) GetFirstValues() (x, y float64) {
+	if ics.InnerSeries == nil || ics.InnerSeries.Len() == 0 {
+		return
+	}
+	return ics.GetValues(0)
+}
+
+// GetLastValues returns the last value of the base line.
+func (ics IchimokuSeries) GetLastValues() (x, y float64) {
+	if ics.InnerSeries == nil || ics.InnerSeries.Len() == 0 {
+		return
+	}
+	return ics.GetValues(ics.InnerSeries.Len() - 1)
+}
+
+// GetBoundedLastValues returns the last leading spans, at the x-value they're shifted forward to.
+func (ics IchimokuSeries) GetBoundedLastValues() (x, y1, y2 float64) {
+	if ics.InnerSeries == nil || ics.InnerSeries.Len() == 0 {
+		return
+	}
+	return ics.GetBoundedValues(ics.InnerSeries.Len() - 1)
+}
+
+// getMidpoint returns halfway between the highest high and the lowest low of the periods up to an index.
+func (ics IchimokuSeries) getMidpoint(index, period int) float64 {
+	highest, lowest := getHighestLowest(ics.InnerSeries, ics.HighSeries, ics.LowSeries, index, period)
+	return (highest + lowest) / 2
+}
+
+// Render renders the series.
+func (ics IchimokuSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	seriesLength := ics.InnerSeries.Len()
+	if seriesLength == 0 {
+		return
+	}
+	ics.drawCloud(r, canvasBox, xrange, yrange)
+
+	var conversion, base, lagging ContinuousSeries
+	displacement := ics.GetDisplacement()
+	for index := 0; index < seriesLength; index++ {
+		x, y := ics.GetConversionValues(index)
+		conversion.XValues, conversion.YValues = append(conversion.XValues, x), append(conversion.YValues, y)
+		x, y = ics.GetValues(index)
+		base.XValues, base.YValues = append(base.XValues, x), append(base.YValues, y)
+		if index+displacement < seriesLength {
+			_, y = ics.InnerSeries.GetValues(index + displacement)
+			lagging.XValues, lagging.YValues = append(lagging.XValues, x), append(lagging.YValues, y)
+		}
+	}
+
+	for _, line := range []struct {
+		Style  Style
+		Color  Style
+		Values ContinuousSeries
+	}{
+		{ics.ConversionStyle, Style{StrokeColor: ColorBlue}, conversion},
+		{ics.BaseStyle, Style{StrokeColor: ColorOrange}, base},
+		{ics.LaggingStyle, Style{StrokeColor: DefaultAxisColor, StrokeDashArray: []float64{5.0, 5.0}}, lagging},
+	} {
+		style := line.Style.InheritFrom(line.Color.InheritFrom(Style{StrokeWidth: defaults.GetStrokeWidth(DefaultSeriesLineWidth)}))
+		if style.Hidden || line.Values.Len() == 0 {
+			continue
+		}
+		Draw.LineSeries(r, canvasBox, xrange, yrange, style, line.Values)
+	}
+}
+
+// drawCloud draws the cloud between the leading spans, in the bullish or bearish style depending on which span
+// is above, splitting it where the spans cross.
+func (ics IchimokuSeries) drawCloud(r Renderer, canvasBox Box, xrange, yrange Range) {
+	bullish := ics.Style.InheritFrom(Style{
+		StrokeWidth: 1.0,
+		StrokeColor: ColorGreen.WithAlpha(96),
+		FillColor:   ColorGreen.WithAlpha(48),
+	})
+	bearish := ics.BearishStyle.InheritFrom(Style{
+		StrokeWidth: 1.0,
+		StrokeColor: ColorRed.WithAlpha(96),
+		FillColor:   ColorRed.WithAlpha(48),
+	})
+
+	var cloud ichimokuCloud
+	flush := func(above bool) {
+		if cloud.Len() > 1 {
+			if above {
+				Draw.BoundedSeries(r, canvasBox, xrange, yrange, bullish, cloud)
+			} else {
+				Draw.BoundedSeries(r, canvasBox, xrange, yrange, bearish, cloud)
+			}
+		}
+		cloud = ichimokuCloud{}
+	}
+
+	var px, pa, pb float64
+	for index := 0; index < ics.InnerSeries.Len(); index++ {
+		x, a, b := ics.GetBoundedValues(index)
+		if index > 0 && (pa >= pb) != (a >= b) {
+			// the spans cross between the values; both parts of the cloud end where they do.
+			t := (pa - pb) / ((pa - pb) - (a - b))
+			cx, cy := px+t*(x-px), pa+t*(a-pa)
+			cloud.add(cx, cy, cy)
+			flush(pa >= pb)
+			cloud.add(cx, cy, cy)
+		}
+		cloud.add(x, a, b)
+		px, pa, pb = x, a, b
+	}
+	flush(pa >= pb)
+}
+
+// Validate validates the series.
+func (ics IchimokuSeries) Validate() error {
+	return validateIndicatorSeries("ichimoku", ics.InnerSeries, ics.HighSeries, ics.LowSeries)
+}
+
+// ichimokuCloud is a part of the cloud of an ichimoku series the same span is above in.
+type ichimokuCloud struct {
+	XValues []float64
+	AValues []float64
+	BValues []float64
+}
+
+func (ic *ichimokuCloud) add(x, a, b float64) {
+	ic.XValues = append(ic.XValues, x)
+	ic.AValues = append(ic.AValues, a)
+	ic.BValues = append(ic.BValues, b)
+}
+
+// Len returns the number of values.
+func (ic ichimokuCloud) Len() int {
+	return len(ic.XValues)
+}
+
+// GetBoundedValues returns the leading spans at an index.
+func (ic ichimokuCloud) GetBoundedValues(index int) (x, y1, y2 float64) {
+	return ic.XValues[index], math.Max(ic.AValues[index], ic.BValues[index]), math.Min(ic.AValues[index], ic.BValues[index])
+}
@@ -0,0 +1,53 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestIchimokuSeries(t *testing.T) {
+	ics := IchimokuSeries{
+		InnerSeries: mockValuesProvider{
+			X: LinearRange(1.0, 100.0),
+			Y: LinearRange(1.0, 100.0),
+		},
+	}
+
+	_, y := ics.GetConversionValues(99)
+	testutil.AssertEqual(t, 96.0, y)
+	x, y := ics.GetLastValues()
+	testutil.AssertEqual(t, 100.0, x)
+	testutil.AssertEqual(t, 87.5, y)
+
+	// the leading spans are shifted forward past the end of the series.
+	x, y1, y2 := ics.GetBoundedLastValues()
+	testutil.AssertEqual(t, 126.0, x)
+	testutil.AssertEqual(t, 91.75, y1)
+	testutil.AssertEqual(t, 74.5, y2)
+
+	x, _, _ = ics.GetBoundedValues(10)
+	testutil.AssertEqual(t, 37.0, x)
+}
+
+func TestIchimokuSeriesRender(t *testing.T) {
+	var xvalues, yvalues []float64
+	for x := 0.0; x < 200; x++ {
+		xvalues = append(xvalues, x)
+		yvalues = append(yvalues, 100+10*math.Sin(x/15))
+	}
+	graph := Chart{
+		Series: []Series{
+			IchimokuSeries{
+				InnerSeries:  ContinuousSeries{XValues: xvalues, YValues: yvalues},
+				LaggingStyle: Style{Hidden: true},
+			},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, graph.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
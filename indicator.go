@@ -0,0 +1,88 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// getHighLowClose returns the high, low and close of a period of a price series; the close is the value of the
+// inner series, and the high and low are the close if their series aren't set.
+func getHighLowClose(innerSeries, highSeries, lowSeries ValuesProvider, index int) (x, high, low, close float64) {
+	x, close = innerSeries.GetValues(index)
+	high, low = close, close
+	if highSeries != nil {
+		_, high = highSeries.GetValues(index)
+	}
+	if lowSeries != nil {
+		_, low = lowSeries.GetValues(index)
+	}
+	return
+}
+
+// getHighestLowest returns the highest high and the lowest low of the periods up to and including an index.
+func getHighestLowest(innerSeries, highSeries, lowSeries ValuesProvider, index, period int) (highest, lowest float64) {
+	highest, lowest = -math.MaxFloat64, math.MaxFloat64
+	for i := MaxInt(0, index-period+1); i <= index; i++ {
+		_, high, low, _ := getHighLowClose(innerSeries, highSeries, lowSeries, i)
+		highest = math.Max(highest, high)
+		lowest = math.Min(lowest, low)
+	}
+	return
+}
+
+// wilderAverage returns the moving averages of values with Wilder's smoothing; the average of the values so far
+// for the first period, and then the previous average moved by a period'th of the difference to each value.
+func wilderAverage(values []float64, period int) []float64 {
+	averages := make([]float64, len(values))
+	var sum float64
+	for index, value := range values {
+		if index < period {
+			sum += value
+			averages[index] = sum / float64(index+1)
+			continue
+		}
+		averages[index] = averages[index-1] + (value-averages[index-1])/float64(period)
+	}
+	return averages
+}
+
+// validateIndicatorSeries checks an indicator has its inner series and that the series it reads have the same length.
+func validateIndicatorSeries(name string, innerSeries ValuesProvider, series ...ValuesProvider) error {
+	if innerSeries == nil {
+		return fmt.Errorf("%s series requires InnerSeries to be set", name)
+	}
+	for _, s := range series {
+		if s != nil && s.Len() != innerSeries.Len() {
+			return fmt.Errorf("%s series requires its series to have the same length as InnerSeries", name)
+		}
+	}
+	return nil
+}
+
+// channelValuesProvider is a band around a middle line.
+type channelValuesProvider interface {
+	ValuesProvider
+	BoundedValuesProvider
+}
+
+// drawChannel draws the band of a channel and its middle line in the stroke of the band.
+func drawChannel(r Renderer, canvasBox Box, xrange, yrange Range, style Style, cvp channelValuesProvider) {
+	if cvp.Len() == 0 {
+		return
+	}
+	Draw.BoundedSeries(r, canvasBox, xrange, yrange, style, cvp)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, Style{
+		StrokeColor:     style.StrokeColor,
+		StrokeWidth:     style.StrokeWidth,
+		StrokeDashArray: style.StrokeDashArray,
+	}, cvp)
+}
+
+// channelStyleDefaults are the defaults of the bands of channel series.
+func channelStyleDefaults() Style {
+	return Style{
+		StrokeWidth: 1.0,
+		StrokeColor: DefaultAxisColor.WithAlpha(64),
+		FillColor:   DefaultAxisColor.WithAlpha(32),
+	}
+}
@@ -0,0 +1,155 @@
+package chart
+
+const (
+	// DefaultKeltnerChannelPeriod is the default period of the EMA at the middle of a keltner channel.
+	DefaultKeltnerChannelPeriod = 20
+	// DefaultKeltnerChannelATRPeriod is the default period of the ATR the width of a keltner channel is from.
+	DefaultKeltnerChannelATRPeriod = 10
+	// DefaultKeltnerChannelMultiplier is the default number of ATRs the bands are above and below the middle.
+	DefaultKeltnerChannelMultiplier = 2.0
+)
+
+// Interface Assertions.
+var (
+	_ Series                    = (*KeltnerChannelSeries)(nil)
+	_ BoundedValuesProvider     = (*KeltnerChannelSeries)(nil)
+	_ FirstValuesProvider       = (*KeltnerChannelSeries)(nil)
+	_ LastValuesProvider        = (*KeltnerChannelSeries)(nil)
+	_ BoundedLastValuesProvider = (*KeltnerChannelSeries)(nil)
+)
+
+// KeltnerChannelSeries draws keltner channels for a price series.
+// The channel is an EMA of the closes with bands a multiple of the ATR above and below it.
+//
+// The close is the value of the inner series; the high and low are the values of their series, or the close if
+// they aren't set.
+type KeltnerChannelSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Period      int
+	ATRPeriod   int
+	Multiplier  float64
+	InnerSeries ValuesProvider
+	HighSeries  ValuesProvider
+	LowSeries   ValuesProvider
+
+	ema *EMASeries
+	atr *ATRSeries
+}
+
+// GetName returns the name of the time series.
+func (kcs KeltnerChannelSeries) GetName() string {
+	return kcs.Name
+}
+
+// GetStyle returns the line style.
+func (kcs KeltnerChannelSeries) GetStyle() Style {
+	return kcs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (kcs KeltnerChannelSeries) GetYAxis() YAxisType {
+	return kcs.YAxis
+}
+
+// GetPeriod returns the window size of the EMA.
+func (kcs KeltnerChannelSeries) GetPeriod() int {
+	if kcs.Period == 0 {
+		return DefaultKeltnerChannelPeriod
+	}
+	return kcs.Period
+}
+
+// GetATRPeriod returns the window size of the ATR.
+func (kcs KeltnerChannelSeries) GetATRPeriod() int {
+	if kcs.ATRPeriod == 0 {
+		return DefaultKeltnerChannelATRPeriod
+	}
+	return kcs.ATRPeriod
+}
+
+// GetMultiplier returns the number of ATRs the bands are above and below the middle.
+func (kcs KeltnerChannelSeries) GetMultiplier() float64 {
+	if kcs.Multiplier == 0 {
+		return DefaultKeltnerChannelMultiplier
+	}
+	return kcs.Multiplier
+}
+
+// Len returns the number of elements in the series.
+func (kcs KeltnerChannelSeries) Len() int {
+	return kcs.InnerSeries.Len()
+}
+
+// GetValues gets the middle of the channel at a given index.
+func (kcs *KeltnerChannelSeries) GetValues(index int) (x, y float64) {
+	if kcs.InnerSeries == nil {
+		return
+	}
+	if kcs.ema == nil || kcs.atr == nil {
+		kcs.ensureChildSeries()
+	}
+	return kcs.ema.GetValues(index)
+}
+
+// GetBoundedValues gets the bands of the channel at a given index.
+func (kcs *KeltnerChannelSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	if kcs.InnerSeries == nil {
+		return
+	}
+	x, y := kcs.GetValues(index)
+	_, atr := kcs.atr.GetValues(index)
+	y1 = y + kcs.GetMultiplier()*atr
+	y2 = y - kcs.GetMultiplier()*atr
+	return
+}
+
+// GetFirstValues returns the first middle value of the channel.
+func (kcs *KeltnerChannelSeries) GetFirstValues() (x, y float64) {
+	if kcs.InnerSeries == nil || kcs.InnerSeries.Len() == 0 {
+		return
+	}
+	return kcs.GetValues(0)
+}
+
+// GetLastValues returns the last middle value of the channel.
+func (kcs *KeltnerChannelSeries) GetLastValues() (x, y float64) {
+	if kcs.InnerSeries == nil || kcs.InnerSeries.Len() == 0 {
+		return
+	}
+	return kcs.GetValues(kcs.InnerSeries.Len() - 1)
+}
+
+// GetBoundedLastValues returns the last bands of the channel.
+func (kcs *KeltnerChannelSeries) GetBoundedLastValues() (x, y1, y2 float64) {
+	if kcs.InnerSeries == nil || kcs.InnerSeries.Len() == 0 {
+		return
+	}
+	return kcs.GetBoundedValues(kcs.InnerSeries.Len() - 1)
+}
+
+func (kcs *KeltnerChannelSeries) ensureChildSeries() {
+	kcs.ema = &EMASeries{
+		InnerSeries: kcs.InnerSeries,
+		Period:      kcs.GetPeriod(),
+	}
+	kcs.atr = &ATRSeries{
+		InnerSeries: kcs.InnerSeries,
+		HighSeries:  kcs.HighSeries,
+		LowSeries:   kcs.LowSeries,
+		Period:      kcs.GetATRPeriod(),
+	}
+}
+
+// Render renders the series.
+func (kcs *KeltnerChannelSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := kcs.Style.InheritFrom(defaults.InheritFrom(channelStyleDefaults()))
+	drawChannel(r, canvasBox, xrange, yrange, style, kcs)
+}
+
+// Validate validates the series.
+func (kcs *KeltnerChannelSeries) Validate() error {
+	return validateIndicatorSeries("keltner channel", kcs.InnerSeries, kcs.HighSeries, kcs.LowSeries)
+}
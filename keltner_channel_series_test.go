@@ -0,0 +1,29 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestKeltnerChannelSeries(t *testing.T) {
+	closes, highs, lows := make([]float64, 30), make([]float64, 30), make([]float64, 30)
+	for index := range closes {
+		closes[index], highs[index], lows[index] = 100, 101, 99
+	}
+
+	kcs := &KeltnerChannelSeries{
+		InnerSeries: mockValuesProvider{X: LinearRange(1.0, 30.0), Y: closes},
+		HighSeries:  mockValuesProvider{X: LinearRange(1.0, 30.0), Y: highs},
+		LowSeries:   mockValuesProvider{X: LinearRange(1.0, 30.0), Y: lows},
+	}
+
+	x, y := kcs.GetLastValues()
+	testutil.AssertEqual(t, 30.0, x)
+	testutil.AssertInDelta(t, 100.0, y, 0.0000001)
+
+	x, y1, y2 := kcs.GetBoundedLastValues()
+	testutil.AssertEqual(t, 30.0, x)
+	testutil.AssertInDelta(t, 104.0, y1, 0.0000001)
+	testutil.AssertInDelta(t, 96.0, y2, 0.0000001)
+}
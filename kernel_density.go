@@ -0,0 +1,162 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ ValuesProvider = (*KernelDensity)(nil)
+)
+
+// Kernel is the kernel of a kernel density estimate; a probability density that is symmetric around zero.
+type Kernel func(u float64) float64
+
+// GaussianKernel is the standard normal density.
+func GaussianKernel(u float64) float64 {
+	return math.Exp(-u*u/2.0) / math.Sqrt(_2pi)
+}
+
+// EpanechnikovKernel is the parabolic kernel, which is zero outside of [-1, 1].
+func EpanechnikovKernel(u float64) float64 {
+	if math.Abs(u) > 1 {
+		return 0
+	}
+	return 0.75 * (1 - u*u)
+}
+
+// BandwidthSelector returns the bandwidth of a kernel density estimate for the samples.
+type BandwidthSelector func(samples Seq) float64
+
+// SilvermanBandwidth is Silverman's rule of thumb, which is robust to outliers and skew.
+func SilvermanBandwidth(samples Seq) float64 {
+	spread := samples.StdDev()
+	if samples.Len() > 1 {
+		sorted := samples.Sort().Values()
+		if iqr := (interpolatedPercentile(sorted, 0.75) - interpolatedPercentile(sorted, 0.25)) / 1.34; iqr > 0 && (iqr < spread || spread == 0) {
+			spread = iqr
+		}
+	}
+	return kernelDensityBandwidth(0.9, spread, samples.Len())
+}
+
+// ScottBandwidth is Scott's rule of thumb, which is optimal for normally distributed samples.
+func ScottBandwidth(samples Seq) float64 {
+	return kernelDensityBandwidth(1.06, samples.StdDev(), samples.Len())
+}
+
+// kernelDensityBandwidth returns a rule of thumb bandwidth, or 1 if the samples have no spread.
+func kernelDensityBandwidth(factor, spread float64, count int) float64 {
+	if spread <= 0 || count == 0 {
+		return 1
+	}
+	return factor * spread * math.Pow(float64(count), -0.2)
+}
+
+// KernelDensity is a kernel density estimate of the distribution of samples, provided as values evenly spaced
+// over a range.
+type KernelDensity struct {
+	Samples []float64
+
+	// Kernel is the kernel; it defaults to `GaussianKernel`.
+	Kernel Kernel
+	// Bandwidth is the width of the kernel; if unset it is chosen by the `BandwidthSelector`.
+	Bandwidth float64
+	// BandwidthSelector chooses the bandwidth; it defaults to `SilvermanBandwidth`.
+	BandwidthSelector BandwidthSelector
+
+	// Min and Max are the range the density is provided over; if unset it is the range of the samples
+	// extended by `DefaultKernelDensityCut` bandwidths on each side.
+	Min float64
+	Max float64
+	// Points is the number of values provided.
+	Points int
+
+	bandwidth float64
+	min       float64
+	max       float64
+}
+
+// GetKernel returns the kernel or the default.
+func (kd KernelDensity) GetKernel() Kernel {
+	if kd.Kernel == nil {
+		return GaussianKernel
+	}
+	return kd.Kernel
+}
+
+// GetBandwidth returns the bandwidth, or the bandwidth chosen for the samples.
+func (kd KernelDensity) GetBandwidth() float64 {
+	if kd.Bandwidth > 0 {
+		return kd.Bandwidth
+	}
+	if kd.BandwidthSelector != nil {
+		return kd.BandwidthSelector(ValueSequence(kd.Samples...))
+	}
+	return SilvermanBandwidth(ValueSequence(kd.Samples...))
+}
+
+// GetPoints returns the number of values provided or the default.
+func (kd KernelDensity) GetPoints() int {
+	if kd.Points < 2 {
+		return DefaultKernelDensityPoints
+	}
+	return kd.Points
+}
+
+// GetBounds returns the range the density is provided over.
+func (kd KernelDensity) GetBounds() (min, max float64) {
+	if kd.Max > kd.Min {
+		return kd.Min, kd.Max
+	}
+	bandwidth := kd.GetBandwidth()
+	min, max = ValueSequence(kd.Samples...).MinMax()
+	return min - DefaultKernelDensityCut*bandwidth, max + DefaultKernelDensityCut*bandwidth
+}
+
+// Density returns the estimated density at a value.
+func (kd KernelDensity) Density(x float64) float64 {
+	return kd.density(x, kd.GetKernel(), kd.GetBandwidth())
+}
+
+func (kd KernelDensity) density(x float64, kernel Kernel, bandwidth float64) float64 {
+	if len(kd.Samples) == 0 {
+		return 0
+	}
+	var total float64
+	for _, sample := range kd.Samples {
+		total += kernel((x - sample) / bandwidth)
+	}
+	return total / (float64(len(kd.Samples)) * bandwidth)
+}
+
+// Len returns the number of values provided.
+func (kd *KernelDensity) Len() int {
+	if len(kd.Samples) == 0 {
+		return 0
+	}
+	return kd.GetPoints()
+}
+
+// GetValues returns the value at the index and its density; the bandwidth and the range are computed on first use.
+func (kd *KernelDensity) GetValues(index int) (x, y float64) {
+	if kd.bandwidth == 0 {
+		kd.bandwidth = kd.GetBandwidth()
+		kd.min, kd.max = kd.GetBounds()
+	}
+	x = kd.min + (kd.max-kd.min)*float64(index)/float64(kd.GetPoints()-1)
+	y = kd.density(x, kd.GetKernel(), kd.bandwidth)
+	return
+}
+
+// Validate validates the density estimate.
+func (kd KernelDensity) Validate() error {
+	if len(kd.Samples) == 0 {
+		return fmt.Errorf("kernel density must have samples set")
+	}
+	if kd.Bandwidth < 0 {
+		return fmt.Errorf("kernel density bandwidth cannot be negative; bandwidth: %v", kd.Bandwidth)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package chart
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestKernels(t *testing.T) {
+	// both kernels are densities, so they integrate to one.
+	for _, kernel := range []Kernel{GaussianKernel, EpanechnikovKernel} {
+		var total float64
+		for u := -6.0; u < 6.0; u += 0.001 {
+			total += kernel(u) * 0.001
+		}
+		testutil.AssertInDelta(t, 1.0, total, 0.001)
+	}
+	testutil.AssertEqual(t, 0.0, EpanechnikovKernel(1.5))
+	testutil.AssertEqual(t, 0.75, EpanechnikovKernel(0))
+}
+
+func TestBandwidthSelectors(t *testing.T) {
+	samples := ValueSequence(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	stddev := samples.StdDev()
+
+	testutil.AssertInDelta(t, 1.06*stddev*math.Pow(10, -0.2), ScottBandwidth(samples), 1e-9)
+	testutil.AssertTrue(t, SilvermanBandwidth(samples) < ScottBandwidth(samples))
+
+	// samples without spread get a bandwidth of one.
+	testutil.AssertEqual(t, 1.0, SilvermanBandwidth(ValueSequence(3, 3, 3)))
+	testutil.AssertEqual(t, 1.0, ScottBandwidth(ValueSequence(3)))
+
+	// the quartiles of a few samples are interpolated between them.
+	testutil.AssertInDelta(t, 0.9*(1/1.34)*math.Pow(2, -0.2), SilvermanBandwidth(ValueSequence(3, 1)), 0.0000001)
+}
+
+func TestKernelDensity(t *testing.T) {
+	kd := &KernelDensity{
+		Samples:   []float64{0, 1, 2},
+		Bandwidth: 0.5,
+		Points:    201,
+	}
+	testutil.AssertNil(t, kd.Validate())
+	testutil.AssertEqual(t, 201, kd.Len())
+
+	min, max := kd.GetBounds()
+	testutil.AssertEqual(t, -1.5, min)
+	testutil.AssertEqual(t, 3.5, max)
+
+	x, _ := kd.GetValues(0)
+	testutil.AssertEqual(t, -1.5, x)
+	x, _ = kd.GetValues(kd.Len() - 1)
+	testutil.AssertEqual(t, 3.5, x)
+
+	// the estimate is a density, so it integrates to (nearly) one over the bounds.
+	var total float64
+	for index := 1; index < kd.Len(); index++ {
+		x0, y0 := kd.GetValues(index - 1)
+		x1, y1 := kd.GetValues(index)
+		total += (x1 - x0) * (y0 + y1) / 2
+	}
+	testutil.AssertInDelta(t, 1.0, total, 0.01)
+
+	// it is symmetric around the middle sample.
+	testutil.AssertInDelta(t, kd.Density(0.5), kd.Density(1.5), 1e-9)
+}
+
+func TestKernelDensityValidate(t *testing.T) {
+	kd := KernelDensity{}
+	testutil.AssertNotNil(t, kd.Validate())
+
+	kd = KernelDensity{Samples: []float64{1}, Bandwidth: -1}
+	testutil.AssertNotNil(t, kd.Validate())
+}
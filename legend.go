@@ -7,31 +7,6 @@ import (
 // Legend returns a legend renderable function.
 func Legend(c *Chart, userDefaults ...Style) Renderable {
 	return func(r Renderer, cb Box, chartDefaults Style) {
-		legendDefaults := Style{
-			FillColor:   drawing.ColorWhite,
-			FontColor:   DefaultTextColor,
-			FontSize:    8.0,
-			StrokeColor: DefaultAxisColor,
-			StrokeWidth: DefaultAxisLineWidth,
-		}
-
-		var legendStyle Style
-		if len(userDefaults) > 0 {
-			legendStyle = userDefaults[0].InheritFrom(chartDefaults.InheritFrom(legendDefaults))
-		} else {
-			legendStyle = chartDefaults.InheritFrom(legendDefaults)
-		}
-
-		// DEFAULTS
-		legendPadding := Box{
-			Top:    5,
-			Left:   5,
-			Right:  5,
-			Bottom: 5,
-		}
-		lineTextGap := 5
-		lineLengthMinimum := 25
-
 		var labels []string
 		var lines []Style
 		for index, s := range c.Series {
@@ -42,78 +17,122 @@ func Legend(c *Chart, userDefaults ...Style) Renderable {
 				}
 			}
 		}
+		drawLegend(r, cb, chartDefaults, labels, lines, userDefaults...)
+	}
+}
 
-		legend := Box{
-			Top:  cb.Top,
-			Left: cb.Left,
-			// bottom and right will be sized by the legend content + relevant padding.
+// RadarLegend returns a legend renderable function for a radar chart.
+func RadarLegend(rc *RadarChart, userDefaults ...Style) Renderable {
+	return func(r Renderer, cb Box, chartDefaults Style) {
+		var labels []string
+		var lines []Style
+		for index := range rc.Series {
+			if style := rc.getSeriesStyle(index); !style.Hidden {
+				labels = append(labels, rc.Series[index].Name)
+				lines = append(lines, style)
+			}
 		}
+		drawLegend(r, cb, chartDefaults, labels, lines, userDefaults...)
+	}
+}
 
-		legendContent := Box{
-			Top:    legend.Top + legendPadding.Top,
-			Left:   legend.Left + legendPadding.Left,
-			Right:  legend.Left + legendPadding.Left,
-			Bottom: legend.Top + legendPadding.Top,
-		}
+// drawLegend draws a legend with a line in the given style after each label in the top left of the canvas box.
+func drawLegend(r Renderer, cb Box, chartDefaults Style, labels []string, lines []Style, userDefaults ...Style) {
+	legendDefaults := Style{
+		FillColor:   drawing.ColorWhite,
+		FontColor:   DefaultTextColor,
+		FontSize:    8.0,
+		StrokeColor: DefaultAxisColor,
+		StrokeWidth: DefaultAxisLineWidth,
+	}
 
-		legendStyle.GetTextOptions().WriteToRenderer(r)
+	var legendStyle Style
+	if len(userDefaults) > 0 {
+		legendStyle = userDefaults[0].InheritFrom(chartDefaults.InheritFrom(legendDefaults))
+	} else {
+		legendStyle = chartDefaults.InheritFrom(legendDefaults)
+	}
 
-		// measure
-		labelCount := 0
-		for x := 0; x < len(labels); x++ {
-			if len(labels[x]) > 0 {
-				tb := r.MeasureText(labels[x])
-				if labelCount > 0 {
-					legendContent.Bottom += DefaultMinimumTickVerticalSpacing
-				}
-				legendContent.Bottom += tb.Height()
-				right := legendContent.Left + tb.Width() + lineTextGap + lineLengthMinimum
-				legendContent.Right = MaxInt(legendContent.Right, right)
-				labelCount++
+	// DEFAULTS
+	legendPadding := Box{
+		Top:    5,
+		Left:   5,
+		Right:  5,
+		Bottom: 5,
+	}
+	lineTextGap := 5
+	lineLengthMinimum := 25
+
+	legend := Box{
+		Top:  cb.Top,
+		Left: cb.Left,
+		// bottom and right will be sized by the legend content + relevant padding.
+	}
+
+	legendContent := Box{
+		Top:    legend.Top + legendPadding.Top,
+		Left:   legend.Left + legendPadding.Left,
+		Right:  legend.Left + legendPadding.Left,
+		Bottom: legend.Top + legendPadding.Top,
+	}
+
+	legendStyle.GetTextOptions().WriteToRenderer(r)
+
+	// measure
+	labelCount := 0
+	for x := 0; x < len(labels); x++ {
+		if len(labels[x]) > 0 {
+			tb := r.MeasureText(labels[x])
+			if labelCount > 0 {
+				legendContent.Bottom += DefaultMinimumTickVerticalSpacing
 			}
+			legendContent.Bottom += tb.Height()
+			right := legendContent.Left + tb.Width() + lineTextGap + lineLengthMinimum
+			legendContent.Right = MaxInt(legendContent.Right, right)
+			labelCount++
 		}
+	}
 
-		legend = legend.Grow(legendContent)
-		legend.Right = legendContent.Right + legendPadding.Right
-		legend.Bottom = legendContent.Bottom + legendPadding.Bottom
+	legend = legend.Grow(legendContent)
+	legend.Right = legendContent.Right + legendPadding.Right
+	legend.Bottom = legendContent.Bottom + legendPadding.Bottom
 
-		Draw.Box(r, legend, legendStyle)
+	Draw.Box(r, legend, legendStyle)
 
-		legendStyle.GetTextOptions().WriteToRenderer(r)
+	legendStyle.GetTextOptions().WriteToRenderer(r)
 
-		ycursor := legendContent.Top
-		tx := legendContent.Left
-		legendCount := 0
-		var label string
-		for x := 0; x < len(labels); x++ {
-			label = labels[x]
-			if len(label) > 0 {
-				if legendCount > 0 {
-					ycursor += DefaultMinimumTickVerticalSpacing
-				}
+	ycursor := legendContent.Top
+	tx := legendContent.Left
+	legendCount := 0
+	var label string
+	for x := 0; x < len(labels); x++ {
+		label = labels[x]
+		if len(label) > 0 {
+			if legendCount > 0 {
+				ycursor += DefaultMinimumTickVerticalSpacing
+			}
 
-				tb := r.MeasureText(label)
+			tb := r.MeasureText(label)
 
-				ty := ycursor + tb.Height()
-				r.Text(label, tx, ty)
+			ty := ycursor + tb.Height()
+			r.Text(label, tx, ty)
 
-				th2 := tb.Height() >> 1
+			th2 := tb.Height() >> 1
 
-				lx := tx + tb.Width() + lineTextGap
-				ly := ty - th2
-				lx2 := legendContent.Right - legendPadding.Right
+			lx := tx + tb.Width() + lineTextGap
+			ly := ty - th2
+			lx2 := legendContent.Right - legendPadding.Right
 
-				r.SetStrokeColor(lines[x].GetStrokeColor())
-				r.SetStrokeWidth(lines[x].GetStrokeWidth())
-				r.SetStrokeDashArray(lines[x].GetStrokeDashArray())
+			r.SetStrokeColor(lines[x].GetStrokeColor())
+			r.SetStrokeWidth(lines[x].GetStrokeWidth())
+			r.SetStrokeDashArray(lines[x].GetStrokeDashArray())
 
-				r.MoveTo(lx, ly)
-				r.LineTo(lx2, ly)
-				r.Stroke()
+			r.MoveTo(lx, ly)
+			r.LineTo(lx2, ly)
+			r.Stroke()
 
-				ycursor += tb.Height()
-				legendCount++
-			}
+			ycursor += tb.Height()
+			legendCount++
 		}
 	}
 }
@@ -10,6 +10,7 @@ var (
 	_ FirstValuesProvider       = (*LinearRegressionSeries)(nil)
 	_ LastValuesProvider        = (*LinearRegressionSeries)(nil)
 	_ LinearCoefficientProvider = (*LinearRegressionSeries)(nil)
+	_ RegressionProvider        = (*LinearRegressionSeries)(nil)
 )
 
 // LinearRegressionSeries is a series that plots the n-nearest neighbors
@@ -127,6 +128,44 @@ func (lrs *LinearRegressionSeries) GetLastValues() (x, y float64) {
 	return
 }
 
+// GetCoefficients returns the intercept and the slope of the fitted line.
+func (lrs *LinearRegressionSeries) GetCoefficients() []float64 {
+	if lrs.IsZero() {
+		lrs.computeCoefficients()
+	}
+	slope := lrs.m / lrs.stddevx
+	return []float64{lrs.b - slope*lrs.avgx, slope}
+}
+
+// GetRSquared returns the coefficient of determination of the fitted line.
+func (lrs *LinearRegressionSeries) GetRSquared() float64 {
+	var yvalues []float64
+	for index := lrs.GetOffset(); index < lrs.GetEndIndex(); index++ {
+		_, y := lrs.InnerSeries.GetValues(index)
+		yvalues = append(yvalues, y)
+	}
+	return regressionRSquared(yvalues, lrs.GetResiduals())
+}
+
+// GetResiduals returns the differences between the values the line is fitted to and the fitted values.
+func (lrs *LinearRegressionSeries) GetResiduals() []float64 {
+	if lrs.IsZero() {
+		lrs.computeCoefficients()
+	}
+	var residuals []float64
+	for index := lrs.GetOffset(); index < lrs.GetEndIndex(); index++ {
+		x, y := lrs.InnerSeries.GetValues(index)
+		residuals = append(residuals, y-((lrs.m*lrs.normalize(x))+lrs.b))
+	}
+	return residuals
+}
+
+// GetEquation returns the fitted equation.
+func (lrs *LinearRegressionSeries) GetEquation() string {
+	coefficients := lrs.GetCoefficients()
+	return fmt.Sprintf("y = %sx%s", formatRegressionCoefficient(coefficients[1]), formatRegressionTerm(coefficients[0], ""))
+}
+
 // Render renders the series.
 func (lrs *LinearRegressionSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
 	style := lrs.Style.InheritFrom(defaults)
@@ -75,3 +75,21 @@ func TestLinearRegressionSeriesWindowAndOffset(t *testing.T) {
 	testutil.AssertInDelta(t, 80.0, lrxn, 0.0000001)
 	testutil.AssertInDelta(t, 80.0, lryn, 0.0000001)
 }
+
+func TestLinearRegressionSeriesFit(t *testing.T) {
+	linRegSeries := &LinearRegressionSeries{
+		InnerSeries: ContinuousSeries{
+			XValues: []float64{1, 2, 3, 4, 5},
+			YValues: []float64{1, 3, 5, 7, 9},
+		},
+	}
+
+	coefficients := linRegSeries.GetCoefficients()
+	testutil.AssertLen(t, coefficients, 2)
+	testutil.AssertInDelta(t, -1.0, coefficients[0], 0.0000001)
+	testutil.AssertInDelta(t, 2.0, coefficients[1], 0.0000001)
+	testutil.AssertInDelta(t, 1.0, linRegSeries.GetRSquared(), 0.0000001)
+	// the fit leaves out the last value of the window.
+	testutil.AssertLen(t, linRegSeries.GetResiduals(), 4)
+	testutil.AssertEqual(t, "y = 2x - 1", linRegSeries.GetEquation())
+}
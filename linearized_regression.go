@@ -0,0 +1,42 @@
+package chart
+
+import "fmt"
+
+// linearizedRegressionModel is the transform of a curve that is fitted as a line through the transformed values;
+// y = a + b*x for the transformed values, with the fitted values transformed back by the inverse of the y-transform.
+type linearizedRegressionModel struct {
+	// Name names the series in validation errors.
+	Name string
+
+	TransformX func(float64) float64
+	TransformY func(float64) float64
+	InverseY   func(float64) float64
+
+	// PositiveX and PositiveY are set if the transform of the x or y-values is only defined for positive values.
+	PositiveX bool
+	PositiveY bool
+}
+
+// fitLinearizedRegression fits the curve of a model to the window of an inner series.
+func fitLinearizedRegression(model linearizedRegressionModel, innerSeries ValuesProvider, offset, limit int, band RegressionBand, confidence float64) *regression {
+	xvalues, yvalues := regressionWindow(innerSeries, offset, limit)
+	return newLinearizedRegression(xvalues, yvalues, model.TransformX, model.TransformY, model.InverseY, band, confidence)
+}
+
+// validateLinearizedRegression validates that the inner series is set and the values of its window are within
+// the domain of the transforms of a model.
+func validateLinearizedRegression(model linearizedRegressionModel, innerSeries ValuesProvider, offset, limit int) error {
+	if innerSeries == nil {
+		return fmt.Errorf("%s regression series requires InnerSeries to be set", model.Name)
+	}
+	xvalues, yvalues := regressionWindow(innerSeries, offset, limit)
+	for index := range xvalues {
+		if model.PositiveX && xvalues[index] <= 0 {
+			return fmt.Errorf("%s regression series requires positive x-values; x-value: %v", model.Name, xvalues[index])
+		}
+		if model.PositiveY && yvalues[index] <= 0 {
+			return fmt.Errorf("%s regression series requires positive y-values; y-value: %v", model.Name, yvalues[index])
+		}
+	}
+	return nil
+}
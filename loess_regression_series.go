@@ -0,0 +1,231 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Interface Assertions.
+var (
+	_ Series                = (*LOESSRegressionSeries)(nil)
+	_ ValuesProvider        = (*LOESSRegressionSeries)(nil)
+	_ BoundedValuesProvider = (*LOESSRegressionSeries)(nil)
+	_ FirstValuesProvider   = (*LOESSRegressionSeries)(nil)
+	_ LastValuesProvider    = (*LOESSRegressionSeries)(nil)
+	_ RegressionProvider    = (*LOESSRegressionSeries)(nil)
+)
+
+// LOESSRegressionSeries draws a locally weighted regression of the values of an inner series; each fitted value
+// is a line fitted to the nearest values, weighted by how near they are, so the curve follows the values
+// without a model of their shape.
+//
+// The band is estimated from the weights the fitted values take the values with, as for a linear smoother.
+type LOESSRegressionSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Limit       int
+	Offset      int
+	InnerSeries ValuesProvider
+
+	// Span is the fraction of the values each local line is fitted to; the larger the span the smoother the curve.
+	Span float64
+
+	// Band is the band drawn around the curve with the `Confidence` level, which defaults to 95%.
+	Band       RegressionBand
+	BandStyle  Style
+	Confidence float64
+
+	regression *regression
+}
+
+// GetName returns the name of the series.
+func (lrs LOESSRegressionSeries) GetName() string {
+	return lrs.Name
+}
+
+// GetStyle returns the line style.
+func (lrs LOESSRegressionSeries) GetStyle() Style {
+	return lrs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (lrs LOESSRegressionSeries) GetYAxis() YAxisType {
+	return lrs.YAxis
+}
+
+// GetSpan returns the fraction of the values each local line is fitted to or the default.
+func (lrs LOESSRegressionSeries) GetSpan() float64 {
+	if lrs.Span <= 0 || lrs.Span > 1 {
+		return DefaultLOESSSpan
+	}
+	return lrs.Span
+}
+
+// GetConfidence returns the confidence level of the band or the default.
+func (lrs LOESSRegressionSeries) GetConfidence() float64 {
+	if lrs.Confidence <= 0 || lrs.Confidence >= 1 {
+		return DefaultRegressionConfidence
+	}
+	return lrs.Confidence
+}
+
+// Len returns the number of values in the window of the inner series.
+func (lrs *LOESSRegressionSeries) Len() int {
+	return lrs.getRegression().Len()
+}
+
+// GetValues returns a value of the inner series and its fitted value.
+func (lrs *LOESSRegressionSeries) GetValues(index int) (x, y float64) {
+	return lrs.getRegression().GetValues(index)
+}
+
+// GetBoundedValues returns a value of the inner series and the band at it.
+func (lrs *LOESSRegressionSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	return lrs.getRegression().GetBoundedValues(index)
+}
+
+// GetFirstValues returns the first value of the window and its fitted value.
+func (lrs *LOESSRegressionSeries) GetFirstValues() (x, y float64) {
+	return lrs.getRegression().GetFirstValues()
+}
+
+// GetLastValues returns the last value of the window and its fitted value.
+func (lrs *LOESSRegressionSeries) GetLastValues() (x, y float64) {
+	return lrs.getRegression().GetLastValues()
+}
+
+// GetCoefficients returns nil; a LOESS regression has no equation.
+func (lrs *LOESSRegressionSeries) GetCoefficients() []float64 {
+	return nil
+}
+
+// GetRSquared returns the coefficient of determination of the fitted values.
+func (lrs *LOESSRegressionSeries) GetRSquared() float64 {
+	return lrs.getRegression().GetRSquared()
+}
+
+// GetResiduals returns the differences between the values and the fitted values.
+func (lrs *LOESSRegressionSeries) GetResiduals() []float64 {
+	return lrs.getRegression().GetResiduals()
+}
+
+// GetEquation returns an empty string; a LOESS regression has no equation.
+func (lrs *LOESSRegressionSeries) GetEquation() string {
+	return ""
+}
+
+// Render renders the series.
+func (lrs *LOESSRegressionSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := lrs.Style.InheritFrom(defaults)
+	drawRegression(r, canvasBox, xrange, yrange, style, lrs.BandStyle, lrs.Band, lrs.getRegression())
+}
+
+// Validate validates the series.
+func (lrs *LOESSRegressionSeries) Validate() error {
+	if lrs.InnerSeries == nil {
+		return fmt.Errorf("loess regression series requires InnerSeries to be set")
+	}
+	return nil
+}
+
+func (lrs *LOESSRegressionSeries) getRegression() *regression {
+	if lrs.regression == nil {
+		xvalues, yvalues := regressionWindow(lrs.InnerSeries, lrs.Offset, lrs.Limit)
+		lrs.regression = newLOESSRegression(xvalues, yvalues, lrs.GetSpan(), lrs.Band, lrs.GetConfidence())
+	}
+	return lrs.regression
+}
+
+// newLOESSRegression fits a line to the span of the values nearest to each value, weighted by the tricube of
+// their distance, and estimates the band from the weights of the fitted values.
+func newLOESSRegression(xvalues, yvalues []float64, span float64, band RegressionBand, confidence float64) *regression {
+	count := len(xvalues)
+	reg := &regression{
+		XValues: xvalues,
+		YValues: yvalues,
+		Fitted:  make([]float64, count),
+		Lower:   make([]float64, count),
+		Upper:   make([]float64, count),
+	}
+	if count == 0 {
+		return reg
+	}
+	nearest := MinInt(MaxInt(int(math.Ceil(span*float64(count))), 3), count)
+
+	// each fitted value is a weighted sum of the values; the sum of the squared weights gives its variance
+	// and the weights of the values in their own fits give the degrees of freedom used up.
+	sumSquares := make([]float64, count)
+	var trace float64
+	for index, x := range xvalues {
+		weights := loessWeights(xvalues, x, nearest)
+		for other, weight := range weights {
+			reg.Fitted[index] += weight * yvalues[other]
+			sumSquares[index] += weight * weight
+		}
+		trace += weights[index]
+	}
+
+	var residuals float64
+	for index, y := range yvalues {
+		residuals += (y - reg.Fitted[index]) * (y - reg.Fitted[index])
+	}
+	var t, stdErr float64
+	if df := float64(count) - trace; band != RegressionBandNone && df > 0 {
+		t = studentTQuantile(1-(1-confidence)/2, df)
+		stdErr = math.Sqrt(residuals / df)
+	}
+	for index, fitted := range reg.Fitted {
+		variance := sumSquares[index]
+		if band == RegressionBandPrediction {
+			variance++
+		}
+		spread := t * stdErr * math.Sqrt(variance)
+		reg.Lower[index], reg.Upper[index] = fitted-spread, fitted+spread
+	}
+	return reg
+}
+
+// loessWeights returns the weights of the values in the value fitted at x by a line through the nearest values.
+func loessWeights(xvalues []float64, x float64, nearest int) []float64 {
+	distances := make([]float64, len(xvalues))
+	for index, value := range xvalues {
+		distances[index] = math.Abs(value - x)
+	}
+	sorted := append([]float64{}, distances...)
+	sort.Float64s(sorted)
+	maxDistance := sorted[nearest-1]
+	if maxDistance == 0 {
+		maxDistance = sorted[len(sorted)-1]
+	}
+
+	// the tricube weights of the nearest values; the furthest of them is stretched to keep a little weight.
+	weights := make([]float64, len(xvalues))
+	var total, mean float64
+	for index, distance := range distances {
+		if maxDistance == 0 {
+			weights[index] = 1
+		} else if u := distance / (maxDistance * (1 + 1e-9)); u < 1 {
+			weights[index] = math.Pow(1-u*u*u, 3)
+		}
+		total += weights[index]
+		mean += weights[index] * xvalues[index]
+	}
+	mean /= total
+	var sxx float64
+	for index, value := range xvalues {
+		sxx += weights[index] * (value - mean) * (value - mean)
+	}
+
+	// the weighted least squares line at x is a weighted sum of the values.
+	for index, value := range xvalues {
+		weight := weights[index] / total
+		if sxx > 0 {
+			weight += weights[index] * (value - mean) * (x - mean) / sxx
+		}
+		weights[index] = weight
+	}
+	return weights
+}
@@ -0,0 +1,55 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestLOESSRegressionSeries(t *testing.T) {
+	inner := testRegressionInnerSeries(func(x float64) float64 { return math.Sin(x / 2) })
+	graph := Chart{
+		Series: []Series{
+			inner,
+			&LOESSRegressionSeries{InnerSeries: inner, Band: RegressionBandConfidence},
+			&LOESSRegressionSeries{InnerSeries: inner, Span: 0.5, Band: RegressionBandPrediction},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, graph.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
+
+func TestLOESSRegressionSeriesLine(t *testing.T) {
+	// local lines fit a line exactly.
+	lrs := &LOESSRegressionSeries{
+		InnerSeries: testRegressionInnerSeries(func(x float64) float64 { return 3*x - 1 }),
+		Span:        0.4,
+		Band:        RegressionBandPrediction,
+	}
+	testutil.AssertEqual(t, 10, lrs.Len())
+	for index := 0; index < lrs.Len(); index++ {
+		x, y := lrs.GetValues(index)
+		testutil.AssertInDelta(t, 3*x-1, y, 0.0001)
+	}
+	testutil.AssertInDelta(t, 1.0, lrs.GetRSquared(), 0.0001)
+	testutil.AssertNil(t, lrs.GetCoefficients())
+	testutil.AssertEqual(t, "", lrs.GetEquation())
+}
+
+func TestLOESSRegressionSeriesBand(t *testing.T) {
+	lrs := &LOESSRegressionSeries{
+		InnerSeries: testRegressionInnerSeries(func(x float64) float64 { return x + math.Sin(3*x) }),
+		Band:        RegressionBandConfidence,
+	}
+	for index := 0; index < lrs.Len(); index++ {
+		_, y := lrs.GetValues(index)
+		_, y1, y2 := lrs.GetBoundedValues(index)
+		testutil.AssertTrue(t, y1 < y && y < y2)
+	}
+	testutil.AssertLen(t, lrs.GetResiduals(), 10)
+	testutil.AssertTrue(t, lrs.GetRSquared() < 1)
+}
@@ -0,0 +1,132 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series                = (*LogarithmicRegressionSeries)(nil)
+	_ ValuesProvider        = (*LogarithmicRegressionSeries)(nil)
+	_ BoundedValuesProvider = (*LogarithmicRegressionSeries)(nil)
+	_ FirstValuesProvider   = (*LogarithmicRegressionSeries)(nil)
+	_ LastValuesProvider    = (*LogarithmicRegressionSeries)(nil)
+	_ RegressionProvider    = (*LogarithmicRegressionSeries)(nil)
+)
+
+// logarithmicRegressionModel fits y = a + b*ln(x).
+var logarithmicRegressionModel = linearizedRegressionModel{
+	Name:       "logarithmic",
+	TransformX: math.Log,
+	TransformY: regressionIdentity,
+	InverseY:   regressionIdentity,
+	PositiveX:  true,
+}
+
+// LogarithmicRegressionSeries draws the logarithmic curve y = a + b*ln(x) fitted to the values of an inner series.
+//
+// The curve is fitted as a line through the logarithms of the x-values, so they must be positive.
+type LogarithmicRegressionSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Limit       int
+	Offset      int
+	InnerSeries ValuesProvider
+
+	// Band is the band drawn around the curve with the `Confidence` level, which defaults to 95%.
+	Band       RegressionBand
+	BandStyle  Style
+	Confidence float64
+
+	regression *regression
+}
+
+// GetName returns the name of the series.
+func (lrs LogarithmicRegressionSeries) GetName() string {
+	return lrs.Name
+}
+
+// GetStyle returns the line style.
+func (lrs LogarithmicRegressionSeries) GetStyle() Style {
+	return lrs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (lrs LogarithmicRegressionSeries) GetYAxis() YAxisType {
+	return lrs.YAxis
+}
+
+// GetConfidence returns the confidence level of the band or the default.
+func (lrs LogarithmicRegressionSeries) GetConfidence() float64 {
+	if lrs.Confidence <= 0 || lrs.Confidence >= 1 {
+		return DefaultRegressionConfidence
+	}
+	return lrs.Confidence
+}
+
+// Len returns the number of values in the window of the inner series.
+func (lrs *LogarithmicRegressionSeries) Len() int {
+	return lrs.getRegression().Len()
+}
+
+// GetValues returns a value of the inner series and its fitted value.
+func (lrs *LogarithmicRegressionSeries) GetValues(index int) (x, y float64) {
+	return lrs.getRegression().GetValues(index)
+}
+
+// GetBoundedValues returns a value of the inner series and the band at it.
+func (lrs *LogarithmicRegressionSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	return lrs.getRegression().GetBoundedValues(index)
+}
+
+// GetFirstValues returns the first value of the window and its fitted value.
+func (lrs *LogarithmicRegressionSeries) GetFirstValues() (x, y float64) {
+	return lrs.getRegression().GetFirstValues()
+}
+
+// GetLastValues returns the last value of the window and its fitted value.
+func (lrs *LogarithmicRegressionSeries) GetLastValues() (x, y float64) {
+	return lrs.getRegression().GetLastValues()
+}
+
+// GetCoefficients returns the a and b coefficients of y = a + b*ln(x).
+func (lrs *LogarithmicRegressionSeries) GetCoefficients() []float64 {
+	return lrs.getRegression().Coefficients
+}
+
+// GetRSquared returns the coefficient of determination of the fitted values.
+func (lrs *LogarithmicRegressionSeries) GetRSquared() float64 {
+	return lrs.getRegression().GetRSquared()
+}
+
+// GetResiduals returns the differences between the values and the fitted values.
+func (lrs *LogarithmicRegressionSeries) GetResiduals() []float64 {
+	return lrs.getRegression().GetResiduals()
+}
+
+// GetEquation returns the fitted equation.
+func (lrs *LogarithmicRegressionSeries) GetEquation() string {
+	coefficients := lrs.GetCoefficients()
+	return fmt.Sprintf("y = %s%s", formatRegressionCoefficient(coefficients[0]), formatRegressionTerm(coefficients[1], " ln(x)"))
+}
+
+// Render renders the series.
+func (lrs *LogarithmicRegressionSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := lrs.Style.InheritFrom(defaults)
+	drawRegression(r, canvasBox, xrange, yrange, style, lrs.BandStyle, lrs.Band, lrs.getRegression())
+}
+
+// Validate validates the series.
+func (lrs *LogarithmicRegressionSeries) Validate() error {
+	return validateLinearizedRegression(logarithmicRegressionModel, lrs.InnerSeries, lrs.Offset, lrs.Limit)
+}
+
+func (lrs *LogarithmicRegressionSeries) getRegression() *regression {
+	if lrs.regression == nil {
+		lrs.regression = fitLinearizedRegression(logarithmicRegressionModel, lrs.InnerSeries, lrs.Offset, lrs.Limit, lrs.Band, lrs.GetConfidence())
+	}
+	return lrs.regression
+}
@@ -221,6 +221,34 @@ func GetRoundToForDelta(delta float64) float64 {
 	return 0.0
 }
 
+// niceStep returns the smallest step of 1, 2, 2.5 or 5 times a power of ten that is at least the given step.
+func niceStep(step float64) float64 {
+	if step <= 0 {
+		return step
+	}
+	magnitude := math.Pow(10, math.Floor(math.Log10(step)))
+	for _, multiple := range []float64{1, 2, 2.5, 5} {
+		if multiple*magnitude >= step {
+			return multiple * magnitude
+		}
+	}
+	return 10 * magnitude
+}
+
+// interpolatedPercentile returns a percentile of sorted values, on the interval [0, 1.0], interpolating between
+// the values either side of it; unlike `Seq.Percentile` it is defined for any number of values.
+func interpolatedPercentile(sorted []float64, percent float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := math.Max(0, math.Min(percent, 1)) * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	if lower == len(sorted)-1 {
+		return sorted[lower]
+	}
+	return sorted[lower] + (rank-float64(lower))*(sorted[lower+1]-sorted[lower])
+}
+
 // RoundPlaces rounds an input to a given places.
 func RoundPlaces(input float64, places int) (rounded float64) {
 	if math.IsNaN(input) {
@@ -0,0 +1,106 @@
+package chart
+
+import "fmt"
+
+// Interface Assertions.
+var (
+	_ Series              = (*OBVSeries)(nil)
+	_ FirstValuesProvider = (*OBVSeries)(nil)
+	_ LastValuesProvider  = (*OBVSeries)(nil)
+)
+
+// OBVSeries computes the on-balance volume of a price series, the running total of the volumes of the periods
+// the price closed up in less those it closed down in, which shows whether volume is flowing in or out.
+//
+// The closes are the values of the inner series and the volumes the values of the volume series. The totals are
+// usually far larger than the prices, so the series is usually drawn on the secondary axis.
+type OBVSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	InnerSeries  ValuesProvider
+	VolumeSeries ValuesProvider
+
+	cache []float64
+}
+
+// GetName returns the name of the time series.
+func (obv OBVSeries) GetName() string {
+	return obv.Name
+}
+
+// GetStyle returns the line style.
+func (obv OBVSeries) GetStyle() Style {
+	return obv.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (obv OBVSeries) GetYAxis() YAxisType {
+	return obv.YAxis
+}
+
+// Len returns the number of elements in the series.
+func (obv OBVSeries) Len() int {
+	return obv.InnerSeries.Len()
+}
+
+// GetValues gets a value at a given index.
+func (obv *OBVSeries) GetValues(index int) (x, y float64) {
+	if obv.InnerSeries == nil || obv.VolumeSeries == nil {
+		return
+	}
+	if len(obv.cache) == 0 {
+		obv.ensureCachedValues()
+	}
+	x, _ = obv.InnerSeries.GetValues(index)
+	y = obv.cache[index]
+	return
+}
+
+// GetFirstValues returns the first value of the series.
+func (obv *OBVSeries) GetFirstValues() (x, y float64) {
+	if obv.InnerSeries == nil || obv.InnerSeries.Len() == 0 {
+		return
+	}
+	return obv.GetValues(0)
+}
+
+// GetLastValues returns the last value of the series.
+func (obv *OBVSeries) GetLastValues() (x, y float64) {
+	if obv.InnerSeries == nil || obv.InnerSeries.Len() == 0 {
+		return
+	}
+	return obv.GetValues(obv.InnerSeries.Len() - 1)
+}
+
+func (obv *OBVSeries) ensureCachedValues() {
+	seriesLength := obv.InnerSeries.Len()
+	obv.cache = make([]float64, seriesLength)
+	for index := 1; index < seriesLength; index++ {
+		_, previous := obv.InnerSeries.GetValues(index - 1)
+		_, close := obv.InnerSeries.GetValues(index)
+		_, volume := obv.VolumeSeries.GetValues(index)
+
+		obv.cache[index] = obv.cache[index-1]
+		if close > previous {
+			obv.cache[index] += volume
+		} else if close < previous {
+			obv.cache[index] -= volume
+		}
+	}
+}
+
+// Render renders the series.
+func (obv *OBVSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := obv.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, obv)
+}
+
+// Validate validates the series.
+func (obv *OBVSeries) Validate() error {
+	if obv.VolumeSeries == nil {
+		return fmt.Errorf("obv series requires VolumeSeries to be set")
+	}
+	return validateIndicatorSeries("obv", obv.InnerSeries, obv.VolumeSeries)
+}
@@ -0,0 +1,21 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestOBVSeries(t *testing.T) {
+	obv := &OBVSeries{
+		InnerSeries:  mockValuesProvider{X: []float64{1, 2, 3, 4}, Y: []float64{10, 11, 10, 10}},
+		VolumeSeries: mockValuesProvider{X: []float64{1, 2, 3, 4}, Y: []float64{5, 6, 7, 8}},
+	}
+
+	expected := []float64{0, 6, -1, -1}
+	for index, value := range expected {
+		_, y := obv.GetValues(index)
+		testutil.AssertEqual(t, value, y)
+	}
+	testutil.AssertNotNil(t, (&OBVSeries{InnerSeries: obv.InnerSeries}).Validate())
+}
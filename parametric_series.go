@@ -0,0 +1,109 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series              = (*ParametricSeries)(nil)
+	_ ValuesProvider      = (*ParametricSeries)(nil)
+	_ FirstValuesProvider = (*ParametricSeries)(nil)
+	_ LastValuesProvider  = (*ParametricSeries)(nil)
+)
+
+// ParametricSeries plots a curve given by the x and y-values of a function of a parameter t, between `Start`
+// and `End`, such as a circle or a curve that doubles back on itself.
+//
+// The function is sampled as a `FunctionSeries` is; more finely where the curve bends, breaking the line where
+// it jumps, where it is NaN or infinite, and where it leaves the ranges of the chart.
+type ParametricSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Function func(t float64) (x, y float64)
+
+	Start float64
+	End   float64
+}
+
+// GetName returns the name of the series.
+func (ps ParametricSeries) GetName() string {
+	return ps.Name
+}
+
+// GetStyle returns the line style.
+func (ps ParametricSeries) GetStyle() Style {
+	return ps.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ps ParametricSeries) GetYAxis() YAxisType {
+	return ps.YAxis
+}
+
+// Len returns the number of values at evenly spaced parameters used for the ranges of the chart.
+func (ps ParametricSeries) Len() int {
+	if ps.Function == nil || ps.Start >= ps.End {
+		return 0
+	}
+	return DefaultFunctionSamples
+}
+
+// GetValues returns the value at one of the evenly spaced parameters; the y-value is NaN if the function isn't
+// finite there.
+func (ps ParametricSeries) GetValues(index int) (x, y float64) {
+	x, y = ps.Function(ps.Start + (ps.End-ps.Start)*float64(index)/float64(DefaultFunctionSamples-1))
+	if math.IsNaN(x) || math.IsInf(x, 0) || math.IsInf(y, 0) {
+		y = math.NaN()
+	}
+	return
+}
+
+// GetFirstValues returns the value at the start.
+func (ps ParametricSeries) GetFirstValues() (x, y float64) {
+	if ps.Len() == 0 {
+		return
+	}
+	return ps.GetValues(0)
+}
+
+// GetLastValues returns the value at the end.
+func (ps ParametricSeries) GetLastValues() (x, y float64) {
+	if ps.Len() == 0 {
+		return
+	}
+	return ps.GetValues(DefaultFunctionSamples - 1)
+}
+
+// Render renders the series.
+func (ps ParametricSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if ps.Len() == 0 {
+		return
+	}
+	plotter := &functionPlotter{
+		CanvasBox: canvasBox,
+		XRange:    xrange,
+		YRange:    yrange,
+		Function:  ps.Function,
+	}
+	xvalues, yvalues := plotter.Plot(ps.Start, ps.End)
+	if len(xvalues) == 0 {
+		return
+	}
+	style := ps.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, ContinuousSeries{XValues: xvalues, YValues: yvalues})
+}
+
+// Validate validates the series.
+func (ps ParametricSeries) Validate() error {
+	if ps.Function == nil {
+		return fmt.Errorf("parametric series requires Function to be set")
+	}
+	if ps.Start >= ps.End {
+		return fmt.Errorf("parametric series requires Start to be before End")
+	}
+	return nil
+}
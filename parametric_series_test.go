@@ -0,0 +1,60 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func testCircle(t float64) (x, y float64) {
+	return math.Cos(t), math.Sin(t)
+}
+
+func TestParametricSeries(t *testing.T) {
+	ps := ParametricSeries{
+		Function: testCircle,
+		Start:    0,
+		End:      _2pi,
+	}
+	testutil.AssertNil(t, ps.Validate())
+	testutil.AssertEqual(t, DefaultFunctionSamples, ps.Len())
+
+	x, y := ps.GetFirstValues()
+	testutil.AssertEqual(t, 1.0, x)
+	testutil.AssertEqual(t, 0.0, y)
+
+	x, y = ps.GetLastValues()
+	testutil.AssertInDelta(t, 1.0, x, 0.0000001)
+	testutil.AssertInDelta(t, 0.0, y, 0.0000001)
+
+	// the samples follow the circle.
+	for index := 0; index < ps.Len(); index++ {
+		x, y = ps.GetValues(index)
+		testutil.AssertInDelta(t, 1.0, math.Hypot(x, y), 0.0000001)
+	}
+}
+
+func TestParametricSeriesValidate(t *testing.T) {
+	testutil.AssertNotNil(t, ParametricSeries{}.Validate())
+	testutil.AssertNotNil(t, ParametricSeries{Function: testCircle}.Validate())
+	testutil.AssertNotNil(t, ParametricSeries{Function: testCircle, Start: _2pi}.Validate())
+	testutil.AssertNil(t, ParametricSeries{Function: testCircle, End: _2pi}.Validate())
+}
+
+func TestParametricSeriesRender(t *testing.T) {
+	graph := Chart{
+		Series: []Series{
+			ParametricSeries{Function: testCircle, End: _2pi},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, graph.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+
+	b.Reset()
+	testutil.AssertNil(t, graph.Render(SVG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
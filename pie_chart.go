@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 
 	"github.com/golang/freetype/truetype"
 )
@@ -23,6 +24,28 @@ type PieChart struct {
 	Canvas     Style
 	SliceStyle Style
 
+	// StartAngle is the angle in degrees, clockwise from three o'clock, that the first slice starts at.
+	StartAngle float64
+	// CounterClockwise lays the slices out counter-clockwise from the start angle.
+	CounterClockwise bool
+
+	// LabelPosition determines where the slice labels are drawn.
+	LabelPosition PieLabelPosition
+	// ValueFormatter, if set, formats a value shown with each slice label; it is given the slice's
+	// share of the total on the interval [0, 1], or the original value if `FormatValues` is set.
+	ValueFormatter ValueFormatter
+	FormatValues   bool
+
+	// OtherThreshold merges the slices whose share of the total is below the threshold
+	// into a single slice labeled `OtherLabel`.
+	OtherThreshold float64
+	OtherLabel     string
+
+	// Exploded lists the indexes of the values whose slices are moved out from the center
+	// by `ExplodeOffset`, a fraction of the radius.
+	Exploded      []int
+	ExplodeOffset float64
+
 	Font        *truetype.Font
 	defaultFont *truetype.Font
 
@@ -86,16 +109,19 @@ func (pc PieChart) Render(rp RendererProvider, w io.Writer) error {
 	r.SetDPI(pc.GetDPI(DefaultDPI))
 
 	canvasBox := pc.getDefaultCanvasBox()
-	canvasBox = pc.getCircleAdjustedCanvasBox(canvasBox)
+	if pc.LabelPosition != PieLabelPositionOutside {
+		canvasBox = pc.getCircleAdjustedCanvasBox(canvasBox)
+	}
 
 	pc.drawBackground(r)
 	pc.drawCanvas(r, canvasBox)
 
-	finalValues, err := pc.finalizeValues(pc.Values)
+	layout := pc.getSliceLayout()
+	slices, err := pc.finalizeSlices(layout)
 	if err != nil {
 		return err
 	}
-	pc.drawSlices(r, canvasBox, finalValues)
+	pc.drawSlices(r, canvasBox, layout, slices)
 	pc.drawTitle(r)
 	for _, a := range pc.Elements {
 		a(r, canvasBox, pc.styleDefaultsElements())
@@ -121,69 +147,86 @@ func (pc PieChart) drawTitle(r Renderer) {
 	}
 }
 
-func (pc PieChart) drawSlices(r Renderer, canvasBox Box, values []Value) {
+func (pc PieChart) drawSlices(r Renderer, canvasBox Box, layout pieSliceLayout, slices []pieSlice) {
 	cx, cy := canvasBox.Center()
-	diameter := MinInt(canvasBox.Width(), canvasBox.Height())
-	radius := float64(diameter >> 1)
+	var radius float64
+	if layout.LabelPosition == PieLabelPositionOutside {
+		cx, radius = layout.GetOutsideLabelCircle(r, canvasBox, slices, pc.stylePieChartValue)
+	} else {
+		diameter := MinInt(canvasBox.Width(), canvasBox.Height())
+		radius = float64(diameter>>1) / layout.OuterRadius(slices, 1.0)
+	}
 	labelRadius := (radius * 2.0) / 3.0
 
 	// draw the pie slices
-	var rads, delta, delta2, total float64
-	var lx, ly int
-
-	if len(values) == 1 {
+	var dx, dy int
+	if len(slices) == 1 {
 		pc.stylePieChartValue(0).WriteToRenderer(r)
 		r.MoveTo(cx, cy)
 		r.Circle(radius, cx, cy)
 	} else {
-		for index, v := range values {
-			v.Style.InheritFrom(pc.stylePieChartValue(index)).WriteToRenderer(r)
+		for index, ps := range slices {
+			ps.Value.Style.InheritFrom(pc.stylePieChartValue(index)).WriteToRenderer(r)
 
-			r.MoveTo(cx, cy)
-			rads = PercentToRadians(total)
-			delta = PercentToRadians(v.Value)
+			dx, dy = layout.Offset(ps, radius)
+			r.MoveTo(cx+dx, cy+dy)
+			r.ArcTo(cx+dx, cy+dy, radius, radius, ps.Start, ps.Delta)
 
-			r.ArcTo(cx, cy, radius, radius, rads, delta)
-
-			r.LineTo(cx, cy)
+			r.LineTo(cx+dx, cy+dy)
 			r.Close()
 			r.FillStroke()
-			total = total + v.Value
 		}
 	}
 
 	// draw the labels
-	total = 0
-	for index, v := range values {
-		v.Style.InheritFrom(pc.stylePieChartValue(index)).WriteToRenderer(r)
-		if len(v.Label) > 0 {
-			delta2 = PercentToRadians(total + (v.Value / 2.0))
-			delta2 = RadianAdd(delta2, _pi2)
-			lx, ly = CirclePoint(cx, cy, labelRadius, delta2)
-
-			tb := r.MeasureText(v.Label)
+	if layout.LabelPosition == PieLabelPositionOutside {
+		for _, label := range layout.OutsideLabels(r, canvasBox, cx, cy, radius, slices, pc.stylePieChartValue) {
+			style := slices[label.Slice].Value.Style.InheritFrom(pc.stylePieChartValue(label.Slice))
+			drawPieLabel(r, label, Style{
+				StrokeColor: style.GetFillColor(),
+				StrokeWidth: DefaultAxisLineWidth,
+			}, style)
+		}
+		return
+	}
+
+	var lx, ly int
+	for index, ps := range slices {
+		ps.Value.Style.InheritFrom(pc.stylePieChartValue(index)).WriteToRenderer(r)
+		if label := layout.Label(ps); len(label) > 0 {
+			dx, dy = layout.Offset(ps, radius)
+			lx = cx + dx + int(labelRadius*math.Cos(ps.Mid()))
+			ly = cy + dy + int(labelRadius*math.Sin(ps.Mid()))
+
+			tb := r.MeasureText(label)
 			lx = lx - (tb.Width() >> 1)
 			ly = ly + (tb.Height() >> 1)
 
-			if lx < 0 {
-				lx = 0
-			}
-			if ly < 0 {
-				lx = 0
-			}
-
-			r.Text(v.Label, lx, ly)
+			r.Text(label, lx, ly)
 		}
-		total = total + v.Value
 	}
 }
 
-func (pc PieChart) finalizeValues(values []Value) ([]Value, error) {
-	finalValues := Values(values).Normalize()
-	if len(finalValues) == 0 {
+func (pc PieChart) getSliceLayout() pieSliceLayout {
+	return pieSliceLayout{
+		StartAngle:       pc.StartAngle,
+		CounterClockwise: pc.CounterClockwise,
+		OtherThreshold:   pc.OtherThreshold,
+		OtherLabel:       pc.OtherLabel,
+		Exploded:         pc.Exploded,
+		ExplodeOffset:    pc.ExplodeOffset,
+		LabelPosition:    pc.LabelPosition,
+		ValueFormatter:   pc.ValueFormatter,
+		FormatValues:     pc.FormatValues,
+	}
+}
+
+func (pc PieChart) finalizeSlices(layout pieSliceLayout) ([]pieSlice, error) {
+	slices := layout.Slices(pc.Values)
+	if len(slices) == 0 {
 		return nil, fmt.Errorf("pie chart must contain at least (1) non-zero value")
 	}
-	return finalValues, nil
+	return slices, nil
 }
 
 func (pc PieChart) getDefaultCanvasBox() Box {
@@ -30,6 +30,25 @@ func TestPieChart(t *testing.T) {
 	testutil.AssertNotZero(t, b.Len())
 }
 
+func TestPieChartSVGArcs(t *testing.T) {
+	pie := PieChart{
+		Width:  200,
+		Height: 200,
+		Values: []Value{
+			{Value: 1, Label: "a"},
+			{Value: 3, Label: "b"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, pie.Render(SVG, b))
+	svg := b.String()
+
+	// the slices sweep clockwise.
+	testutil.AssertContains(t, svg, "A 95 95 90.00 0 1 100 195")
+	testutil.AssertContains(t, svg, "A 95 95 270.00 1 1 195 100")
+}
+
 func TestPieChartDropsZeroValues(t *testing.T) {
 	// replaced new assertions helper
 
@@ -67,3 +86,26 @@ func TestPieChartAllZeroValues(t *testing.T) {
 	err := pie.Render(PNG, b)
 	testutil.AssertNotNil(t, err)
 }
+
+func TestPieChartOutsideLabels(t *testing.T) {
+	chart := PieChart{
+		Width:          640,
+		Height:         480,
+		StartAngle:     -90,
+		LabelPosition:  PieLabelPositionOutside,
+		ValueFormatter: PercentValueFormatter,
+		OtherThreshold: 0.05,
+		Exploded:       []int{0},
+		Values: []Value{
+			{Value: 50, Label: "Blue"},
+			{Value: 30, Label: "Green"},
+			{Value: 2, Label: "Gray"},
+			{Value: 1, Label: "Orange"},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	err := chart.Render(PNG, b)
+	testutil.AssertNil(t, err)
+	testutil.AssertNotZero(t, b.Len())
+}
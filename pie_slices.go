@@ -0,0 +1,328 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// PieLabelPosition is an enum for where the slice labels of a pie or donut chart are drawn.
+type PieLabelPosition int
+
+const (
+	// PieLabelPositionUnset is the unset state for the label position; it behaves like `PieLabelPositionInside`.
+	PieLabelPositionUnset PieLabelPosition = 0
+	// PieLabelPositionInside draws labels on top of their slices.
+	PieLabelPositionInside PieLabelPosition = 1
+	// PieLabelPositionOutside draws labels in columns to the left and right of the chart,
+	// with leader lines back to their slices.
+	PieLabelPositionOutside PieLabelPosition = 2
+)
+
+// pieSliceLayout holds the options that determine how values are laid out as slices of a circle.
+type pieSliceLayout struct {
+	StartAngle       float64
+	CounterClockwise bool
+
+	OtherThreshold float64
+	OtherLabel     string
+
+	Exploded      []int
+	ExplodeOffset float64
+
+	LabelPosition  PieLabelPosition
+	ValueFormatter ValueFormatter
+	FormatValues   bool
+}
+
+// pieSlice is a value laid out as a slice of a circle.
+type pieSlice struct {
+	// Value is the slice's share of the total.
+	Value Value
+	// Raw is the original value of the slice.
+	Raw float64
+	// Source is the index of the value the slice was made from, or -1 if it merges several values.
+	Source int
+
+	// Start and Delta are the angles in radians of the slice, clockwise from three o'clock; Delta is always positive.
+	Start, Delta float64
+
+	Exploded bool
+}
+
+// Mid returns the angle in radians halfway through the slice.
+func (ps pieSlice) Mid() float64 {
+	return RadianAdd(ps.Start, ps.Delta/2.0)
+}
+
+// pieLabel is a slice label positioned outside of the circle.
+type pieLabel struct {
+	Text  string
+	Slice int
+
+	AnchorX, AnchorY int
+	ElbowX, ElbowY   int
+
+	// X is the column edge closest to the circle, Y is the vertical center of the label.
+	X, Y  int
+	Right bool
+
+	Width, Height int
+}
+
+// Slices merges small values into the "other" bucket and lays the values out as slices.
+func (psl pieSliceLayout) Slices(values []Value) []pieSlice {
+	merged, mergedSources := psl.mergeOther(values)
+
+	var raw []Value
+	var sources []int
+	for index, v := range merged {
+		if v.Value > 0 {
+			raw = append(raw, v)
+			sources = append(sources, mergedSources[index])
+		}
+	}
+	if len(raw) == 0 {
+		return nil
+	}
+
+	normalized := Values(raw).Normalize()
+	slices := make([]pieSlice, len(normalized))
+
+	start := DegreesToRadians(psl.StartAngle)
+	var total float64
+	for index, v := range normalized {
+		delta := PercentToRadians(v.Value)
+		slices[index] = pieSlice{
+			Value:  v,
+			Raw:    raw[index].Value,
+			Source: sources[index],
+			Delta:  delta,
+		}
+		if psl.CounterClockwise {
+			slices[index].Start = RadianAdd(start, -(total + delta))
+		} else {
+			slices[index].Start = RadianAdd(start, total)
+		}
+		for _, exploded := range psl.Exploded {
+			if exploded == slices[index].Source {
+				slices[index].Exploded = true
+			}
+		}
+		total += delta
+	}
+	return slices
+}
+
+// mergeOther replaces the values whose share of the total is below the threshold with a single value
+// at the end; if fewer than two values fall below the threshold the values are returned as is.
+// It also returns the index of the value each output value came from, or -1 for the merged value.
+func (psl pieSliceLayout) mergeOther(values []Value) ([]Value, []int) {
+	sources := make([]int, len(values))
+	for index := range values {
+		sources[index] = index
+	}
+	if psl.OtherThreshold <= 0 {
+		return values, sources
+	}
+
+	var total float64
+	for _, v := range values {
+		if v.Value > 0 {
+			total += v.Value
+		}
+	}
+
+	var output []Value
+	var outputSources []int
+	var other float64
+	var merged int
+	for index, v := range values {
+		if v.Value > 0 && v.Value/total < psl.OtherThreshold {
+			other += v.Value
+			merged++
+			continue
+		}
+		output = append(output, v)
+		outputSources = append(outputSources, index)
+	}
+	if merged < 2 {
+		return values, sources
+	}
+	output = append(output, Value{
+		Label: psl.GetOtherLabel(),
+		Value: other,
+	})
+	return output, append(outputSources, -1)
+}
+
+// GetOtherLabel returns the label for the "other" bucket or the default.
+func (psl pieSliceLayout) GetOtherLabel() string {
+	if psl.OtherLabel == "" {
+		return DefaultPieOtherLabel
+	}
+	return psl.OtherLabel
+}
+
+// GetExplodeOffset returns the explode offset as a fraction of the radius or the default.
+func (psl pieSliceLayout) GetExplodeOffset() float64 {
+	if psl.ExplodeOffset <= 0 {
+		return DefaultPieExplodeOffset
+	}
+	return psl.ExplodeOffset
+}
+
+// Label returns the label text for a slice, including the formatted value if there is a value formatter.
+func (psl pieSliceLayout) Label(ps pieSlice) string {
+	if psl.ValueFormatter == nil {
+		return ps.Value.Label
+	}
+
+	var formatted string
+	if psl.FormatValues {
+		formatted = psl.ValueFormatter(ps.Raw)
+	} else {
+		formatted = psl.ValueFormatter(ps.Value.Value)
+	}
+	if ps.Value.Label == "" {
+		return formatted
+	}
+	return fmt.Sprintf("%s (%s)", ps.Value.Label, formatted)
+}
+
+// Offset returns how far a slice is moved away from the center.
+func (psl pieSliceLayout) Offset(ps pieSlice, radius float64) (dx, dy int) {
+	if !ps.Exploded {
+		return
+	}
+	offset := radius * psl.GetExplodeOffset()
+	mid := ps.Mid()
+	dx = int(math.Round(offset * math.Cos(mid)))
+	dy = int(math.Round(offset * math.Sin(mid)))
+	return
+}
+
+// OuterRadius returns the radius including any exploded slices.
+func (psl pieSliceLayout) OuterRadius(slices []pieSlice, radius float64) float64 {
+	for _, ps := range slices {
+		if ps.Exploded {
+			return radius * (1.0 + psl.GetExplodeOffset())
+		}
+	}
+	return radius
+}
+
+// GetOutsideLabelCircle returns the horizontal center and the largest radius for which the circle
+// and the outside labels fit within the canvas; the circle is moved towards the narrower label column.
+func (psl pieSliceLayout) GetOutsideLabelCircle(r Renderer, canvasBox Box, slices []pieSlice, style func(int) Style) (cx int, radius float64) {
+	gutter := 2*DefaultPieLabelLeaderLength + DefaultPieLabelSpacing
+
+	var left, right int
+	for index, ps := range slices {
+		text := psl.Label(ps)
+		if text == "" {
+			continue
+		}
+		width := Draw.MeasureText(r, text, style(index)).Width() + gutter
+		if math.Cos(ps.Mid()) >= 0 {
+			right = MaxInt(right, width)
+		} else {
+			left = MaxInt(left, width)
+		}
+	}
+
+	available := MaxInt(canvasBox.Width()-left-right, 0)
+	cx = canvasBox.Left + left + available>>1
+	diameter := MinInt(available, canvasBox.Height())
+	radius = float64(diameter>>1) / psl.OuterRadius(slices, 1.0)
+	return
+}
+
+// OutsideLabels positions the labels of the slices in columns to the left and right of the circle,
+// moving labels within a column apart so they don't overlap.
+func (psl pieSliceLayout) OutsideLabels(r Renderer, canvasBox Box, cx, cy int, radius float64, slices []pieSlice, style func(int) Style) []pieLabel {
+	columnOffset := int(psl.OuterRadius(slices, radius)) + 2*DefaultPieLabelLeaderLength
+
+	var left, right []pieLabel
+	for index, ps := range slices {
+		text := psl.Label(ps)
+		if text == "" {
+			continue
+		}
+		tb := Draw.MeasureText(r, text, style(index))
+
+		dx, dy := psl.Offset(ps, radius)
+		mid := ps.Mid()
+		cos, sin := math.Cos(mid), math.Sin(mid)
+		label := pieLabel{
+			Text:    text,
+			Slice:   index,
+			AnchorX: cx + dx + int(math.Round(radius*cos)),
+			AnchorY: cy + dy + int(math.Round(radius*sin)),
+			ElbowX:  cx + dx + int(math.Round((radius+DefaultPieLabelLeaderLength)*cos)),
+			ElbowY:  cy + dy + int(math.Round((radius+DefaultPieLabelLeaderLength)*sin)),
+			Right:   cos >= 0,
+			Width:   tb.Width(),
+			Height:  tb.Height(),
+		}
+		label.Y = label.ElbowY
+		if label.Right {
+			label.X = cx + columnOffset
+			right = append(right, label)
+		} else {
+			label.X = cx - columnOffset
+			left = append(left, label)
+		}
+	}
+
+	spacing := DefaultPieLabelSpacing
+	return append(spreadPieLabels(left, canvasBox, spacing), spreadPieLabels(right, canvasBox, spacing)...)
+}
+
+// spreadPieLabels moves the labels of a column vertically so they don't overlap, keeping them within the canvas.
+func spreadPieLabels(labels []pieLabel, canvasBox Box, spacing int) []pieLabel {
+	sort.SliceStable(labels, func(i, j int) bool {
+		return labels[i].Y < labels[j].Y
+	})
+
+	for index := range labels {
+		top := labels[index].Y - labels[index].Height>>1
+		if index > 0 {
+			previous := labels[index-1]
+			top = MaxInt(top, previous.Y+previous.Height-previous.Height>>1+spacing)
+		}
+		top = MaxInt(top, canvasBox.Top)
+		labels[index].Y = top + labels[index].Height>>1
+	}
+	for index := len(labels) - 1; index >= 0; index-- {
+		bottom := labels[index].Y + labels[index].Height - labels[index].Height>>1
+		limit := canvasBox.Bottom
+		if index < len(labels)-1 {
+			next := labels[index+1]
+			limit = MinInt(limit, next.Y-next.Height>>1-spacing)
+		}
+		if bottom > limit {
+			labels[index].Y -= bottom - limit
+		}
+	}
+	return labels
+}
+
+// drawPieLabel draws an outside label and its leader line.
+func drawPieLabel(r Renderer, label pieLabel, lineStyle, textStyle Style) {
+	lineX := label.X - DefaultPieLabelSpacing
+	textX := label.X
+	if !label.Right {
+		lineX = label.X + DefaultPieLabelSpacing
+		textX = label.X - label.Width
+	}
+
+	lineStyle.WriteToRenderer(r)
+	r.MoveTo(label.AnchorX, label.AnchorY)
+	r.LineTo(label.ElbowX, label.ElbowY)
+	r.LineTo(lineX, label.Y)
+	r.Stroke()
+	r.ResetStyle()
+
+	Draw.Text(r, label.Text, textX, label.Y+label.Height>>1, textStyle)
+}
@@ -0,0 +1,121 @@
+package chart
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestPieSliceLayoutSlices(t *testing.T) {
+	values := []Value{
+		{Value: 2, Label: "A"},
+		{Value: 0, Label: "B"},
+		{Value: 2, Label: "C"},
+	}
+
+	slices := pieSliceLayout{}.Slices(values)
+	testutil.AssertLen(t, slices, 2)
+	testutil.AssertEqual(t, 0, slices[0].Source)
+	testutil.AssertEqual(t, 2, slices[1].Source)
+	testutil.AssertInDelta(t, 0, slices[0].Start, 0.0001)
+	testutil.AssertInDelta(t, math.Pi, slices[0].Delta, 0.0001)
+	testutil.AssertInDelta(t, math.Pi, slices[1].Start, 0.0001)
+
+	slices = pieSliceLayout{StartAngle: -90}.Slices(values)
+	testutil.AssertInDelta(t, 3*math.Pi/2, slices[0].Start, 0.0001)
+	testutil.AssertInDelta(t, math.Pi/2, slices[1].Start, 0.0001)
+
+	slices = pieSliceLayout{StartAngle: -90, CounterClockwise: true}.Slices([]Value{
+		{Value: 1, Label: "A"},
+		{Value: 3, Label: "B"},
+	})
+	testutil.AssertInDelta(t, math.Pi, slices[0].Start, 0.0001)
+	testutil.AssertInDelta(t, math.Pi/2, slices[0].Delta, 0.0001)
+	testutil.AssertInDelta(t, math.Pi, RadianAdd(slices[1].Start, slices[1].Delta), 0.0001)
+}
+
+func TestPieSliceLayoutSlicesOther(t *testing.T) {
+	values := []Value{
+		{Value: 90, Label: "A"},
+		{Value: 5, Label: "B"},
+		{Value: 3, Label: "C"},
+		{Value: 2, Label: "D"},
+	}
+
+	slices := pieSliceLayout{OtherThreshold: 0.04}.Slices(values)
+	testutil.AssertLen(t, slices, 3)
+	testutil.AssertEqual(t, DefaultPieOtherLabel, slices[2].Value.Label)
+	testutil.AssertEqual(t, -1, slices[2].Source)
+	testutil.AssertEqual(t, 5.0, slices[2].Raw)
+
+	// a single small value is not merged.
+	slices = pieSliceLayout{OtherThreshold: 0.025, OtherLabel: "Rest"}.Slices(values)
+	testutil.AssertLen(t, slices, 4)
+	testutil.AssertEqual(t, "D", slices[3].Value.Label)
+}
+
+func TestPieSliceLayoutLabel(t *testing.T) {
+	slice := pieSlice{Value: Value{Label: "A", Value: 0.25}, Raw: 10}
+
+	testutil.AssertEqual(t, "A", pieSliceLayout{}.Label(slice))
+	testutil.AssertEqual(t, "A (25.00%)", pieSliceLayout{ValueFormatter: PercentValueFormatter}.Label(slice))
+	testutil.AssertEqual(t, "A (10.00)", pieSliceLayout{ValueFormatter: FloatValueFormatter, FormatValues: true}.Label(slice))
+}
+
+func TestPieSliceLayoutExploded(t *testing.T) {
+	layout := pieSliceLayout{Exploded: []int{1}}
+	slices := layout.Slices([]Value{
+		{Value: 1, Label: "A"},
+		{Value: 1, Label: "B"},
+	})
+	testutil.AssertFalse(t, slices[0].Exploded)
+	testutil.AssertTrue(t, slices[1].Exploded)
+
+	dx, dy := layout.Offset(slices[0], 100)
+	testutil.AssertZero(t, dx)
+	testutil.AssertZero(t, dy)
+
+	// the second slice is centered on twelve o'clock.
+	dx, dy = layout.Offset(slices[1], 100)
+	testutil.AssertZero(t, dx)
+	testutil.AssertEqual(t, -10, dy)
+
+	testutil.AssertInDelta(t, 110, layout.OuterRadius(slices, 100), 0.0001)
+}
+
+func TestPieSliceLayoutOutsideLabels(t *testing.T) {
+	r, err := PNG(512, 512)
+	testutil.AssertNil(t, err)
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	var values []Value
+	for index := 0; index < 10; index++ {
+		values = append(values, Value{Value: 1, Label: "Small"})
+	}
+	values = append(values, Value{Value: 100, Label: "Large"})
+
+	layout := pieSliceLayout{LabelPosition: PieLabelPositionOutside}
+	slices := layout.Slices(values)
+	canvasBox := NewBox(0, 0, 512, 512)
+	style := func(int) Style { return Style{Font: f, FontSize: 10} }
+
+	labels := layout.OutsideLabels(r, canvasBox, 256, 256, 100, slices, style)
+	testutil.AssertLen(t, labels, len(values))
+
+	for i := range labels {
+		testutil.AssertTrue(t, labels[i].Y-labels[i].Height>>1 >= canvasBox.Top)
+		testutil.AssertTrue(t, labels[i].Y+labels[i].Height>>1 <= canvasBox.Bottom)
+		for j := range labels {
+			if i == j || labels[i].Right != labels[j].Right {
+				continue
+			}
+			distance := labels[i].Y - labels[j].Y
+			if distance < 0 {
+				distance = -distance
+			}
+			testutil.AssertTrue(t, distance >= labels[i].Height)
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package chart
+
+import (
+	"io"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Renderer = (*polarRenderer)(nil)
+)
+
+// polarRenderer wraps a renderer so shapes drawn in a cartesian canvas box are drawn on a circle instead;
+// the x-axis of the box wraps clockwise around the circle from twelve o'clock and the y-axis is the distance
+// from the center. Lines are split into short segments so lines along the x-axis are drawn as arcs.
+type polarRenderer struct {
+	Renderer
+
+	canvasBox Box
+	cx, cy    int
+	radius    float64
+
+	x, y           float64
+	startX, startY float64
+}
+
+func newPolarRenderer(r Renderer, canvasBox Box, cx, cy int, radius float64) *polarRenderer {
+	return &polarRenderer{
+		Renderer:  r,
+		canvasBox: canvasBox,
+		cx:        cx,
+		cy:        cy,
+		radius:    radius,
+	}
+}
+
+// Angle returns the angle in radians, clockwise from twelve o'clock, of an x coordinate of the canvas box.
+func (pr *polarRenderer) Angle(x float64) float64 {
+	return _2pi * (x - float64(pr.canvasBox.Left)) / float64(pr.canvasBox.Width())
+}
+
+// Distance returns the distance from the center of a y coordinate of the canvas box.
+func (pr *polarRenderer) Distance(y float64) float64 {
+	return math.Max(pr.radius*(float64(pr.canvasBox.Bottom)-y)/float64(pr.canvasBox.Height()), 0)
+}
+
+// Project returns where a point in the canvas box is drawn.
+func (pr *polarRenderer) Project(x, y float64) (px, py int) {
+	return CirclePoint(pr.cx, pr.cy, pr.Distance(y), pr.Angle(x))
+}
+
+// MoveTo implements the interface method.
+func (pr *polarRenderer) MoveTo(x, y int) {
+	pr.x, pr.y = float64(x), float64(y)
+	pr.startX, pr.startY = pr.x, pr.y
+	pr.Renderer.MoveTo(pr.Project(pr.x, pr.y))
+}
+
+// LineTo implements the interface method.
+func (pr *polarRenderer) LineTo(x, y int) {
+	pr.lineTo(float64(x), float64(y))
+}
+
+func (pr *polarRenderer) lineTo(x, y float64) {
+	arc := math.Abs(pr.Angle(x)-pr.Angle(pr.x)) * math.Max(pr.Distance(y), pr.Distance(pr.y))
+	segments := MaxInt(1, int(math.Ceil(arc/DefaultPolarSegmentLength)))
+	for segment := 1; segment <= segments; segment++ {
+		t := float64(segment) / float64(segments)
+		pr.Renderer.LineTo(pr.Project(pr.x+(x-pr.x)*t, pr.y+(y-pr.y)*t))
+	}
+	pr.x, pr.y = x, y
+}
+
+// QuadCurveTo implements the interface method.
+func (pr *polarRenderer) QuadCurveTo(cx, cy, x, y int) {
+	pcx, pcy := pr.Project(float64(cx), float64(cy))
+	px, py := pr.Project(float64(x), float64(y))
+	pr.Renderer.QuadCurveTo(pcx, pcy, px, py)
+	pr.x, pr.y = float64(x), float64(y)
+}
+
+// ArcTo implements the interface method.
+func (pr *polarRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
+	pcx, pcy := pr.Project(float64(cx), float64(cy))
+	pr.Renderer.ArcTo(pcx, pcy, rx, ry, startAngle, delta)
+}
+
+// Close implements the interface method; the shape is closed along the projected line back to its start.
+func (pr *polarRenderer) Close() {
+	pr.lineTo(pr.startX, pr.startY)
+	pr.Renderer.Close()
+}
+
+// Circle implements the interface method.
+func (pr *polarRenderer) Circle(radius float64, x, y int) {
+	px, py := pr.Project(float64(x), float64(y))
+	pr.Renderer.Circle(radius, px, py)
+}
+
+// Text implements the interface method.
+func (pr *polarRenderer) Text(body string, x, y int) {
+	px, py := pr.Project(float64(x), float64(y))
+	pr.Renderer.Text(body, px, py)
+}
+
+// Save implements the interface method.
+func (pr *polarRenderer) Save(w io.Writer) error {
+	return pr.Renderer.Save(w)
+}
+
+// polarLabelPosition returns where to draw a label of the given size just outside a circle at an angle in radians,
+// clockwise from twelve o'clock, so that the label is aligned away from the center.
+func polarLabelPosition(cx, cy int, radius, angle float64, tb Box) (x, y int) {
+	x, y = CirclePoint(cx, cy, radius, angle)
+
+	sin, cos := math.Sin(angle), math.Cos(angle)
+	switch {
+	case sin > 0.1:
+	case sin < -0.1:
+		x -= tb.Width()
+	default:
+		x -= tb.Width() >> 1
+	}
+	switch {
+	case cos > 0.1:
+	case cos < -0.1:
+		y += tb.Height()
+	default:
+		y += tb.Height() >> 1
+	}
+	return
+}
+
+// renderPolar draws the chart in polar coordinates; the series are drawn in a canvas box as wide as the circumference
+// and as tall as the radius of the circle, through a renderer that wraps the box around the circle.
+func (c Chart) renderPolar(r Renderer, w io.Writer, canvasBox Box, xr, yr, yra Range, yrs []Range, xf, yf, yfa ValueFormatter) error {
+	circleBox := c.getPolarCircleBox(r, canvasBox)
+	cx, cy := circleBox.Center()
+
+	// the radius depends on the size of the x-axis labels, which depend on the radius, so it is measured twice.
+	radius := float64(MinInt(canvasBox.Width(), canvasBox.Height())>>1) * 0.8
+	var polarBox Box
+	var xt, yt []Tick
+	for pass := 0; pass < 2; pass++ {
+		polarBox = c.getPolarCanvasBox(radius)
+		xr, yr, yra = c.setRangeDomains(polarBox, xr, yr, yra)
+		yrs = c.setAdditionalRangeDomains(polarBox, yrs)
+		if err := c.checkRanges(xr, yr, yra); err != nil {
+			r.Save(w)
+			return err
+		}
+		if err := c.checkAdditionalRanges(yrs); err != nil {
+			r.Save(w)
+			return err
+		}
+		xt, yt, _ = c.getAxesTicks(r, xr, yr, yra, xf, yf, yfa)
+		radius = c.getPolarRadius(r, circleBox, xt)
+	}
+
+	pr := newPolarRenderer(r, polarBox, cx, cy, radius)
+
+	c.drawCanvas(r, canvasBox)
+	c.drawPolarGrid(pr, polarBox, xr, yr, xt, yt)
+	for index, series := range c.Series {
+		c.drawSeries(pr, polarBox, xr, yr, yra, yrs, series, index)
+	}
+	c.drawPolarAxes(r, pr, xr, yr, xt, yt)
+	c.drawTitle(r)
+
+	for _, a := range c.Elements {
+		a(r, canvasBox, c.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+// getPolarCircleBox returns the part of the canvas box the circle is centered in, below the title.
+func (c Chart) getPolarCircleBox(r Renderer, canvasBox Box) Box {
+	if len(c.Title) > 0 && !c.TitleStyle.Hidden {
+		tb := Draw.MeasureText(r, c.Title, Style{
+			Font:     c.TitleStyle.GetFont(c.GetFont()),
+			FontSize: c.TitleStyle.GetFontSize(DefaultTitleFontSize),
+		})
+		canvasBox.Top = MaxInt(canvasBox.Top, c.TitleStyle.Padding.GetTop(DefaultTitleTop)+tb.Height()+DefaultTitleTop)
+	}
+	return canvasBox
+}
+
+// getPolarCanvasBox returns the cartesian canvas box the series are drawn in for a given radius.
+func (c Chart) getPolarCanvasBox(radius float64) Box {
+	return Box{
+		Right:  int(math.Round(_2pi * radius)),
+		Bottom: int(math.Round(radius)),
+	}
+}
+
+// getPolarRadius returns the largest radius that leaves room for the x-axis labels around the circle.
+func (c Chart) getPolarRadius(r Renderer, canvasBox Box, xt []Tick) float64 {
+	var labelWidth, labelHeight int
+	if !c.XAxis.Style.Hidden {
+		style := c.XAxis.Style.InheritFrom(c.styleDefaultsAxes())
+		for _, t := range xt {
+			if t.Label != "" {
+				tb := Draw.MeasureText(r, t.Label, style)
+				labelWidth = MaxInt(labelWidth, tb.Width())
+				labelHeight = MaxInt(labelHeight, tb.Height())
+			}
+		}
+	}
+	horizontal := canvasBox.Width()>>1 - labelWidth - DefaultPolarLabelPadding
+	vertical := canvasBox.Height()>>1 - labelHeight - DefaultPolarLabelPadding
+	return math.Max(float64(MinInt(horizontal, vertical)), 1)
+}
+
+// drawPolarGrid draws a spoke for each x-axis tick and a ring for each y-axis tick, unless the grid styles are hidden.
+func (c Chart) drawPolarGrid(pr *polarRenderer, polarBox Box, xr, yr Range, xt, yt []Tick) {
+	defaults := Style{
+		StrokeColor: c.GetColorPalette().AxisStrokeColor().WithAlpha(64),
+		StrokeWidth: DefaultAxisLineWidth,
+	}
+
+	if !c.XAxis.GridMajorStyle.Hidden {
+		style := c.XAxis.GridMajorStyle.InheritFrom(defaults)
+		for _, gl := range c.getPolarGridLines(c.XAxis.GridLines, xt, xr) {
+			gl.Render(pr, polarBox, xr, true, gl.Style.InheritFrom(style))
+		}
+	}
+	if !c.YAxis.GridMajorStyle.Hidden {
+		style := c.YAxis.GridMajorStyle.InheritFrom(defaults)
+		for _, gl := range c.getPolarGridLines(c.YAxis.GridLines, yt, yr) {
+			gl.Render(pr, polarBox, yr, false, gl.Style.InheritFrom(style))
+		}
+	}
+	pr.ResetStyle()
+}
+
+// getPolarGridLines returns the grid lines, or one for each tick that isn't at the start or end of the range.
+func (c Chart) getPolarGridLines(gridLines []GridLine, ticks []Tick, ra Range) []GridLine {
+	if len(gridLines) > 0 {
+		return gridLines
+	}
+	var output []GridLine
+	for _, t := range ticks {
+		if translated := ra.Translate(t.Value); translated > 0 && translated < ra.GetDomain() {
+			output = append(output, GridLine{Value: t.Value})
+		}
+	}
+	return output
+}
+
+// drawPolarAxes draws the x-axis as the outline of the circle with its labels around it,
+// and the y-axis labels along the spoke at twelve o'clock, skipping those that would run into an x-axis label.
+func (c Chart) drawPolarAxes(r Renderer, pr *polarRenderer, xr, yr Range, xt, yt []Tick) {
+	var xLabels []Box
+	if !c.XAxis.Style.Hidden {
+		style := c.XAxis.Style.InheritFrom(c.styleDefaultsAxes())
+		Draw.AnnularSector(r, pr.cx, pr.cy, 0, pr.radius, 0, _2pi, Style{
+			StrokeColor:     style.GetStrokeColor(),
+			StrokeWidth:     style.GetStrokeWidth(),
+			StrokeDashArray: style.GetStrokeDashArray(),
+		})
+
+		domain := float64(xr.GetDomain())
+		for index, t := range xt {
+			if t.Label == "" {
+				continue
+			}
+			position := float64(xr.Translate(t.Value))
+			if c.XAxis.TickPosition == TickPositionBetweenTicks {
+				if index == 0 {
+					continue
+				}
+				position = (position + float64(xr.Translate(xt[index-1].Value))) / 2.0
+			} else if position >= domain && len(xt) > 1 && xr.Translate(xt[0].Value) <= 0 {
+				// the end of the range is at the same angle as the start.
+				continue
+			}
+			tb := Draw.MeasureText(r, t.Label, style)
+			lx, ly := polarLabelPosition(pr.cx, pr.cy, pr.radius+DefaultPolarLabelPadding, _2pi*position/domain, tb)
+			Draw.Text(r, t.Label, lx, ly, style)
+			xLabels = append(xLabels, Box{Top: ly - tb.Height(), Left: lx, Right: lx + tb.Width(), Bottom: ly})
+		}
+	}
+
+	if !c.YAxis.Style.Hidden {
+		style := c.YAxis.Style.InheritFrom(c.styleDefaultsAxes())
+		for _, t := range yt {
+			distance := pr.Distance(float64(pr.canvasBox.Bottom - yr.Translate(t.Value)))
+			if t.Label == "" || distance <= 0 || distance > pr.radius {
+				continue
+			}
+			x, y := CirclePoint(pr.cx, pr.cy, distance, 0)
+			x, y = x+DefaultPolarLabelPadding>>1, y-DefaultPolarLabelPadding>>1
+			tb := Draw.MeasureText(r, t.Label, style)
+			if !c.intersectsAny(Box{Top: y - tb.Height(), Left: x, Right: x + tb.Width(), Bottom: y}, xLabels) {
+				Draw.Text(r, t.Label, x, y, style)
+			}
+		}
+	}
+}
+
+func (c Chart) intersectsAny(b Box, others []Box) bool {
+	for _, other := range others {
+		if b.Intersects(other) {
+			return true
+		}
+	}
+	return false
+}
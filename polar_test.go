@@ -0,0 +1,108 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestPolarRendererProject(t *testing.T) {
+	pr := newPolarRenderer(nil, Box{Right: 400, Bottom: 100}, 200, 200, 100)
+
+	// the left edge of the box is at twelve o'clock and the x-axis wraps clockwise.
+	x, y := pr.Project(0, 0)
+	testutil.AssertEqual(t, 200, x)
+	testutil.AssertEqual(t, 100, y)
+
+	x, y = pr.Project(100, 50)
+	testutil.AssertEqual(t, 250, x)
+	testutil.AssertEqual(t, 200, y)
+
+	x, y = pr.Project(200, 0)
+	testutil.AssertEqual(t, 200, x)
+	testutil.AssertEqual(t, 300, y)
+
+	// the bottom of the box is the center, and points below it are clamped to it.
+	x, y = pr.Project(300, 100)
+	testutil.AssertEqual(t, 200, x)
+	testutil.AssertEqual(t, 200, y)
+	testutil.AssertEqual(t, 0.0, pr.Distance(150))
+}
+
+func TestPolarLabelPosition(t *testing.T) {
+	tb := Box{Right: 20, Bottom: 10}
+
+	// labels are centered above twelve o'clock and aligned away from the circle elsewhere.
+	x, y := polarLabelPosition(100, 100, 50, 0, tb)
+	testutil.AssertEqual(t, 90, x)
+	testutil.AssertEqual(t, 50, y)
+
+	x, y = polarLabelPosition(100, 100, 50, _pi2, tb)
+	testutil.AssertEqual(t, 150, x)
+	testutil.AssertEqual(t, 105, y)
+
+	x, y = polarLabelPosition(100, 100, 50, _pi, tb)
+	testutil.AssertEqual(t, 90, x)
+	testutil.AssertEqual(t, 160, y)
+
+	x, y = polarLabelPosition(100, 100, 50, _3pi2, tb)
+	testutil.AssertEqual(t, 30, x)
+	testutil.AssertEqual(t, 105, y)
+}
+
+func TestChartPolar(t *testing.T) {
+	c := Chart{
+		Polar: true,
+		XAxis: XAxis{
+			Range: &ContinuousRange{Min: 0, Max: 360},
+			Ticks: []Tick{
+				{Value: 0, Label: "N"},
+				{Value: 90, Label: "E"},
+				{Value: 180, Label: "S"},
+				{Value: 270, Label: "W"},
+				{Value: 360, Label: "N"},
+			},
+		},
+		Series: []Series{
+			ContinuousSeries{
+				XValues: []float64{0, 45, 90, 135, 180, 225, 270, 315, 360},
+				YValues: []float64{4, 6, 3, 2, 5, 8, 7, 5, 4},
+				Style:   Style{FillColor: ColorBlue.WithAlpha(64)},
+			},
+		},
+	}
+
+	testutil.AssertNil(t, c.Render(PNG, bytes.NewBuffer([]byte{})))
+	testutil.AssertNil(t, c.Render(SVG, bytes.NewBuffer([]byte{})))
+
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	c.Font = f
+	r, err := PNG(c.GetWidth(), c.GetHeight())
+	testutil.AssertNil(t, err)
+
+	// the circle leaves room for the labels around it, and the series wrap around it once.
+	canvasBox := c.getPolarCircleBox(r, c.Box())
+	radius := c.getPolarRadius(r, canvasBox, c.XAxis.Ticks)
+	testutil.AssertTrue(t, radius > 1 && 2*radius < float64(canvasBox.Height()))
+	polarBox := c.getPolarCanvasBox(radius)
+	testutil.AssertEqual(t, int(math.Round(radius)), polarBox.Height())
+	testutil.AssertInDelta(t, _2pi*radius, float64(polarBox.Width()), 1)
+}
+
+func TestChartPolarGridLines(t *testing.T) {
+	c := Chart{}
+	xr := &ContinuousRange{Min: 0, Max: 360, Domain: 600}
+	ticks := []Tick{{Value: 0}, {Value: 90}, {Value: 180}, {Value: 270}, {Value: 360}}
+
+	// the ticks at either end of the range would draw over the center and the outline of the circle.
+	gridLines := c.getPolarGridLines(nil, ticks, xr)
+	testutil.AssertLen(t, gridLines, 3)
+	testutil.AssertEqual(t, 90.0, gridLines[0].Value)
+	testutil.AssertEqual(t, 270.0, gridLines[2].Value)
+
+	gridLines = c.getPolarGridLines([]GridLine{{Value: 45}}, ticks, xr)
+	testutil.AssertLen(t, gridLines, 1)
+}
@@ -12,6 +12,7 @@ var (
 	_ Series              = (*PolynomialRegressionSeries)(nil)
 	_ FirstValuesProvider = (*PolynomialRegressionSeries)(nil)
 	_ LastValuesProvider  = (*PolynomialRegressionSeries)(nil)
+	_ RegressionProvider  = (*PolynomialRegressionSeries)(nil)
 )
 
 // PolynomialRegressionSeries implements a polynomial regression over a given
@@ -82,6 +83,9 @@ func (prs *PolynomialRegressionSeries) Validate() error {
 	if endIndex >= prs.InnerSeries.Len() {
 		return fmt.Errorf("invalid window; inner series has length %d but end index is %d", prs.InnerSeries.Len(), endIndex)
 	}
+	if _, err := prs.computeCoefficients(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -142,6 +146,63 @@ func (prs *PolynomialRegressionSeries) GetLastValues() (x, y float64) {
 	return
 }
 
+// GetCoefficients returns the coefficients of the fitted polynomial, from the constant term up, or nil if
+// there are no values or the polynomial can't be fitted to them.
+func (prs *PolynomialRegressionSeries) GetCoefficients() []float64 {
+	if prs.InnerSeries == nil || prs.InnerSeries.Len() == 0 {
+		return nil
+	}
+	if prs.coeffs == nil {
+		coeffs, err := prs.computeCoefficients()
+		if err != nil {
+			return nil
+		}
+		prs.coeffs = coeffs
+	}
+	return prs.coeffs
+}
+
+// GetRSquared returns the coefficient of determination of the fitted polynomial, or NaN if it can't be fitted.
+func (prs *PolynomialRegressionSeries) GetRSquared() float64 {
+	residuals := prs.GetResiduals()
+	if residuals == nil {
+		return math.NaN()
+	}
+	_, yvalues := prs.values()
+	return regressionRSquared(yvalues, residuals)
+}
+
+// GetResiduals returns the differences between the values the polynomial is fitted to and the fitted values,
+// or nil if it can't be fitted.
+func (prs *PolynomialRegressionSeries) GetResiduals() []float64 {
+	if prs.GetCoefficients() == nil {
+		return nil
+	}
+	xvalues, yvalues := prs.values()
+	residuals := make([]float64, len(xvalues))
+	for index, x := range xvalues {
+		residuals[index] = yvalues[index] - prs.apply(x)
+	}
+	return residuals
+}
+
+// GetEquation returns the fitted equation.
+func (prs *PolynomialRegressionSeries) GetEquation() string {
+	coefficients := prs.GetCoefficients()
+	if len(coefficients) == 0 {
+		return ""
+	}
+	equation := fmt.Sprintf("y = %s", formatRegressionCoefficient(coefficients[0]))
+	for power := 1; power < len(coefficients); power++ {
+		variable := "x"
+		if power > 1 {
+			variable = fmt.Sprintf("x^%d", power)
+		}
+		equation += formatRegressionTerm(coefficients[power], variable)
+	}
+	return equation
+}
+
 func (prs *PolynomialRegressionSeries) apply(v float64) (out float64) {
 	for index, coeff := range prs.coeffs {
 		out = out + (coeff * math.Pow(v, float64(index)))
@@ -151,6 +212,9 @@ func (prs *PolynomialRegressionSeries) apply(v float64) (out float64) {
 
 func (prs *PolynomialRegressionSeries) computeCoefficients() ([]float64, error) {
 	xvalues, yvalues := prs.values()
+	if len(xvalues) <= prs.Degree {
+		return nil, fmt.Errorf("polynomial regression series requires more values than its degree; values: %d, degree: %d", len(xvalues), prs.Degree)
+	}
 	return matrix.Poly(xvalues, yvalues, prs.Degree)
 }
 
@@ -1,6 +1,7 @@
 package chart
 
 import (
+	"math"
 	"testing"
 
 	"github.com/wcharczuk/go-chart/v2/matrix"
@@ -33,3 +34,44 @@ func TestPolynomialRegression(t *testing.T) {
 		testutil.AssertInDelta(t, float64(i*i), y, matrix.DefaultEpsilon)
 	}
 }
+
+func TestPolynomialRegressionFit(t *testing.T) {
+	poly := &PolynomialRegressionSeries{
+		InnerSeries: ContinuousSeries{
+			XValues: []float64{0, 1, 2, 3, 4},
+			YValues: []float64{1, 2, 5, 10, 17},
+		},
+		Degree: 2,
+	}
+
+	coefficients := poly.GetCoefficients()
+	testutil.AssertLen(t, coefficients, 3)
+	testutil.AssertInDelta(t, 1.0, coefficients[0], 0.0001)
+	testutil.AssertInDelta(t, 0.0, coefficients[1], 0.0001)
+	testutil.AssertInDelta(t, 1.0, coefficients[2], 0.0001)
+	testutil.AssertInDelta(t, 1.0, poly.GetRSquared(), 0.0001)
+	// the fit leaves out the last value of the window.
+	testutil.AssertLen(t, poly.GetResiduals(), 4)
+}
+
+func TestPolynomialRegressionUnfitted(t *testing.T) {
+	// there are fewer values than the degree needs.
+	poly := &PolynomialRegressionSeries{
+		InnerSeries: ContinuousSeries{
+			XValues: []float64{0, 1, 2},
+			YValues: []float64{1, 2, 5},
+		},
+		Degree: 5,
+	}
+	testutil.AssertNotNil(t, poly.Validate())
+	testutil.AssertNil(t, poly.GetCoefficients())
+	testutil.AssertNil(t, poly.GetResiduals())
+	testutil.AssertTrue(t, math.IsNaN(poly.GetRSquared()))
+	testutil.AssertEqual(t, "", poly.GetEquation())
+	testutil.AssertEmpty(t, RegressionAnnotationSeries(poly).Annotations)
+
+	empty := &PolynomialRegressionSeries{InnerSeries: ContinuousSeries{}, Degree: 2}
+	testutil.AssertNil(t, empty.GetCoefficients())
+	testutil.AssertEqual(t, "", empty.GetEquation())
+	testutil.AssertEmpty(t, RegressionAnnotationSeries(empty).Annotations)
+}
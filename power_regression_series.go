@@ -0,0 +1,133 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series                = (*PowerRegressionSeries)(nil)
+	_ ValuesProvider        = (*PowerRegressionSeries)(nil)
+	_ BoundedValuesProvider = (*PowerRegressionSeries)(nil)
+	_ FirstValuesProvider   = (*PowerRegressionSeries)(nil)
+	_ LastValuesProvider    = (*PowerRegressionSeries)(nil)
+	_ RegressionProvider    = (*PowerRegressionSeries)(nil)
+)
+
+// powerRegressionModel fits y = a*x^b.
+var powerRegressionModel = linearizedRegressionModel{
+	Name:       "power",
+	TransformX: math.Log,
+	TransformY: math.Log,
+	InverseY:   math.Exp,
+	PositiveX:  true,
+	PositiveY:  true,
+}
+
+// PowerRegressionSeries draws the power curve y = a*x^b fitted to the values of an inner series.
+//
+// The curve is fitted as a line through the logarithms of the values, so they must be positive.
+type PowerRegressionSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Limit       int
+	Offset      int
+	InnerSeries ValuesProvider
+
+	// Band is the band drawn around the curve with the `Confidence` level, which defaults to 95%.
+	Band       RegressionBand
+	BandStyle  Style
+	Confidence float64
+
+	regression *regression
+}
+
+// GetName returns the name of the series.
+func (prs PowerRegressionSeries) GetName() string {
+	return prs.Name
+}
+
+// GetStyle returns the line style.
+func (prs PowerRegressionSeries) GetStyle() Style {
+	return prs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (prs PowerRegressionSeries) GetYAxis() YAxisType {
+	return prs.YAxis
+}
+
+// GetConfidence returns the confidence level of the band or the default.
+func (prs PowerRegressionSeries) GetConfidence() float64 {
+	if prs.Confidence <= 0 || prs.Confidence >= 1 {
+		return DefaultRegressionConfidence
+	}
+	return prs.Confidence
+}
+
+// Len returns the number of values in the window of the inner series.
+func (prs *PowerRegressionSeries) Len() int {
+	return prs.getRegression().Len()
+}
+
+// GetValues returns a value of the inner series and its fitted value.
+func (prs *PowerRegressionSeries) GetValues(index int) (x, y float64) {
+	return prs.getRegression().GetValues(index)
+}
+
+// GetBoundedValues returns a value of the inner series and the band at it.
+func (prs *PowerRegressionSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	return prs.getRegression().GetBoundedValues(index)
+}
+
+// GetFirstValues returns the first value of the window and its fitted value.
+func (prs *PowerRegressionSeries) GetFirstValues() (x, y float64) {
+	return prs.getRegression().GetFirstValues()
+}
+
+// GetLastValues returns the last value of the window and its fitted value.
+func (prs *PowerRegressionSeries) GetLastValues() (x, y float64) {
+	return prs.getRegression().GetLastValues()
+}
+
+// GetCoefficients returns the a and b coefficients of y = a*x^b.
+func (prs *PowerRegressionSeries) GetCoefficients() []float64 {
+	return prs.getRegression().Coefficients
+}
+
+// GetRSquared returns the coefficient of determination of the fitted values.
+func (prs *PowerRegressionSeries) GetRSquared() float64 {
+	return prs.getRegression().GetRSquared()
+}
+
+// GetResiduals returns the differences between the values and the fitted values.
+func (prs *PowerRegressionSeries) GetResiduals() []float64 {
+	return prs.getRegression().GetResiduals()
+}
+
+// GetEquation returns the fitted equation.
+func (prs *PowerRegressionSeries) GetEquation() string {
+	coefficients := prs.GetCoefficients()
+	return fmt.Sprintf("y = %sx^%s", formatRegressionCoefficient(coefficients[0]), formatRegressionCoefficient(coefficients[1]))
+}
+
+// Render renders the series.
+func (prs *PowerRegressionSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := prs.Style.InheritFrom(defaults)
+	drawRegression(r, canvasBox, xrange, yrange, style, prs.BandStyle, prs.Band, prs.getRegression())
+}
+
+// Validate validates the series.
+func (prs *PowerRegressionSeries) Validate() error {
+	return validateLinearizedRegression(powerRegressionModel, prs.InnerSeries, prs.Offset, prs.Limit)
+}
+
+func (prs *PowerRegressionSeries) getRegression() *regression {
+	if prs.regression == nil {
+		prs.regression = fitLinearizedRegression(powerRegressionModel, prs.InnerSeries, prs.Offset, prs.Limit, prs.Band, prs.GetConfidence())
+	}
+	return prs.regression
+}
@@ -0,0 +1,535 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/golang/freetype/truetype"
+)
+
+// RadarGridShape is an enum for the shape of the grid rings of a radar chart.
+type RadarGridShape int
+
+const (
+	// RadarGridShapeUnset is the unset state for the grid shape; it behaves like `RadarGridShapePolygon`.
+	RadarGridShapeUnset RadarGridShape = 0
+	// RadarGridShapePolygon draws the grid rings as polygons with a corner on each spoke.
+	RadarGridShapePolygon RadarGridShape = 1
+	// RadarGridShapeCircle draws the grid rings as circles.
+	RadarGridShapeCircle RadarGridShape = 2
+)
+
+// RadarAxis is a spoke of a radar chart.
+type RadarAxis struct {
+	Name string
+	// Range, if set, is the range of the axis; otherwise the axis shares a range fit to the values of all the axes without a range.
+	Range Range
+	// ValueFormatter formats the tick labels of the axis.
+	ValueFormatter ValueFormatter
+}
+
+// RadarSeries is a set of values, one per axis, drawn as a polygon on a radar chart.
+type RadarSeries struct {
+	Name   string
+	Style  Style
+	Values []float64
+}
+
+// RadarChart is a chart that compares values across several axes arranged as the spokes of a wheel.
+type RadarChart struct {
+	Title      string
+	TitleStyle Style
+
+	ColorPalette ColorPalette
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+
+	// GridShape determines if the grid rings are polygons or circles.
+	GridShape RadarGridShape
+	// GridRings is the number of grid rings, each of which gets a tick label.
+	GridRings int
+	GridStyle Style
+	// AxisStyle is the style of the spokes and their labels.
+	AxisStyle Style
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	Axes     []RadarAxis
+	Series   []RadarSeries
+	Elements []Renderable
+}
+
+// GetDPI returns the dpi for the chart.
+func (rc RadarChart) GetDPI(defaults ...float64) float64 {
+	if rc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return rc.DPI
+}
+
+// GetFont returns the text font.
+func (rc RadarChart) GetFont() *truetype.Font {
+	if rc.Font == nil {
+		return rc.defaultFont
+	}
+	return rc.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (rc RadarChart) GetWidth() int {
+	if rc.Width == 0 {
+		return DefaultChartWidth
+	}
+	return rc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (rc RadarChart) GetHeight() int {
+	if rc.Height == 0 {
+		return DefaultChartHeight
+	}
+	return rc.Height
+}
+
+// GetGridRings returns the number of grid rings or the default.
+func (rc RadarChart) GetGridRings() int {
+	if rc.GridRings <= 0 {
+		return DefaultRadarGridRings
+	}
+	return rc.GridRings
+}
+
+// Validate validates the chart.
+func (rc RadarChart) Validate() error {
+	if len(rc.Axes) < 3 {
+		return fmt.Errorf("radar chart must have at least (3) axes")
+	}
+	if len(rc.Series) == 0 {
+		return errors.New("please provide at least one series")
+	}
+	for _, s := range rc.Series {
+		if len(s.Values) != len(rc.Axes) {
+			return fmt.Errorf("radar series %q must have (%d) values, one per axis; has (%d)", s.Name, len(rc.Axes), len(s.Values))
+		}
+		for index, value := range s.Values {
+			if math.IsNaN(value) || math.IsInf(value, 0) {
+				return fmt.Errorf("radar series %q values must be finite; index: %d value: %v", s.Name, index, value)
+			}
+		}
+	}
+	return nil
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (rc RadarChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := rc.Validate(); err != nil {
+		return err
+	}
+
+	r, err := rp(rc.GetWidth(), rc.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if rc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		rc.defaultFont = defaultFont
+	}
+	r.SetDPI(rc.GetDPI(DefaultDPI))
+
+	canvasBox := rc.getCanvasBox(r)
+	rc.drawBackground(r)
+	rc.drawCanvas(r, canvasBox)
+
+	cx, cy := canvasBox.Center()
+	radius := rc.getRadius(r, canvasBox)
+	ranges := rc.getRanges(radius)
+
+	rc.drawGrid(r, cx, cy, radius)
+	rc.drawSeries(r, cx, cy, ranges)
+	rc.drawTickLabels(r, cx, cy, radius, ranges)
+	rc.drawAxisLabels(r, cx, cy, radius)
+	rc.drawTitle(r)
+	for _, a := range rc.Elements {
+		a(r, canvasBox, rc.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+func (rc RadarChart) drawBackground(r Renderer) {
+	Draw.Box(r, Box{
+		Right:  rc.GetWidth(),
+		Bottom: rc.GetHeight(),
+	}, rc.getBackgroundStyle())
+}
+
+func (rc RadarChart) drawCanvas(r Renderer, canvasBox Box) {
+	Draw.Box(r, canvasBox, rc.getCanvasStyle())
+}
+
+func (rc RadarChart) drawTitle(r Renderer) {
+	if len(rc.Title) > 0 && !rc.TitleStyle.Hidden {
+		Draw.TextWithin(r, rc.Title, rc.Box(), rc.styleDefaultsTitle())
+	}
+}
+
+func (rc RadarChart) drawGrid(r Renderer, cx, cy int, radius float64) {
+	gridStyle := rc.getGridStyle()
+	if !gridStyle.Hidden {
+		rings := rc.GetGridRings()
+		for ring := 1; ring <= rings; ring++ {
+			ringRadius := radius * float64(ring) / float64(rings)
+			if rc.GridShape == RadarGridShapeCircle {
+				Draw.AnnularSector(r, cx, cy, 0, ringRadius, 0, _2pi, gridStyle)
+				continue
+			}
+			gridStyle.GetStrokeOptions().WriteToRenderer(r)
+			for index := range rc.Axes {
+				x, y := CirclePoint(cx, cy, ringRadius, rc.getAxisAngle(index))
+				if index == 0 {
+					r.MoveTo(x, y)
+				} else {
+					r.LineTo(x, y)
+				}
+			}
+			r.Close()
+			r.Stroke()
+			r.ResetStyle()
+		}
+	}
+
+	axisStyle := rc.getAxisStyle()
+	if !axisStyle.Hidden {
+		axisStyle.GetStrokeOptions().WriteToRenderer(r)
+		for index := range rc.Axes {
+			x, y := CirclePoint(cx, cy, radius, rc.getAxisAngle(index))
+			r.MoveTo(cx, cy)
+			r.LineTo(x, y)
+			r.Stroke()
+		}
+		r.ResetStyle()
+	}
+}
+
+func (rc RadarChart) drawSeries(r Renderer, cx, cy int, ranges []Range) {
+	for seriesIndex, s := range rc.Series {
+		style := rc.getSeriesStyle(seriesIndex)
+		if style.Hidden {
+			continue
+		}
+
+		points := rc.getSeriesPoints(cx, cy, ranges, s)
+		style.GetFillAndStrokeOptions().WriteToRenderer(r)
+		for index, p := range points {
+			if index == 0 {
+				r.MoveTo(p.X, p.Y)
+			} else {
+				r.LineTo(p.X, p.Y)
+			}
+		}
+		r.Close()
+		r.FillStroke()
+		r.ResetStyle()
+
+		if style.ShouldDrawDot() {
+			dotStyle := style.GetDotOptions()
+			dotStyle.WriteToRenderer(r)
+			for _, p := range points {
+				r.Circle(dotStyle.GetDotWidth(), p.X, p.Y)
+				r.FillStroke()
+			}
+			r.ResetStyle()
+		}
+	}
+}
+
+// drawTickLabels labels the grid rings along the first spoke, and along the spokes of the axes with their own range.
+func (rc RadarChart) drawTickLabels(r Renderer, cx, cy int, radius float64, ranges []Range) {
+	style := rc.getTickLabelStyle()
+	if style.Hidden {
+		return
+	}
+
+	var axisLabels []Box
+	if axisLabelStyle := rc.getAxisLabelStyle(); !axisLabelStyle.Hidden {
+		for index, axis := range rc.Axes {
+			if axis.Name != "" {
+				tb := Draw.MeasureText(r, axis.Name, axisLabelStyle)
+				lx, ly := rc.getAxisLabelPosition(cx, cy, radius, index, tb)
+				axisLabels = append(axisLabels, Box{Top: ly - tb.Height(), Left: lx, Right: lx + tb.Width(), Bottom: ly})
+			}
+		}
+	}
+
+	rings := rc.GetGridRings()
+	for index, axis := range rc.Axes {
+		if index > 0 && (axis.Range == nil || axis.Range.IsZero()) {
+			continue
+		}
+		vf := axis.ValueFormatter
+		if vf == nil {
+			vf = FloatValueFormatter
+		}
+		angle := rc.getAxisAngle(index)
+		delta := ranges[index].GetDelta()
+		for ring := 1; ring <= rings; ring++ {
+			label := vf(ranges[index].GetMin() + delta*float64(ring)/float64(rings))
+			x, y := CirclePoint(cx, cy, radius*float64(ring)/float64(rings), angle)
+			x, y = x+DefaultRadarLabelPadding>>1, y-DefaultRadarLabelPadding>>1
+
+			// tick labels that would run into an axis label are skipped.
+			tb := Draw.MeasureText(r, label, style)
+			labelBox := Box{Top: y - tb.Height(), Left: x, Right: x + tb.Width(), Bottom: y}
+			if !rc.intersectsAny(labelBox, axisLabels) {
+				Draw.Text(r, label, x, y, style)
+			}
+		}
+	}
+}
+
+// drawAxisLabels draws the name of each axis just outside the end of its spoke, aligned away from the center.
+func (rc RadarChart) drawAxisLabels(r Renderer, cx, cy int, radius float64) {
+	style := rc.getAxisLabelStyle()
+	if style.Hidden {
+		return
+	}
+	for index, axis := range rc.Axes {
+		if axis.Name == "" {
+			continue
+		}
+		tb := Draw.MeasureText(r, axis.Name, style)
+		lx, ly := rc.getAxisLabelPosition(cx, cy, radius, index, tb)
+		Draw.Text(r, axis.Name, lx, ly, style)
+	}
+}
+
+// getAxisLabelPosition returns where the name of an axis is drawn given its measured bounds.
+func (rc RadarChart) getAxisLabelPosition(cx, cy int, radius float64, index int, tb Box) (x, y int) {
+	return polarLabelPosition(cx, cy, radius+DefaultRadarLabelPadding, rc.getAxisAngle(index), tb)
+}
+
+func (rc RadarChart) intersectsAny(b Box, others []Box) bool {
+	for _, other := range others {
+		if b.Intersects(other) {
+			return true
+		}
+	}
+	return false
+}
+
+// getSeriesPoints returns the vertex of a series on each spoke.
+func (rc RadarChart) getSeriesPoints(cx, cy int, ranges []Range, s RadarSeries) []Point {
+	points := make([]Point, len(s.Values))
+	for index, value := range s.Values {
+		distance := float64(ranges[index].Translate(value))
+		distance = math.Max(0, math.Min(distance, float64(ranges[index].GetDomain())))
+		points[index].X, points[index].Y = CirclePoint(cx, cy, distance, rc.getAxisAngle(index))
+	}
+	return points
+}
+
+// getAxisAngle returns the angle in radians of a spoke, clockwise from twelve o'clock.
+func (rc RadarChart) getAxisAngle(index int) float64 {
+	return _2pi * float64(index) / float64(len(rc.Axes))
+}
+
+// getRanges returns the range of each axis; the axes without a range share one that spans zero
+// and the values of all of them.
+func (rc RadarChart) getRanges(radius float64) []Range {
+	shared := &ContinuousRange{}
+	min, max := 0.0, 0.0
+	for _, s := range rc.Series {
+		for index, value := range s.Values {
+			if axisRange := rc.Axes[index].Range; axisRange != nil && !axisRange.IsZero() {
+				continue
+			}
+			min = math.Min(min, value)
+			max = math.Max(max, value)
+		}
+	}
+	if max == min {
+		max = min + 1
+	}
+	// the range is rounded out so each grid ring falls on a round value.
+	step := niceStep((max - min) / float64(rc.GetGridRings()))
+	shared.Min = RoundDown(min, step)
+	shared.Max = shared.Min + step*float64(rc.GetGridRings())
+	for shared.Max < max {
+		shared.Max += step
+	}
+	shared.Domain = int(radius)
+
+	ranges := make([]Range, len(rc.Axes))
+	for index, axis := range rc.Axes {
+		if axis.Range != nil && !axis.Range.IsZero() {
+			axis.Range.SetDomain(int(radius))
+			ranges[index] = axis.Range
+		} else {
+			ranges[index] = shared
+		}
+	}
+	return ranges
+}
+
+// getRadius returns the largest radius that leaves room for the axis labels within the canvas.
+func (rc RadarChart) getRadius(r Renderer, canvasBox Box) float64 {
+	style := rc.getAxisLabelStyle()
+	var labelWidth, labelHeight int
+	if !style.Hidden {
+		for _, axis := range rc.Axes {
+			if axis.Name != "" {
+				tb := Draw.MeasureText(r, axis.Name, style)
+				labelWidth = MaxInt(labelWidth, tb.Width())
+				labelHeight = MaxInt(labelHeight, tb.Height())
+			}
+		}
+	}
+	horizontal := canvasBox.Width()>>1 - labelWidth - DefaultRadarLabelPadding
+	vertical := canvasBox.Height()>>1 - labelHeight - DefaultRadarLabelPadding
+	return math.Max(float64(MinInt(horizontal, vertical)), 0)
+}
+
+func (rc RadarChart) getCanvasBox(r Renderer) Box {
+	canvasBox := rc.Box()
+	if len(rc.Title) > 0 && !rc.TitleStyle.Hidden {
+		titleBox := Draw.MeasureText(r, rc.Title, rc.styleDefaultsTitle())
+		canvasBox.Top += titleBox.Height() + DefaultTitleTop
+	}
+	return canvasBox
+}
+
+func (rc RadarChart) getSeriesStyle(index int) Style {
+	return rc.Series[index].Style.InheritFrom(rc.styleDefaultsSeries(index))
+}
+
+func (rc RadarChart) styleDefaultsSeries(index int) Style {
+	color := rc.GetColorPalette().GetSeriesColor(index)
+	return Style{
+		StrokeColor: color,
+		StrokeWidth: DefaultSeriesLineWidth,
+		FillColor:   color.WithAlpha(DefaultRadarFillAlpha),
+		DotColor:    color,
+		Font:        rc.GetFont(),
+		FontSize:    DefaultFontSize,
+	}
+}
+
+func (rc RadarChart) getGridStyle() Style {
+	return rc.GridStyle.InheritFrom(Style{
+		StrokeColor: rc.GetColorPalette().AxisStrokeColor().WithAlpha(64),
+		StrokeWidth: DefaultAxisLineWidth,
+	})
+}
+
+func (rc RadarChart) getAxisStyle() Style {
+	return rc.AxisStyle.InheritFrom(Style{
+		StrokeColor: rc.GetColorPalette().AxisStrokeColor().WithAlpha(64),
+		StrokeWidth: DefaultAxisLineWidth,
+		FontColor:   rc.GetColorPalette().TextColor(),
+		FontSize:    DefaultFontSize,
+		Font:        rc.GetFont(),
+	})
+}
+
+func (rc RadarChart) getAxisLabelStyle() Style {
+	return rc.getAxisStyle()
+}
+
+func (rc RadarChart) getTickLabelStyle() Style {
+	return rc.GridStyle.InheritFrom(Style{
+		FontColor: rc.GetColorPalette().TextColor(),
+		FontSize:  DefaultAxisFontSize,
+		Font:      rc.GetFont(),
+	})
+}
+
+func (rc RadarChart) getBackgroundStyle() Style {
+	return rc.Background.InheritFrom(rc.styleDefaultsBackground())
+}
+
+func (rc RadarChart) getCanvasStyle() Style {
+	return rc.Canvas.InheritFrom(rc.styleDefaultsCanvas())
+}
+
+func (rc RadarChart) styleDefaultsCanvas() Style {
+	return Style{
+		FillColor:   rc.GetColorPalette().CanvasColor(),
+		StrokeColor: rc.GetColorPalette().CanvasStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (rc RadarChart) styleDefaultsBackground() Style {
+	return Style{
+		FillColor:   rc.GetColorPalette().BackgroundColor(),
+		StrokeColor: rc.GetColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (rc RadarChart) styleDefaultsElements() Style {
+	return Style{
+		Font: rc.GetFont(),
+	}
+}
+
+func (rc RadarChart) styleDefaultsTitle() Style {
+	return rc.TitleStyle.InheritFrom(Style{
+		FontColor:           rc.GetColorPalette().TextColor(),
+		Font:                rc.GetFont(),
+		FontSize:            rc.getTitleFontSize(),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+func (rc RadarChart) getTitleFontSize() float64 {
+	effectiveDimension := MinInt(rc.GetWidth(), rc.GetHeight())
+	if effectiveDimension >= 2048 {
+		return 48
+	} else if effectiveDimension >= 1024 {
+		return 24
+	} else if effectiveDimension >= 512 {
+		return 18
+	} else if effectiveDimension >= 256 {
+		return 12
+	}
+	return 10
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (rc RadarChart) GetColorPalette() ColorPalette {
+	if rc.ColorPalette != nil {
+		return rc.ColorPalette
+	}
+	return DefaultColorPalette
+}
+
+// Box returns the chart bounds as a box.
+func (rc RadarChart) Box() Box {
+	dpr := rc.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
+	dpb := rc.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
+
+	return Box{
+		Top:    rc.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
+		Left:   rc.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
+		Right:  rc.GetWidth() - dpr,
+		Bottom: rc.GetHeight() - dpb,
+	}
+}
@@ -0,0 +1,133 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestRadarChart(t *testing.T) {
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	rc := RadarChart{
+		Title:     "Test Title",
+		Font:      f,
+		GridShape: RadarGridShapeCircle,
+		Axes: []RadarAxis{
+			{Name: "A"},
+			{Name: "B"},
+			{Name: "C", Range: &ContinuousRange{Min: 0, Max: 10}},
+			{Name: "D"},
+		},
+		Series: []RadarSeries{
+			{Name: "One", Values: []float64{95, 70, 5, 20}},
+			{Name: "Two", Values: []float64{40, 60, 8, 80}},
+		},
+	}
+	rc.Elements = []Renderable{RadarLegend(&rc)}
+	testutil.AssertNil(t, rc.Render(PNG, bytes.NewBuffer([]byte{})))
+	testutil.AssertNil(t, rc.Render(SVG, bytes.NewBuffer([]byte{})))
+
+	r, err := PNG(rc.GetWidth(), rc.GetHeight())
+	testutil.AssertNil(t, err)
+	canvasBox := rc.getCanvasBox(r)
+	testutil.AssertTrue(t, canvasBox.Top > rc.Box().Top)
+
+	// the chart leaves room for the axis labels, and the series are within it.
+	radius := rc.getRadius(r, canvasBox)
+	testutil.AssertTrue(t, radius > 0 && 2*radius < float64(canvasBox.Height()))
+	cx, cy := canvasBox.Center()
+	ranges := rc.getRanges(radius)
+	for _, series := range rc.Series {
+		for _, point := range rc.getSeriesPoints(cx, cy, ranges, series) {
+			testutil.AssertTrue(t, math.Hypot(float64(point.X-cx), float64(point.Y-cy)) <= radius+1)
+		}
+	}
+}
+
+func TestRadarChartValidate(t *testing.T) {
+	rc := RadarChart{
+		Axes: []RadarAxis{{Name: "A"}, {Name: "B"}, {Name: "C"}, {Name: "D"}},
+		Series: []RadarSeries{
+			{Name: "One", Values: []float64{95, 70, 5, 20}},
+			{Name: "Two", Values: []float64{40, 60, 8, 80}},
+		},
+	}
+	testutil.AssertNil(t, rc.Validate())
+
+	rc.Series[1].Values[2] = math.NaN()
+	testutil.AssertNotNil(t, rc.Validate())
+
+	rc.Series[1].Values[2] = math.Inf(-1)
+	testutil.AssertNotNil(t, rc.Validate())
+
+	rc.Series[1].Values = rc.Series[1].Values[:3]
+	testutil.AssertNotNil(t, rc.Validate())
+
+	rc.Series = nil
+	testutil.AssertNotNil(t, rc.Validate())
+
+	rc.Axes = rc.Axes[:2]
+	testutil.AssertNotNil(t, rc.Validate())
+}
+
+func TestRadarChartGetRanges(t *testing.T) {
+	rc := RadarChart{
+		Axes: []RadarAxis{
+			{Name: "A"},
+			{Name: "B"},
+			{Name: "C", Range: &ContinuousRange{Min: 0, Max: 10}},
+			{Name: "D"},
+		},
+		Series: []RadarSeries{
+			{Name: "One", Values: []float64{95, 70, 5, 20}},
+			{Name: "Two", Values: []float64{40, 60, 8, 80}},
+		},
+	}
+	ranges := rc.getRanges(100)
+	testutil.AssertLen(t, ranges, 4)
+
+	// the axes without a range share one rounded out to the grid rings.
+	testutil.AssertTrue(t, ranges[0] == ranges[1])
+	testutil.AssertEqual(t, 0.0, ranges[0].GetMin())
+	testutil.AssertEqual(t, 100.0, ranges[0].GetMax())
+	testutil.AssertEqual(t, 100, ranges[0].GetDomain())
+
+	testutil.AssertEqual(t, 10.0, ranges[2].GetMax())
+	testutil.AssertEqual(t, 100, ranges[2].GetDomain())
+}
+
+func TestRadarChartGetSeriesPoints(t *testing.T) {
+	rc := RadarChart{
+		Axes: []RadarAxis{
+			{Name: "A"},
+			{Name: "B"},
+			{Name: "C", Range: &ContinuousRange{Min: 0, Max: 10}},
+			{Name: "D"},
+		},
+		Series: []RadarSeries{
+			{Name: "One", Values: []float64{95, 70, 5, 20}},
+		},
+	}
+	ranges := rc.getRanges(100)
+	points := rc.getSeriesPoints(200, 200, ranges, RadarSeries{Values: []float64{50, 100, 20, 0}})
+
+	// the first spoke points up, the rest go clockwise; values past the range are clamped.
+	testutil.AssertEqual(t, Point{X: 200, Y: 150}, points[0])
+	testutil.AssertEqual(t, Point{X: 300, Y: 200}, points[1])
+	testutil.AssertEqual(t, Point{X: 200, Y: 300}, points[2])
+	testutil.AssertEqual(t, Point{X: 200, Y: 200}, points[3])
+
+	testutil.AssertInDelta(t, math.Pi/2, rc.getAxisAngle(1), 0.0001)
+}
+
+func TestNiceStep(t *testing.T) {
+	testutil.AssertEqual(t, 20.0, niceStep(19))
+	testutil.AssertEqual(t, 2.5, niceStep(2.1))
+	testutil.AssertEqual(t, 0.5, niceStep(0.31))
+	testutil.AssertEqual(t, 10.0, niceStep(7))
+	testutil.AssertEqual(t, 0.0, niceStep(0))
+}
@@ -0,0 +1,291 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// RegressionBand is an enum for the band drawn around the line of a regression series.
+type RegressionBand int
+
+const (
+	// RegressionBandNone draws no band.
+	RegressionBandNone RegressionBand = 0
+	// RegressionBandConfidence draws the band the fitted line is in with the confidence level.
+	RegressionBandConfidence RegressionBand = 1
+	// RegressionBandPrediction draws the band new values are in with the confidence level.
+	RegressionBandPrediction RegressionBand = 2
+)
+
+// RegressionProvider is a regression fitted to the values of a series.
+type RegressionProvider interface {
+	LastValuesProvider
+
+	// GetCoefficients returns the coefficients of the fitted equation, in the order of the equation.
+	GetCoefficients() []float64
+	// GetRSquared returns the coefficient of determination of the fit.
+	GetRSquared() float64
+	// GetResiduals returns the differences between the values and the fitted values.
+	GetResiduals() []float64
+	// GetEquation returns the fitted equation.
+	GetEquation() string
+}
+
+// regression is a regression fitted to the values of a window of a series, with its band.
+type regression struct {
+	XValues []float64
+	YValues []float64
+	Fitted  []float64
+	Lower   []float64
+	Upper   []float64
+
+	Coefficients []float64
+}
+
+// Len returns the number of values.
+func (reg *regression) Len() int {
+	return len(reg.XValues)
+}
+
+// GetValues returns a value and its fitted value.
+func (reg *regression) GetValues(index int) (x, y float64) {
+	return reg.XValues[index], reg.Fitted[index]
+}
+
+// GetBoundedValues returns a value and the band at it.
+func (reg *regression) GetBoundedValues(index int) (x, y1, y2 float64) {
+	return reg.XValues[index], reg.Lower[index], reg.Upper[index]
+}
+
+// GetRSquared returns the coefficient of determination of the fitted values.
+func (reg *regression) GetRSquared() float64 {
+	return regressionRSquared(reg.YValues, reg.GetResiduals())
+}
+
+// GetResiduals returns the differences between the values and the fitted values.
+func (reg *regression) GetResiduals() []float64 {
+	residuals := make([]float64, len(reg.YValues))
+	for index, y := range reg.YValues {
+		residuals[index] = y - reg.Fitted[index]
+	}
+	return residuals
+}
+
+// GetFirstValues returns the first value and its fitted value.
+func (reg *regression) GetFirstValues() (x, y float64) {
+	if len(reg.XValues) == 0 {
+		return
+	}
+	return reg.GetValues(0)
+}
+
+// GetLastValues returns the last value and its fitted value.
+func (reg *regression) GetLastValues() (x, y float64) {
+	if len(reg.XValues) == 0 {
+		return
+	}
+	return reg.GetValues(len(reg.XValues) - 1)
+}
+
+// newLinearizedRegression fits a line to the values after transforming them, so y = a + b*x for the transformed
+// values, and transforms the fitted values and the band back with the inverse of the y-transform.
+//
+// The coefficients are the inverse transformed intercept and the slope.
+func newLinearizedRegression(xvalues, yvalues []float64, tx, ty, inverse func(float64) float64, band RegressionBand, confidence float64) *regression {
+	reg := &regression{
+		XValues: xvalues,
+		YValues: yvalues,
+		Fitted:  make([]float64, len(xvalues)),
+		Lower:   make([]float64, len(xvalues)),
+		Upper:   make([]float64, len(xvalues)),
+	}
+
+	txs, tys := make([]float64, len(xvalues)), make([]float64, len(yvalues))
+	for index := range xvalues {
+		txs[index], tys[index] = tx(xvalues[index]), ty(yvalues[index])
+	}
+	line := fitRegressionLine(txs, tys)
+	reg.Coefficients = []float64{inverse(line.Intercept), line.Slope}
+
+	var t float64
+	if band != RegressionBandNone && len(xvalues) > 2 {
+		t = studentTQuantile(1-(1-confidence)/2, float64(len(xvalues)-2))
+	}
+	for index, x := range txs {
+		fitted := line.Predict(x)
+		spread := t * line.StandardError(x, band == RegressionBandPrediction)
+		reg.Fitted[index] = inverse(fitted)
+		reg.Lower[index], reg.Upper[index] = inverse(fitted-spread), inverse(fitted+spread)
+	}
+	return reg
+}
+
+// regressionLine is a least squares line with what's needed for the standard errors of its values.
+type regressionLine struct {
+	Intercept float64
+	Slope     float64
+
+	Count int
+	MeanX float64
+	// SXX is the sum of the squared differences of the x-values from their mean.
+	SXX float64
+	// StdErr is the standard deviation of the residuals, with the two degrees of freedom of the line taken out.
+	StdErr float64
+}
+
+// fitRegressionLine fits a least squares line to the values.
+func fitRegressionLine(xvalues, yvalues []float64) regressionLine {
+	line := regressionLine{
+		Count: len(xvalues),
+		MeanX: ValueSequence(xvalues...).Average(),
+	}
+	meanY := ValueSequence(yvalues...).Average()
+
+	var sxy float64
+	for index, x := range xvalues {
+		line.SXX += (x - line.MeanX) * (x - line.MeanX)
+		sxy += (x - line.MeanX) * (yvalues[index] - meanY)
+	}
+	if line.SXX > 0 {
+		line.Slope = sxy / line.SXX
+	}
+	line.Intercept = meanY - line.Slope*line.MeanX
+
+	if line.Count > 2 {
+		var residuals float64
+		for index, x := range xvalues {
+			residual := yvalues[index] - line.Predict(x)
+			residuals += residual * residual
+		}
+		line.StdErr = math.Sqrt(residuals / float64(line.Count-2))
+	}
+	return line
+}
+
+// Predict returns the value of the line at x.
+func (rl regressionLine) Predict(x float64) float64 {
+	return rl.Intercept + rl.Slope*x
+}
+
+// StandardError returns the standard error of the value of the line at x or, for a prediction,
+// of a new value at x.
+func (rl regressionLine) StandardError(x float64, prediction bool) float64 {
+	if rl.Count == 0 {
+		return 0
+	}
+	variance := 1 / float64(rl.Count)
+	if rl.SXX > 0 {
+		variance += (x - rl.MeanX) * (x - rl.MeanX) / rl.SXX
+	}
+	if prediction {
+		variance++
+	}
+	return rl.StdErr * math.Sqrt(variance)
+}
+
+// normalQuantile returns the value the standard normal distribution is below with probability p,
+// using Acklam's rational approximation.
+func normalQuantile(p float64) float64 {
+	a := []float64{-3.969683028665376e+01, 2.209460984245205e+02, -2.759285104469687e+02, 1.383577518672690e+02, -3.066479806614716e+01, 2.506628277459239e+00}
+	b := []float64{-5.447609879822406e+01, 1.615858368580409e+02, -1.556989798598866e+02, 6.680131188771972e+01, -1.328068155288572e+01}
+	c := []float64{-7.784894002430293e-03, -3.223964580411365e-01, -2.400758277161838e+00, -2.549732539343734e+00, 4.374664141464968e+00, 2.938163982698783e+00}
+	d := []float64{7.784695709041462e-03, 3.224671290700398e-01, 2.445134137142996e+00, 3.754408661907416e+00}
+
+	const low = 0.02425
+	switch {
+	case p <= 0:
+		return math.Inf(-1)
+	case p >= 1:
+		return math.Inf(1)
+	case p < low:
+		q := math.Sqrt(-2 * math.Log(p))
+		return (((((c[0]*q+c[1])*q+c[2])*q+c[3])*q+c[4])*q + c[5]) / ((((d[0]*q+d[1])*q+d[2])*q+d[3])*q + 1)
+	case p > 1-low:
+		return -normalQuantile(1 - p)
+	default:
+		q := p - 0.5
+		r := q * q
+		return (((((a[0]*r+a[1])*r+a[2])*r+a[3])*r+a[4])*r + a[5]) * q / (((((b[0]*r+b[1])*r+b[2])*r+b[3])*r+b[4])*r + 1)
+	}
+}
+
+// studentTQuantile returns the value Student's t distribution with df degrees of freedom is below with
+// probability p; exactly for one and two degrees of freedom and with the Cornish-Fisher expansion otherwise.
+func studentTQuantile(p, df float64) float64 {
+	switch {
+	case df <= 1:
+		return math.Tan(_pi * (p - 0.5))
+	case df == 2:
+		return (2*p - 1) / math.Sqrt(2*p*(1-p))
+	}
+
+	z := normalQuantile(p)
+	z2 := z * z
+	g1 := (z2 + 1) * z / 4
+	g2 := ((5*z2+16)*z2 + 3) * z / 96
+	g3 := (((3*z2+19)*z2+17)*z2 - 15) * z / 384
+	g4 := ((((79*z2+776)*z2+1482)*z2-1920)*z2 - 945) * z / 92160
+	return z + g1/df + g2/(df*df) + g3/(df*df*df) + g4/(df*df*df*df)
+}
+
+// regressionRSquared returns the coefficient of determination of a fit; one less the ratio of the residual
+// sum of squares to the total sum of squares.
+func regressionRSquared(yvalues, residuals []float64) float64 {
+	mean := ValueSequence(yvalues...).Average()
+	var residual, total float64
+	for index, y := range yvalues {
+		residual += residuals[index] * residuals[index]
+		total += (y - mean) * (y - mean)
+	}
+	if total == 0 {
+		return 1
+	}
+	return 1 - residual/total
+}
+
+// regressionWindow returns the values of the window of a series starting at the offset with up to limit values,
+// or all of the remaining values if the limit is unset.
+func regressionWindow(innerSeries ValuesProvider, offset, limit int) (xvalues, yvalues []float64) {
+	end := innerSeries.Len()
+	if limit > 0 {
+		end = MinInt(offset+limit, end)
+	}
+	for index := offset; index < end; index++ {
+		x, y := innerSeries.GetValues(index)
+		xvalues = append(xvalues, x)
+		yvalues = append(yvalues, y)
+	}
+	return
+}
+
+// drawRegression draws the band of a regression, if any, and its line.
+func drawRegression(r Renderer, canvasBox Box, xrange, yrange Range, style, bandStyle Style, band RegressionBand, reg *regression) {
+	if reg.Len() == 0 {
+		return
+	}
+	if band != RegressionBandNone {
+		Draw.BoundedSeries(r, canvasBox, xrange, yrange, bandStyle.InheritFrom(Style{
+			FillColor: style.GetStrokeColor().WithAlpha(DefaultRegressionBandAlpha),
+		}), reg)
+	}
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, reg)
+}
+
+// regressionIdentity is the transform of values a regression fits as they are.
+func regressionIdentity(v float64) float64 {
+	return v
+}
+
+// formatRegressionCoefficient formats a coefficient of a fitted equation.
+func formatRegressionCoefficient(coefficient float64) string {
+	return strconv.FormatFloat(coefficient, 'g', 4, 64)
+}
+
+// formatRegressionTerm formats a term of a sum in a fitted equation with its sign, for example " - 2.5x".
+func formatRegressionTerm(coefficient float64, variable string) string {
+	if coefficient < 0 {
+		return fmt.Sprintf(" - %s%s", formatRegressionCoefficient(-coefficient), variable)
+	}
+	return fmt.Sprintf(" + %s%s", formatRegressionCoefficient(coefficient), variable)
+}
@@ -0,0 +1,41 @@
+package chart
+
+import "fmt"
+
+// RegressionAnnotationSeries returns an annotation series that labels the last fitted value of a regression
+// with the fitted equation and its coefficient of determination.
+func RegressionAnnotationSeries(rp RegressionProvider, vfs ...ValueFormatter) AnnotationSeries {
+	vf := ValueFormatter(func(v interface{}) string {
+		return fmt.Sprintf("%.3f", v)
+	})
+	if len(vfs) > 0 {
+		vf = vfs[0]
+	}
+
+	var seriesName string
+	var seriesStyle Style
+	if typed, isTyped := rp.(Series); isTyped {
+		seriesName = fmt.Sprintf("%s - Fit", typed.GetName())
+		seriesStyle = typed.GetStyle()
+	}
+	annotations := AnnotationSeries{
+		Name:  seriesName,
+		Style: seriesStyle,
+	}
+
+	// a regression with no values, or that can't be fitted to them, has nothing to label.
+	if len(rp.GetResiduals()) == 0 {
+		return annotations
+	}
+
+	label := fmt.Sprintf("R² = %s", vf(rp.GetRSquared()))
+	if equation := rp.GetEquation(); len(equation) > 0 {
+		label = fmt.Sprintf("%s, %s", equation, label)
+	}
+
+	var lastValue Value2
+	lastValue.XValue, lastValue.YValue = rp.GetLastValues()
+	lastValue.Label = label
+	annotations.Annotations = []Value2{lastValue}
+	return annotations
+}
@@ -0,0 +1,138 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestStudentTQuantile(t *testing.T) {
+	testutil.AssertInDelta(t, 1.96, normalQuantile(0.975), 0.0001)
+	testutil.AssertInDelta(t, -2.3263, normalQuantile(0.01), 0.0001)
+
+	testutil.AssertInDelta(t, 12.706, studentTQuantile(0.975, 1), 0.001)
+	testutil.AssertInDelta(t, 4.303, studentTQuantile(0.975, 2), 0.001)
+	testutil.AssertInDelta(t, 2.228, studentTQuantile(0.975, 10), 0.001)
+	testutil.AssertInDelta(t, 1.984, studentTQuantile(0.975, 100), 0.001)
+}
+
+func TestFitRegressionLine(t *testing.T) {
+	line := fitRegressionLine([]float64{1, 2, 3, 4}, []float64{3, 5, 7, 9})
+	testutil.AssertInDelta(t, 1.0, line.Intercept, 0.0001)
+	testutil.AssertInDelta(t, 2.0, line.Slope, 0.0001)
+	testutil.AssertInDelta(t, 0.0, line.StdErr, 0.0001)
+
+	// the standard error grows away from the mean, and more so for predictions.
+	line = fitRegressionLine([]float64{1, 2, 3, 4}, []float64{3, 6, 6, 9})
+	testutil.AssertTrue(t, line.StandardError(2.5, false) < line.StandardError(4, false))
+	testutil.AssertTrue(t, line.StandardError(4, false) < line.StandardError(4, true))
+}
+
+func testRegressionInnerSeries(f func(x float64) float64) ContinuousSeries {
+	var xvalues, yvalues []float64
+	for x := 1.0; x <= 10; x++ {
+		xvalues = append(xvalues, x)
+		yvalues = append(yvalues, f(x))
+	}
+	return ContinuousSeries{XValues: xvalues, YValues: yvalues}
+}
+
+func TestLinearizedRegressionSeries(t *testing.T) {
+	exponential := &ExponentialRegressionSeries{
+		InnerSeries: testRegressionInnerSeries(func(x float64) float64 { return 2 * math.Exp(0.5*x) }),
+	}
+	logarithmic := &LogarithmicRegressionSeries{
+		InnerSeries: testRegressionInnerSeries(func(x float64) float64 { return 3 - 2*math.Log(x) }),
+	}
+	power := &PowerRegressionSeries{
+		InnerSeries: testRegressionInnerSeries(func(x float64) float64 { return 4 * math.Pow(x, 1.5) }),
+	}
+
+	for _, test := range []struct {
+		Series       RegressionProvider
+		Coefficients []float64
+		Equation     string
+	}{
+		{exponential, []float64{2, 0.5}, "y = 2e^(0.5x)"},
+		{logarithmic, []float64{3, -2}, "y = 3 - 2 ln(x)"},
+		{power, []float64{4, 1.5}, "y = 4x^1.5"},
+	} {
+		coefficients := test.Series.GetCoefficients()
+		testutil.AssertLen(t, coefficients, 2)
+		testutil.AssertInDelta(t, test.Coefficients[0], coefficients[0], 0.0001)
+		testutil.AssertInDelta(t, test.Coefficients[1], coefficients[1], 0.0001)
+		testutil.AssertInDelta(t, 1.0, test.Series.GetRSquared(), 0.0001)
+		testutil.AssertEqual(t, test.Equation, test.Series.GetEquation())
+		for _, residual := range test.Series.GetResiduals() {
+			testutil.AssertInDelta(t, 0.0, residual, 0.0001)
+		}
+	}
+}
+
+func TestLinearizedRegressionSeriesBand(t *testing.T) {
+	inner := testRegressionInnerSeries(func(x float64) float64 { return math.Exp(0.3*x) * (1 + 0.1*math.Sin(x)) })
+	confidence := &ExponentialRegressionSeries{InnerSeries: inner, Band: RegressionBandConfidence}
+	prediction := &ExponentialRegressionSeries{InnerSeries: inner, Band: RegressionBandPrediction}
+
+	testutil.AssertEqual(t, 10, confidence.Len())
+	for index := 0; index < confidence.Len(); index++ {
+		x, y := confidence.GetValues(index)
+		_, cy1, cy2 := confidence.GetBoundedValues(index)
+		_, py1, py2 := prediction.GetBoundedValues(index)
+		testutil.AssertEqual(t, float64(index+1), x)
+		testutil.AssertTrue(t, py1 < cy1 && cy1 < y && y < cy2 && cy2 < py2)
+	}
+
+	// without a band the bounds are the fitted values.
+	none := &ExponentialRegressionSeries{InnerSeries: inner, Limit: 5, Offset: 2}
+	testutil.AssertEqual(t, 5, none.Len())
+	x, y1, y2 := none.GetBoundedValues(0)
+	testutil.AssertEqual(t, 3.0, x)
+	testutil.AssertEqual(t, y1, y2)
+}
+
+func TestLinearizedRegressionSeriesRender(t *testing.T) {
+	inner := testRegressionInnerSeries(func(x float64) float64 { return x * x })
+	exponential := &ExponentialRegressionSeries{InnerSeries: inner, Band: RegressionBandConfidence}
+	graph := Chart{
+		Series: []Series{
+			inner,
+			exponential,
+			&LogarithmicRegressionSeries{InnerSeries: inner, Band: RegressionBandPrediction},
+			&PowerRegressionSeries{InnerSeries: inner},
+			RegressionAnnotationSeries(exponential),
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	testutil.AssertNil(t, graph.Render(PNG, b))
+	testutil.AssertNotZero(t, b.Len())
+
+	b.Reset()
+	testutil.AssertNil(t, graph.Render(SVG, b))
+	testutil.AssertNotZero(t, b.Len())
+}
+
+func TestLinearizedRegressionSeriesValidate(t *testing.T) {
+	testutil.AssertNotNil(t, (&ExponentialRegressionSeries{}).Validate())
+
+	inner := testRegressionInnerSeries(func(x float64) float64 { return x - 5 })
+	testutil.AssertNotNil(t, (&ExponentialRegressionSeries{InnerSeries: inner}).Validate())
+	testutil.AssertNil(t, (&LogarithmicRegressionSeries{InnerSeries: inner}).Validate())
+	testutil.AssertNotNil(t, (&PowerRegressionSeries{InnerSeries: inner}).Validate())
+	testutil.AssertNil(t, (&PowerRegressionSeries{InnerSeries: inner, Offset: 5}).Validate())
+}
+
+func TestRegressionAnnotationSeries(t *testing.T) {
+	series := &PowerRegressionSeries{
+		Name:        "Fit",
+		InnerSeries: testRegressionInnerSeries(func(x float64) float64 { return 4 * math.Pow(x, 1.5) }),
+	}
+	as := RegressionAnnotationSeries(series)
+	testutil.AssertEqual(t, "Fit - Fit", as.Name)
+	testutil.AssertLen(t, as.Annotations, 1)
+	testutil.AssertEqual(t, 10.0, as.Annotations[0].XValue)
+	testutil.AssertEqual(t, "y = 4x^1.5, R² = 1.000", as.Annotations[0].Label)
+}
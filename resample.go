@@ -0,0 +1,221 @@
+package chart
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// ResampleInterval is the length of the intervals values are bucketed into by time; a fixed duration or a number
+// of calendar months.
+//
+// Intervals of whole days are calendar days and intervals shorter than a day are counted from midnight, both in
+// the location of the times, so buckets start on the hour and at midnight whatever the offset of the location.
+// Other intervals longer than a day are counted from the zero time, as `time.Time.Truncate` does.
+type ResampleInterval struct {
+	Duration time.Duration
+	Months   int
+}
+
+// Resample intervals.
+var (
+	// ResampleMinute buckets values by the minute.
+	ResampleMinute = ResampleInterval{Duration: time.Minute}
+	// ResampleFiveMinutes buckets values by five minutes.
+	ResampleFiveMinutes = ResampleInterval{Duration: 5 * time.Minute}
+	// ResampleHour buckets values by the hour.
+	ResampleHour = ResampleInterval{Duration: time.Hour}
+	// ResampleDay buckets values by the calendar day.
+	ResampleDay = ResampleInterval{Duration: 24 * time.Hour}
+	// ResampleMonth buckets values by the calendar month.
+	ResampleMonth = ResampleInterval{Months: 1}
+)
+
+// IsZero returns if the interval has neither a duration nor months.
+func (ri ResampleInterval) IsZero() bool {
+	return ri.Duration <= 0 && ri.Months <= 0
+}
+
+// Truncate returns the start of the interval a time is in.
+func (ri ResampleInterval) Truncate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	if ri.Months > 0 {
+		months := year*12 + int(month) - 1
+		months -= modInt(months, ri.Months)
+		return time.Date(months/12, time.Month(months%12+1), 1, 0, 0, 0, 0, t.Location())
+	}
+	if days := ri.days(); days > 0 {
+		// days are counted from the unix epoch so the intervals don't depend on where the values start.
+		epochDays := int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / SecondsPerDay)
+		return time.Date(year, month, day-modInt(epochDays, days), 0, 0, 0, 0, t.Location())
+	}
+	if ri.Duration >= 24*time.Hour {
+		return t.Truncate(ri.Duration)
+	}
+	midnight := time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	return midnight.Add(t.Sub(midnight).Truncate(ri.Duration))
+}
+
+// next returns the start of the interval after the one starting at a time.
+func (ri ResampleInterval) next(start time.Time) time.Time {
+	if ri.Months > 0 {
+		return start.AddDate(0, ri.Months, 0)
+	}
+	if days := ri.days(); days > 0 {
+		return start.AddDate(0, 0, days)
+	}
+	return ri.Truncate(start.Add(ri.Duration))
+}
+
+// days returns the number of calendar days in the interval, or zero if it isn't whole days.
+func (ri ResampleInterval) days() int {
+	const day = 24 * time.Hour
+	if ri.Duration < day || ri.Duration%day != 0 {
+		return 0
+	}
+	return int(ri.Duration / day)
+}
+
+// modInt returns the non-negative remainder of a division.
+func modInt(a, b int) int {
+	return ((a % b) + b) % b
+}
+
+// ResampleAggregator combines the values that fall into an interval into the value of the interval.
+// The values are in the order of their times and there is at least one.
+type ResampleAggregator func(values []float64) float64
+
+// ResampleMean is a resample aggregator that returns the mean of the values.
+func ResampleMean(values []float64) float64 {
+	return ValueSequence(values...).Average()
+}
+
+// ResampleSum is a resample aggregator that returns the sum of the values.
+func ResampleSum(values []float64) float64 {
+	return ValueSequence(values...).Sum()
+}
+
+// ResampleMin is a resample aggregator that returns the smallest value.
+func ResampleMin(values []float64) float64 {
+	return ValueSequence(values...).Min()
+}
+
+// ResampleMax is a resample aggregator that returns the largest value.
+func ResampleMax(values []float64) float64 {
+	return ValueSequence(values...).Max()
+}
+
+// ResampleCount is a resample aggregator that returns the number of values.
+func ResampleCount(values []float64) float64 {
+	return float64(len(values))
+}
+
+// ResampleMedian is a resample aggregator that returns the median of the values.
+func ResampleMedian(values []float64) float64 {
+	return ValueSequence(values...).Median()
+}
+
+// ResampleP95 is a resample aggregator that returns the 95th percentile of the values.
+func ResampleP95(values []float64) float64 {
+	return ResamplePercentile(0.95)(values)
+}
+
+// ResampleLast is a resample aggregator that returns the latest value.
+func ResampleLast(values []float64) float64 {
+	return values[len(values)-1]
+}
+
+// ResamplePercentile returns a resample aggregator that returns a percentile of the values, on the interval
+// [0, 1.0], interpolating between the values either side of it.
+func ResamplePercentile(percent float64) ResampleAggregator {
+	return func(values []float64) float64 {
+		return interpolatedPercentile(ValueSequence(values...).Sort().Values(), percent)
+	}
+}
+
+// ResampleFill is an enum for the value of the intervals no values fall into.
+type ResampleFill int
+
+const (
+	// ResampleFillNone leaves empty intervals as NaN, which lines break around.
+	ResampleFillNone ResampleFill = 0
+	// ResampleFillZero fills empty intervals with zero.
+	ResampleFillZero ResampleFill = 1
+	// ResampleFillForward fills empty intervals with the value of the interval before them.
+	ResampleFillForward ResampleFill = 2
+	// ResampleFillLinear fills empty intervals with the values on the line between the intervals around them.
+	ResampleFillLinear ResampleFill = 3
+)
+
+// Resample buckets values by time into intervals and combines the values of each interval with an aggregator,
+// the mean if it's nil. It returns the start of each interval from the one the earliest value is in to the one
+// the latest value is in, and the value of each, filling the intervals no values fall into by the fill.
+//
+// Values of NaN are left out; the values don't have to be in order.
+func Resample(xvalues []time.Time, yvalues []float64, interval ResampleInterval, aggregator ResampleAggregator, fill ResampleFill) ([]time.Time, []float64) {
+	if interval.IsZero() {
+		return nil, nil
+	}
+	if aggregator == nil {
+		aggregator = ResampleMean
+	}
+
+	var indexes []int
+	for index := range xvalues {
+		if index < len(yvalues) && !math.IsNaN(yvalues[index]) {
+			indexes = append(indexes, index)
+		}
+	}
+	if len(indexes) == 0 {
+		return nil, nil
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return xvalues[indexes[i]].Before(xvalues[indexes[j]])
+	})
+
+	var times []time.Time
+	var values []float64
+	var bucket []float64
+	next := 0
+	for start := interval.Truncate(xvalues[indexes[0]]); next < len(indexes); start = interval.next(start) {
+		end := interval.next(start)
+		bucket = bucket[:0]
+		for ; next < len(indexes) && xvalues[indexes[next]].Before(end); next++ {
+			bucket = append(bucket, yvalues[indexes[next]])
+		}
+		times = append(times, start)
+		if len(bucket) == 0 {
+			values = append(values, math.NaN())
+		} else {
+			values = append(values, aggregator(bucket))
+		}
+	}
+
+	resampleFill(times, values, fill)
+	return times, values
+}
+
+// resampleFill fills the empty intervals of resampled values; the first and last intervals are never empty.
+func resampleFill(times []time.Time, values []float64, fill ResampleFill) {
+	previous := 0
+	for index, value := range values {
+		if !math.IsNaN(value) {
+			previous = index
+			continue
+		}
+		switch fill {
+		case ResampleFillZero:
+			values[index] = 0
+		case ResampleFillForward:
+			values[index] = values[previous]
+		case ResampleFillLinear:
+			following := index + 1
+			for math.IsNaN(values[following]) {
+				following++
+			}
+			t0, t1 := TimeToFloat64(times[previous]), TimeToFloat64(times[following])
+			ratio := (TimeToFloat64(times[index]) - t0) / (t1 - t0)
+			values[index] = values[previous] + ratio*(values[following]-values[previous])
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestResampleIntervalTruncate(t *testing.T) {
+	ts := time.Date(2024, 5, 17, 13, 47, 31, 0, time.UTC)
+	testutil.AssertEqual(t, time.Date(2024, 5, 17, 13, 47, 0, 0, time.UTC), ResampleMinute.Truncate(ts))
+	testutil.AssertEqual(t, time.Date(2024, 5, 17, 13, 45, 0, 0, time.UTC), ResampleFiveMinutes.Truncate(ts))
+	testutil.AssertEqual(t, time.Date(2024, 5, 17, 13, 0, 0, 0, time.UTC), ResampleHour.Truncate(ts))
+	testutil.AssertEqual(t, time.Date(2024, 5, 17, 0, 0, 0, 0, time.UTC), ResampleDay.Truncate(ts))
+	testutil.AssertEqual(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), ResampleMonth.Truncate(ts))
+	testutil.AssertEqual(t, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), ResampleInterval{Months: 3}.Truncate(ts))
+
+	// intervals are on the hour and at midnight in the location of the time.
+	ist := time.FixedZone("IST", 5*SecondsPerHour+30*60)
+	local := time.Date(2024, 5, 17, 13, 47, 31, 0, ist)
+	testutil.AssertEqual(t, time.Date(2024, 5, 17, 13, 0, 0, 0, ist), ResampleHour.Truncate(local))
+	testutil.AssertEqual(t, time.Date(2024, 5, 17, 0, 0, 0, 0, ist), ResampleDay.Truncate(local))
+}
+
+func TestResampleIntervalLongerThanADay(t *testing.T) {
+	interval := ResampleInterval{Duration: 36 * time.Hour}
+	start := time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC)
+	xvalues := []time.Time{start, start.Add(36 * time.Hour), start.Add(72 * time.Hour)}
+
+	// the intervals are counted from the zero time rather than from midnight, so they are 36 hours long.
+	times, values := Resample(xvalues, []float64{1, 2, 3}, interval, nil, ResampleFillNone)
+	testutil.AssertLen(t, times, 3)
+	testutil.AssertEqual(t, []float64{1, 2, 3}, values)
+	for index, ts := range times {
+		testutil.AssertEqual(t, xvalues[index].Truncate(36*time.Hour), ts)
+		if index > 0 {
+			testutil.AssertEqual(t, 36*time.Hour, ts.Sub(times[index-1]))
+		}
+	}
+}
+
+func TestResample(t *testing.T) {
+	start := time.Date(2024, 5, 17, 10, 0, 0, 0, time.UTC)
+	xvalues := []time.Time{
+		start.Add(21 * time.Minute),
+		start.Add(3 * time.Minute),
+		start.Add(1 * time.Minute),
+		start.Add(7 * time.Minute),
+		start.Add(8 * time.Minute),
+	}
+	yvalues := []float64{7, 3, 1, 5, math.NaN()}
+
+	times, values := Resample(xvalues, yvalues, ResampleFiveMinutes, nil, ResampleFillNone)
+	testutil.AssertLen(t, times, 5)
+	testutil.AssertEqual(t, time.Date(2024, 5, 17, 10, 0, 0, 0, time.UTC), times[0])
+	testutil.AssertEqual(t, time.Date(2024, 5, 17, 10, 20, 0, 0, time.UTC), times[4])
+	testutil.AssertEqual(t, 2.0, values[0])
+	testutil.AssertEqual(t, 5.0, values[1])
+	testutil.AssertTrue(t, math.IsNaN(values[2]))
+	testutil.AssertTrue(t, math.IsNaN(values[3]))
+	testutil.AssertEqual(t, 7.0, values[4])
+
+	_, values = Resample(xvalues, yvalues, ResampleFiveMinutes, nil, ResampleFillZero)
+	testutil.AssertEqual(t, []float64{2, 5, 0, 0, 7}, values)
+
+	_, values = Resample(xvalues, yvalues, ResampleFiveMinutes, nil, ResampleFillForward)
+	testutil.AssertEqual(t, []float64{2, 5, 5, 5, 7}, values)
+
+	_, values = Resample(xvalues, yvalues, ResampleFiveMinutes, nil, ResampleFillLinear)
+	testutil.AssertInDelta(t, 5.6666667, values[2], 0.0000001)
+	testutil.AssertInDelta(t, 6.3333333, values[3], 0.0000001)
+
+	times, values = Resample(nil, nil, ResampleHour, nil, ResampleFillNone)
+	testutil.AssertEmpty(t, times)
+	testutil.AssertEmpty(t, values)
+}
+
+func TestResampleAggregators(t *testing.T) {
+	start := time.Date(2024, 5, 17, 10, 0, 0, 0, time.UTC)
+	xvalues := []time.Time{
+		start.Add(21 * time.Minute),
+		start.Add(3 * time.Minute),
+		start.Add(1 * time.Minute),
+		start.Add(7 * time.Minute),
+		start.Add(8 * time.Minute),
+	}
+	yvalues := []float64{7, 3, 1, 5, math.NaN()}
+
+	for _, test := range []struct {
+		Aggregator ResampleAggregator
+		Expected   float64
+	}{
+		{ResampleMean, 4},
+		{ResampleSum, 16},
+		{ResampleMin, 1},
+		{ResampleMax, 7},
+		{ResampleCount, 4},
+		{ResampleMedian, 4},
+		{ResampleP95, 6.7},
+		{ResampleLast, 7},
+	} {
+		_, values := Resample(xvalues, yvalues, ResampleHour, test.Aggregator, ResampleFillNone)
+		testutil.AssertLen(t, values, 1)
+		testutil.AssertInDelta(t, test.Expected, values[0], 0.0000001)
+	}
+
+	// the values are in the order of their times.
+	_, values := Resample(xvalues, yvalues, ResampleFiveMinutes, ResampleLast, ResampleFillNone)
+	testutil.AssertEqual(t, 3.0, values[0])
+}
+
+func TestResampleMonths(t *testing.T) {
+	xvalues := []time.Time{
+		time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+	times, values := Resample(xvalues, []float64{1, 2, 4}, ResampleMonth, ResampleSum, ResampleFillLinear)
+	testutil.AssertLen(t, times, 4)
+	testutil.AssertEqual(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), times[2])
+	testutil.AssertEqual(t, 2.0, values[1])
+	testutil.AssertTrue(t, values[2] > 2 && values[2] < 4)
+}
+
+func TestTimeSeriesResample(t *testing.T) {
+	start := time.Date(2024, 5, 17, 10, 0, 0, 0, time.UTC)
+	xvalues := []time.Time{
+		start.Add(21 * time.Minute),
+		start.Add(3 * time.Minute),
+		start.Add(1 * time.Minute),
+		start.Add(7 * time.Minute),
+		start.Add(8 * time.Minute),
+	}
+	yvalues := []float64{7, 3, 1, 5, math.NaN()}
+	ts := TimeSeries{Name: "Test", XValues: xvalues, YValues: yvalues}.Resample(ResampleFiveMinutes, ResampleMax, ResampleFillNone)
+	testutil.AssertEqual(t, "Test", ts.Name)
+	testutil.AssertLen(t, ts.XValues, 5)
+
+	// the missing intervals break the line and are left out of the ranges.
+	graph := Chart{
+		Series: []Series{ts},
+	}
+	_, yrange, _ := graph.getRanges()
+	testutil.AssertEqual(t, 3.0, yrange.GetMin())
+	testutil.AssertEqual(t, 7.0, yrange.GetMax())
+
+	xrange, _, _ := graph.getRanges()
+	testutil.AssertEqual(t, TimeToFloat64(start), xrange.GetMin())
+	testutil.AssertEqual(t, TimeToFloat64(start.Add(20*time.Minute)), xrange.GetMax())
+	testutil.AssertNil(t, graph.Render(PNG, bytes.NewBuffer([]byte{})))
+}
+
+func TestHoursFilled(t *testing.T) {
+	start := time.Date(2024, 5, 17, 10, 0, 0, 0, time.UTC)
+	xvalues := []time.Time{start.Add(3 * time.Hour), start, start.Add(3*time.Hour + 30*time.Minute)}
+
+	times, values := HoursFilled(xvalues, []float64{2, 1, 3})
+	testutil.AssertLen(t, times, 4)
+	testutil.AssertEqual(t, start, times[0])
+	testutil.AssertEqual(t, start.Add(3*time.Hour), times[3])
+	testutil.AssertEqual(t, []float64{1, 0, 0, 3}, values)
+}
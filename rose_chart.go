@@ -0,0 +1,400 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/golang/freetype/truetype"
+)
+
+// RoseChart is a polar area chart, also called a Nightingale rose; each value is a wedge of the same angle
+// whose area is proportional to the value, such as wind directions or the hours of the day.
+type RoseChart struct {
+	Title      string
+	TitleStyle Style
+
+	ColorPalette ColorPalette
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+	SliceStyle Style
+
+	// StartAngle is the angle in degrees, clockwise from twelve o'clock, of the middle of the first wedge.
+	StartAngle float64
+	// LinearRadius makes the radius of each wedge proportional to its value, instead of its area.
+	LinearRadius bool
+
+	// GridRings is the number of grid rings, each of which gets a label formatted with the `ValueFormatter`.
+	GridRings int
+	GridStyle Style
+
+	ValueFormatter ValueFormatter
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	Values   []Value
+	Elements []Renderable
+}
+
+// GetDPI returns the dpi for the chart.
+func (rc RoseChart) GetDPI(defaults ...float64) float64 {
+	if rc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return rc.DPI
+}
+
+// GetFont returns the text font.
+func (rc RoseChart) GetFont() *truetype.Font {
+	if rc.Font == nil {
+		return rc.defaultFont
+	}
+	return rc.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (rc RoseChart) GetWidth() int {
+	if rc.Width == 0 {
+		return DefaultChartWidth
+	}
+	return rc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (rc RoseChart) GetHeight() int {
+	if rc.Height == 0 {
+		return DefaultChartHeight
+	}
+	return rc.Height
+}
+
+// GetGridRings returns the number of grid rings or the default.
+func (rc RoseChart) GetGridRings() int {
+	if rc.GridRings <= 0 {
+		return DefaultRoseGridRings
+	}
+	return rc.GridRings
+}
+
+// GetValueFormatter returns the value formatter for the grid ring labels or the default.
+func (rc RoseChart) GetValueFormatter() ValueFormatter {
+	if rc.ValueFormatter == nil {
+		return FloatValueFormatter
+	}
+	return rc.ValueFormatter
+}
+
+// Validate validates the chart.
+func (rc RoseChart) Validate() error {
+	if len(rc.Values) == 0 {
+		return errors.New("please provide at least one value")
+	}
+	for _, v := range rc.Values {
+		if v.Value < 0 {
+			return fmt.Errorf("rose chart value %q cannot be negative: %v", v.Label, v.Value)
+		}
+	}
+	return nil
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (rc RoseChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := rc.Validate(); err != nil {
+		return err
+	}
+
+	r, err := rp(rc.GetWidth(), rc.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if rc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		rc.defaultFont = defaultFont
+	}
+	r.SetDPI(rc.GetDPI(DefaultDPI))
+
+	canvasBox := rc.getCanvasBox(r)
+	rc.drawBackground(r)
+	rc.drawCanvas(r, canvasBox)
+
+	cx, cy := canvasBox.Center()
+	radius := rc.getRadius(r, canvasBox)
+	max, step := rc.getScale()
+
+	rc.drawGrid(r, cx, cy, radius, max, step)
+	rc.drawWedges(r, cx, cy, radius, max)
+	rc.drawGridLabels(r, cx, cy, radius, max, step)
+	rc.drawLabels(r, cx, cy, radius)
+	rc.drawTitle(r)
+	for _, a := range rc.Elements {
+		a(r, canvasBox, rc.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+func (rc RoseChart) drawBackground(r Renderer) {
+	Draw.Box(r, Box{
+		Right:  rc.GetWidth(),
+		Bottom: rc.GetHeight(),
+	}, rc.getBackgroundStyle())
+}
+
+func (rc RoseChart) drawCanvas(r Renderer, canvasBox Box) {
+	Draw.Box(r, canvasBox, rc.getCanvasStyle())
+}
+
+func (rc RoseChart) drawTitle(r Renderer) {
+	if len(rc.Title) > 0 && !rc.TitleStyle.Hidden {
+		Draw.TextWithin(r, rc.Title, rc.Box(), rc.styleDefaultsTitle())
+	}
+}
+
+func (rc RoseChart) drawGrid(r Renderer, cx, cy int, radius, max, step float64) {
+	style := rc.getGridStyle()
+	if style.Hidden {
+		return
+	}
+	for ring := 1; ring <= int(math.Round(max/step)); ring++ {
+		value := step * float64(ring)
+		Draw.AnnularSector(r, cx, cy, 0, rc.getValueRadius(value, max, radius), 0, _2pi, style)
+	}
+	style.GetStrokeOptions().WriteToRenderer(r)
+	for index := range rc.Values {
+		x, y := CirclePoint(cx, cy, radius, rc.getWedgeAngle(index)-rc.getWedgeDelta()/2.0)
+		r.MoveTo(cx, cy)
+		r.LineTo(x, y)
+		r.Stroke()
+	}
+	r.ResetStyle()
+}
+
+func (rc RoseChart) drawWedges(r Renderer, cx, cy int, radius, max float64) {
+	delta := rc.getWedgeDelta()
+	for index, v := range rc.Values {
+		style := rc.getWedgeStyle(index)
+		if style.Hidden || v.Value == 0 {
+			continue
+		}
+		// the renderer measures angles from three o'clock.
+		start := rc.getWedgeAngle(index) - delta/2.0 - _pi2
+		Draw.AnnularSector(r, cx, cy, 0, rc.getValueRadius(v.Value, max, radius), start, delta, style)
+	}
+}
+
+// drawGridLabels labels each grid ring along the edge of the first wedge.
+func (rc RoseChart) drawGridLabels(r Renderer, cx, cy int, radius, max, step float64) {
+	style := rc.getGridLabelStyle()
+	if style.Hidden {
+		return
+	}
+	vf := rc.GetValueFormatter()
+	angle := rc.getWedgeAngle(0) - rc.getWedgeDelta()/2.0
+	for ring := 1; ring <= int(math.Round(max/step)); ring++ {
+		value := step * float64(ring)
+		x, y := CirclePoint(cx, cy, rc.getValueRadius(value, max, radius), angle)
+		Draw.Text(r, vf(value), x+DefaultPolarLabelPadding>>1, y-DefaultPolarLabelPadding>>1, style)
+	}
+}
+
+// drawLabels draws the label of each wedge outside the circle.
+func (rc RoseChart) drawLabels(r Renderer, cx, cy int, radius float64) {
+	for index, v := range rc.Values {
+		style := rc.getWedgeStyle(index)
+		if v.Label == "" || style.Hidden {
+			continue
+		}
+		labelStyle := Style{
+			FontColor: style.GetFontColor(),
+			FontSize:  style.GetFontSize(),
+			Font:      style.GetFont(),
+		}
+		tb := Draw.MeasureText(r, v.Label, labelStyle)
+		lx, ly := polarLabelPosition(cx, cy, radius+DefaultPolarLabelPadding, rc.getWedgeAngle(index), tb)
+		Draw.Text(r, v.Label, lx, ly, labelStyle)
+	}
+}
+
+// getWedgeAngle returns the angle in radians of the middle of a wedge, clockwise from twelve o'clock.
+func (rc RoseChart) getWedgeAngle(index int) float64 {
+	return DegreesToRadians(rc.StartAngle) + rc.getWedgeDelta()*float64(index)
+}
+
+// getWedgeDelta returns the angle in radians each wedge spans.
+func (rc RoseChart) getWedgeDelta() float64 {
+	return _2pi / float64(len(rc.Values))
+}
+
+// getValueRadius returns the radius of a wedge for a value given the value at the edge of the chart.
+func (rc RoseChart) getValueRadius(value, max, radius float64) float64 {
+	if max <= 0 {
+		return 0
+	}
+	if rc.LinearRadius {
+		return radius * value / max
+	}
+	return radius * math.Sqrt(value/max)
+}
+
+// getScale returns the value at the edge of the chart, rounded out so each grid ring falls on a round value,
+// and the step between the grid rings.
+func (rc RoseChart) getScale() (max, step float64) {
+	for _, v := range rc.Values {
+		max = math.Max(max, v.Value)
+	}
+	if max == 0 {
+		max = 1
+	}
+	rings := float64(rc.GetGridRings())
+	step = niceStep(max / rings)
+	scale := step * rings
+	for scale < max {
+		scale += step
+	}
+	return scale, step
+}
+
+// getRadius returns the largest radius that leaves room for the wedge labels within the canvas.
+func (rc RoseChart) getRadius(r Renderer, canvasBox Box) float64 {
+	var labelWidth, labelHeight int
+	for index, v := range rc.Values {
+		style := rc.getWedgeStyle(index)
+		if v.Label != "" && !style.Hidden {
+			tb := Draw.MeasureText(r, v.Label, style)
+			labelWidth = MaxInt(labelWidth, tb.Width())
+			labelHeight = MaxInt(labelHeight, tb.Height())
+		}
+	}
+	horizontal := canvasBox.Width()>>1 - labelWidth - DefaultPolarLabelPadding
+	vertical := canvasBox.Height()>>1 - labelHeight - DefaultPolarLabelPadding
+	return math.Max(float64(MinInt(horizontal, vertical)), 0)
+}
+
+func (rc RoseChart) getCanvasBox(r Renderer) Box {
+	canvasBox := rc.Box()
+	if len(rc.Title) > 0 && !rc.TitleStyle.Hidden {
+		titleBox := Draw.MeasureText(r, rc.Title, rc.styleDefaultsTitle())
+		canvasBox.Top += titleBox.Height() + DefaultTitleTop
+	}
+	return canvasBox
+}
+
+func (rc RoseChart) getWedgeStyle(index int) Style {
+	return rc.Values[index].Style.InheritFrom(rc.SliceStyle.InheritFrom(rc.styleDefaultsWedge(index)))
+}
+
+func (rc RoseChart) styleDefaultsWedge(index int) Style {
+	return Style{
+		StrokeColor: rc.GetColorPalette().BackgroundColor(),
+		StrokeWidth: DefaultAxisLineWidth,
+		FillColor:   rc.GetColorPalette().GetSeriesColor(index),
+		FontColor:   rc.GetColorPalette().TextColor(),
+		FontSize:    DefaultFontSize,
+		Font:        rc.GetFont(),
+	}
+}
+
+func (rc RoseChart) getGridStyle() Style {
+	return rc.GridStyle.InheritFrom(Style{
+		StrokeColor: rc.GetColorPalette().AxisStrokeColor().WithAlpha(64),
+		StrokeWidth: DefaultAxisLineWidth,
+	})
+}
+
+func (rc RoseChart) getGridLabelStyle() Style {
+	return rc.GridStyle.InheritFrom(Style{
+		FontColor: rc.GetColorPalette().TextColor(),
+		FontSize:  DefaultAxisFontSize,
+		Font:      rc.GetFont(),
+	})
+}
+
+func (rc RoseChart) getBackgroundStyle() Style {
+	return rc.Background.InheritFrom(rc.styleDefaultsBackground())
+}
+
+func (rc RoseChart) getCanvasStyle() Style {
+	return rc.Canvas.InheritFrom(rc.styleDefaultsCanvas())
+}
+
+func (rc RoseChart) styleDefaultsCanvas() Style {
+	return Style{
+		FillColor:   rc.GetColorPalette().CanvasColor(),
+		StrokeColor: rc.GetColorPalette().CanvasStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (rc RoseChart) styleDefaultsBackground() Style {
+	return Style{
+		FillColor:   rc.GetColorPalette().BackgroundColor(),
+		StrokeColor: rc.GetColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (rc RoseChart) styleDefaultsElements() Style {
+	return Style{
+		Font: rc.GetFont(),
+	}
+}
+
+func (rc RoseChart) styleDefaultsTitle() Style {
+	return rc.TitleStyle.InheritFrom(Style{
+		FontColor:           rc.GetColorPalette().TextColor(),
+		Font:                rc.GetFont(),
+		FontSize:            rc.getTitleFontSize(),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+func (rc RoseChart) getTitleFontSize() float64 {
+	effectiveDimension := MinInt(rc.GetWidth(), rc.GetHeight())
+	if effectiveDimension >= 2048 {
+		return 48
+	} else if effectiveDimension >= 1024 {
+		return 24
+	} else if effectiveDimension >= 512 {
+		return 18
+	} else if effectiveDimension >= 256 {
+		return 12
+	}
+	return 10
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (rc RoseChart) GetColorPalette() ColorPalette {
+	if rc.ColorPalette != nil {
+		return rc.ColorPalette
+	}
+	return DefaultColorPalette
+}
+
+// Box returns the chart bounds as a box.
+func (rc RoseChart) Box() Box {
+	dpr := rc.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
+	dpb := rc.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
+
+	return Box{
+		Top:    rc.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
+		Left:   rc.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
+		Right:  rc.GetWidth() - dpr,
+		Bottom: rc.GetHeight() - dpb,
+	}
+}
@@ -0,0 +1,96 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestRoseChart(t *testing.T) {
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	rc := RoseChart{
+		Title: "Test Title",
+		Font:  f,
+		Values: []Value{
+			{Label: "N", Value: 10},
+			{Label: "E", Value: 25},
+			{Label: "S", Value: 40},
+			{Label: "W", Value: 0},
+		},
+	}
+	testutil.AssertNil(t, rc.Render(PNG, bytes.NewBuffer([]byte{})))
+	testutil.AssertNil(t, rc.Render(SVG, bytes.NewBuffer([]byte{})))
+
+	r, err := PNG(rc.GetWidth(), rc.GetHeight())
+	testutil.AssertNil(t, err)
+	canvasBox := rc.getCanvasBox(r)
+	radius := rc.getRadius(r, canvasBox)
+	testutil.AssertTrue(t, radius > 0 && 2*radius < float64(canvasBox.Height()))
+
+	// the largest wedge reaches the edge of the chart and the empty one has no area.
+	max, _ := rc.getScale()
+	testutil.AssertEqual(t, radius, rc.getValueRadius(rc.Values[2].Value, max, radius))
+	testutil.AssertEqual(t, 0.0, rc.getValueRadius(rc.Values[3].Value, max, radius))
+	testutil.AssertEqual(t, _pi2, rc.getWedgeDelta())
+}
+
+func TestRoseChartValidate(t *testing.T) {
+	rc := RoseChart{
+		Values: []Value{
+			{Label: "N", Value: 10},
+			{Label: "E", Value: 25},
+		},
+	}
+	testutil.AssertNil(t, rc.Validate())
+
+	rc.Values[1].Value = -1
+	testutil.AssertNotNil(t, rc.Validate())
+
+	rc.Values = nil
+	testutil.AssertNotNil(t, rc.Validate())
+}
+
+func TestRoseChartGetScale(t *testing.T) {
+	rc := RoseChart{
+		Values: []Value{
+			{Label: "N", Value: 10},
+			{Label: "E", Value: 25},
+			{Label: "S", Value: 40},
+			{Label: "W", Value: 0},
+		},
+	}
+	max, step := rc.getScale()
+	testutil.AssertEqual(t, 40.0, max)
+	testutil.AssertEqual(t, 10.0, step)
+
+	rc.Values = []Value{{Value: 0}}
+	max, step = rc.getScale()
+	testutil.AssertEqual(t, 1.0, max)
+	testutil.AssertEqual(t, 0.25, step)
+}
+
+func TestRoseChartGetValueRadius(t *testing.T) {
+	rc := RoseChart{}
+
+	// by default the area of a wedge, not its radius, is proportional to its value.
+	testutil.AssertEqual(t, 50.0, rc.getValueRadius(25, 100, 100))
+	testutil.AssertEqual(t, 100.0, rc.getValueRadius(100, 100, 100))
+
+	rc.LinearRadius = true
+	testutil.AssertEqual(t, 25.0, rc.getValueRadius(25, 100, 100))
+}
+
+func TestRoseChartGetWedgeAngle(t *testing.T) {
+	rc := RoseChart{
+		Values: []Value{{Value: 10}, {Value: 25}, {Value: 40}, {Value: 0}},
+	}
+	testutil.AssertEqual(t, 0.0, rc.getWedgeAngle(0))
+	testutil.AssertEqual(t, _pi2, rc.getWedgeAngle(1))
+
+	rc.StartAngle = 45
+	testutil.AssertTrue(t, math.Abs(rc.getWedgeAngle(1)-(_pi2+_pi4)) < 0.0001)
+}
@@ -0,0 +1,130 @@
+package chart
+
+import "math"
+
+const (
+	// DefaultRSIPeriod is the default number of periods the gains and losses of the RSI are averaged over.
+	DefaultRSIPeriod = 14
+)
+
+// Interface Assertions.
+var (
+	_ Series              = (*RSISeries)(nil)
+	_ FirstValuesProvider = (*RSISeries)(nil)
+	_ LastValuesProvider  = (*RSISeries)(nil)
+)
+
+// RSISeries computes the relative strength index of an inner series, the share of the average gains of the
+// average gains and losses between periods scaled to 0-100.
+// It is used in technical analysis to show momentum; values above 70 are considered overbought and below 30 oversold.
+type RSISeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Period      int
+	InnerSeries ValuesProvider
+
+	cache []float64
+}
+
+// GetName returns the name of the time series.
+func (rsi RSISeries) GetName() string {
+	return rsi.Name
+}
+
+// GetStyle returns the line style.
+func (rsi RSISeries) GetStyle() Style {
+	return rsi.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (rsi RSISeries) GetYAxis() YAxisType {
+	return rsi.YAxis
+}
+
+// GetPeriod returns the window size.
+func (rsi RSISeries) GetPeriod() int {
+	if rsi.Period == 0 {
+		return DefaultRSIPeriod
+	}
+	return rsi.Period
+}
+
+// Len returns the number of elements in the series.
+func (rsi RSISeries) Len() int {
+	return rsi.InnerSeries.Len()
+}
+
+// GetValues gets a value at a given index.
+func (rsi *RSISeries) GetValues(index int) (x, y float64) {
+	if rsi.InnerSeries == nil {
+		return
+	}
+	if len(rsi.cache) == 0 {
+		rsi.ensureCachedValues()
+	}
+	x, _ = rsi.InnerSeries.GetValues(index)
+	y = rsi.cache[index]
+	return
+}
+
+// GetFirstValues returns the first value of the series.
+func (rsi *RSISeries) GetFirstValues() (x, y float64) {
+	if rsi.InnerSeries == nil || rsi.InnerSeries.Len() == 0 {
+		return
+	}
+	return rsi.GetValues(0)
+}
+
+// GetLastValues returns the last value of the series.
+func (rsi *RSISeries) GetLastValues() (x, y float64) {
+	if rsi.InnerSeries == nil || rsi.InnerSeries.Len() == 0 {
+		return
+	}
+	return rsi.GetValues(rsi.InnerSeries.Len() - 1)
+}
+
+func (rsi *RSISeries) ensureCachedValues() {
+	seriesLength := rsi.InnerSeries.Len()
+	rsi.cache = make([]float64, seriesLength)
+	if seriesLength == 0 {
+		return
+	}
+
+	// the first period has no change, so gains and losses are counted from the second.
+	gains := make([]float64, seriesLength-1)
+	losses := make([]float64, seriesLength-1)
+	_, previous := rsi.InnerSeries.GetValues(0)
+	for index := 1; index < seriesLength; index++ {
+		_, value := rsi.InnerSeries.GetValues(index)
+		gains[index-1] = math.Max(value-previous, 0)
+		losses[index-1] = math.Max(previous-value, 0)
+		previous = value
+	}
+
+	period := rsi.GetPeriod()
+	averageGains, averageLosses := wilderAverage(gains, period), wilderAverage(losses, period)
+	rsi.cache[0] = 50
+	for index := range averageGains {
+		switch {
+		case averageLosses[index] == 0 && averageGains[index] == 0:
+			rsi.cache[index+1] = 50
+		case averageLosses[index] == 0:
+			rsi.cache[index+1] = 100
+		default:
+			rsi.cache[index+1] = 100 - 100/(1+averageGains[index]/averageLosses[index])
+		}
+	}
+}
+
+// Render renders the series.
+func (rsi *RSISeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := rsi.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, rsi)
+}
+
+// Validate validates the series.
+func (rsi *RSISeries) Validate() error {
+	return validateIndicatorSeries("rsi", rsi.InnerSeries)
+}
@@ -0,0 +1,49 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestRSISeries(t *testing.T) {
+	rsi := &RSISeries{
+		InnerSeries: mockValuesProvider{
+			X: []float64{1, 2, 3, 4},
+			Y: []float64{10, 11, 10.5, 12},
+		},
+		Period: 2,
+	}
+
+	expected := []float64{50, 100, 66.6666667, 88.8888889}
+	for index, value := range expected {
+		x, y := rsi.GetValues(index)
+		testutil.AssertEqual(t, float64(index+1), x)
+		testutil.AssertInDelta(t, value, y, 0.0000001)
+	}
+
+	x, y := rsi.GetLastValues()
+	testutil.AssertEqual(t, 4.0, x)
+	testutil.AssertInDelta(t, 88.8888889, y, 0.0000001)
+}
+
+func TestRSISeriesFlat(t *testing.T) {
+	rsi := &RSISeries{
+		InnerSeries: mockValuesProvider{
+			X: LinearRange(1.0, 20.0),
+			Y: LinearRange(1.0, 20.0),
+		},
+	}
+	_, y := rsi.GetLastValues()
+	testutil.AssertEqual(t, 100.0, y)
+
+	rsi = &RSISeries{
+		InnerSeries: mockValuesProvider{
+			X: LinearRange(1.0, 20.0),
+			Y: LinearRange(20.0, 1.0),
+		},
+	}
+	_, y = rsi.GetLastValues()
+	testutil.AssertEqual(t, 0.0, y)
+	testutil.AssertNotNil(t, (&RSISeries{}).Validate())
+}
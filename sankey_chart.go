@@ -0,0 +1,429 @@
+package chart
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/freetype/truetype"
+)
+
+// SankeyNode is a node of a sankey chart; links refer to nodes by name.
+type SankeyNode struct {
+	Name  string
+	Style Style
+}
+
+// SankeyLink is a flow of a value from one node of a sankey chart to another.
+type SankeyLink struct {
+	Source string
+	Target string
+	Value  float64
+	Style  Style
+}
+
+// SankeyChart is a chart that shows flows between nodes as ribbons with widths proportional to the flow.
+// The nodes are laid out in columns from the sources on the left to the sinks on the right.
+type SankeyChart struct {
+	Title      string
+	TitleStyle Style
+
+	ColorPalette ColorPalette
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+
+	// NodeWidth is the width in pixels of the nodes.
+	NodeWidth int
+	// NodePadding is the space in pixels between the nodes in a column.
+	NodePadding int
+	// Iterations is the number of passes that move the nodes towards the nodes they are linked to.
+	Iterations int
+
+	NodeStyle  Style
+	LinkStyle  Style
+	LabelStyle Style
+
+	// ValueFormatter, if set, formats the value of each node after its name.
+	ValueFormatter ValueFormatter
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	// Nodes sets the order and the style of the nodes; nodes only named by links are added after them.
+	Nodes    []SankeyNode
+	Links    []SankeyLink
+	Elements []Renderable
+}
+
+// GetDPI returns the dpi for the chart.
+func (sc SankeyChart) GetDPI(defaults ...float64) float64 {
+	if sc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return sc.DPI
+}
+
+// GetFont returns the text font.
+func (sc SankeyChart) GetFont() *truetype.Font {
+	if sc.Font == nil {
+		return sc.defaultFont
+	}
+	return sc.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (sc SankeyChart) GetWidth() int {
+	if sc.Width == 0 {
+		return DefaultChartWidth
+	}
+	return sc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (sc SankeyChart) GetHeight() int {
+	if sc.Height == 0 {
+		return DefaultChartHeight
+	}
+	return sc.Height
+}
+
+// GetNodeWidth returns the width of the nodes or the default.
+func (sc SankeyChart) GetNodeWidth() int {
+	if sc.NodeWidth <= 0 {
+		return DefaultSankeyNodeWidth
+	}
+	return sc.NodeWidth
+}
+
+// GetNodePadding returns the space between the nodes in a column or the default.
+func (sc SankeyChart) GetNodePadding() int {
+	if sc.NodePadding <= 0 {
+		return DefaultSankeyNodePadding
+	}
+	return sc.NodePadding
+}
+
+// GetIterations returns the number of layout passes or the default.
+func (sc SankeyChart) GetIterations() int {
+	if sc.Iterations <= 0 {
+		return DefaultSankeyIterations
+	}
+	return sc.Iterations
+}
+
+// GetNodes returns the nodes of the chart, including the nodes only named by links in the order they appear.
+func (sc SankeyChart) GetNodes() []SankeyNode {
+	nodes := make([]SankeyNode, len(sc.Nodes))
+	copy(nodes, sc.Nodes)
+
+	seen := make(map[string]bool)
+	for _, node := range nodes {
+		seen[node.Name] = true
+	}
+	for _, link := range sc.Links {
+		for _, name := range []string{link.Source, link.Target} {
+			if !seen[name] {
+				seen[name] = true
+				nodes = append(nodes, SankeyNode{Name: name})
+			}
+		}
+	}
+	return nodes
+}
+
+// Validate validates the chart.
+func (sc SankeyChart) Validate() error {
+	if len(sc.Links) == 0 {
+		return fmt.Errorf("please provide at least one link")
+	}
+	seen := make(map[string]bool)
+	for _, node := range sc.Nodes {
+		if seen[node.Name] {
+			return fmt.Errorf("sankey node names must be unique; %q is repeated", node.Name)
+		}
+		seen[node.Name] = true
+	}
+	for _, link := range sc.Links {
+		if link.Source == link.Target {
+			return fmt.Errorf("sankey link cannot link a node to itself; node: %q", link.Source)
+		}
+		if link.Value <= 0 {
+			return fmt.Errorf("sankey link value must be positive; source: %q target: %q value: %v", link.Source, link.Target, link.Value)
+		}
+	}
+	_, links := sc.getGraph()
+	_, err := sankeyColumns(len(sc.GetNodes()), links)
+	return err
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (sc SankeyChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := sc.Validate(); err != nil {
+		return err
+	}
+
+	r, err := rp(sc.GetWidth(), sc.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if sc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		sc.defaultFont = defaultFont
+	}
+	r.SetDPI(sc.GetDPI(DefaultDPI))
+
+	canvasBox := sc.getCanvasBox(r)
+	sc.drawBackground(r)
+	sc.drawCanvas(r, canvasBox)
+
+	nodes, links := sc.getGraph()
+	columns, err := sankeyColumns(len(nodes), links)
+	if err != nil {
+		return err
+	}
+	// the labels are drawn inside the outer columns, so the nodes can use the whole canvas.
+	bounds := treemapRect{
+		Left:   float64(canvasBox.Left),
+		Top:    float64(canvasBox.Top),
+		Width:  float64(canvasBox.Width()),
+		Height: float64(canvasBox.Height()),
+	}
+	nodeLayout, linkLayout := layoutSankey(len(nodes), links, columns, bounds,
+		float64(sc.GetNodeWidth()), float64(sc.GetNodePadding()), sc.GetIterations())
+
+	sc.drawLinks(r, nodes, nodeLayout, linkLayout)
+	sc.drawNodes(r, nodes, nodeLayout)
+	sc.drawLabels(r, nodes, nodeLayout)
+
+	sc.drawTitle(r)
+	for _, a := range sc.Elements {
+		a(r, canvasBox, sc.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+// getGraph returns the nodes and the links with the links referring to the nodes by index.
+func (sc SankeyChart) getGraph() ([]SankeyNode, []sankeyLinkLayout) {
+	nodes := sc.GetNodes()
+	indexes := make(map[string]int)
+	for index, node := range nodes {
+		indexes[node.Name] = index
+	}
+	links := make([]sankeyLinkLayout, len(sc.Links))
+	for index, link := range sc.Links {
+		links[index] = sankeyLinkLayout{
+			Source: indexes[link.Source],
+			Target: indexes[link.Target],
+			Value:  link.Value,
+		}
+	}
+	return nodes, links
+}
+
+func (sc SankeyChart) drawBackground(r Renderer) {
+	Draw.Box(r, Box{
+		Right:  sc.GetWidth(),
+		Bottom: sc.GetHeight(),
+	}, sc.getBackgroundStyle())
+}
+
+func (sc SankeyChart) drawCanvas(r Renderer, canvasBox Box) {
+	Draw.Box(r, canvasBox, sc.getCanvasStyle())
+}
+
+func (sc SankeyChart) drawTitle(r Renderer) {
+	if len(sc.Title) > 0 && !sc.TitleStyle.Hidden {
+		Draw.TextWithin(r, sc.Title, sc.Box(), sc.styleDefaultsTitle())
+	}
+}
+
+func (sc SankeyChart) drawLinks(r Renderer, nodes []SankeyNode, layout []sankeyNodeLayout, links []sankeyLinkLayout) {
+	for index, link := range links {
+		style := sc.getLinkStyle(nodes, index, link.Source)
+		if style.Hidden {
+			continue
+		}
+		x0, x1 := layout[link.Source].X1, layout[link.Target].X0
+		style.GetFillOptions().WriteToRenderer(r)
+		r.MoveTo(int(x0), int(link.SY))
+		sankeyCurveTo(r, x0, link.SY, x1, link.TY)
+		r.LineTo(int(x1), int(link.TY+link.Width))
+		sankeyCurveTo(r, x1, link.TY+link.Width, x0, link.SY+link.Width)
+		r.Close()
+		r.Fill()
+		r.ResetStyle()
+	}
+}
+
+// sankeyCurveTo draws an s-curve from the current point to the end point that leaves and arrives horizontally,
+// as two quadratic curves that meet halfway.
+func sankeyCurveTo(r Renderer, x0, y0, x1, y1 float64) {
+	dx := (x1 - x0) / 4.0
+	mx, my := (x0+x1)/2.0, (y0+y1)/2.0
+	r.QuadCurveTo(int(x0+dx), int(y0), int(mx), int(my))
+	r.QuadCurveTo(int(x1-dx), int(y1), int(x1), int(y1))
+}
+
+func (sc SankeyChart) drawNodes(r Renderer, nodes []SankeyNode, layout []sankeyNodeLayout) {
+	for index, node := range layout {
+		style := sc.getNodeStyle(nodes, index)
+		if style.Hidden || node.Value == 0 {
+			continue
+		}
+		Draw.Box(r, Box{
+			Top:    int(node.Y0),
+			Left:   int(node.X0),
+			Right:  int(node.X1),
+			Bottom: int(node.Y1),
+		}, style)
+	}
+}
+
+// drawLabels draws the label of each node vertically centered beside it; to the right of the node,
+// or to the left for the nodes of the last column.
+func (sc SankeyChart) drawLabels(r Renderer, nodes []SankeyNode, layout []sankeyNodeLayout) {
+	style := sc.getLabelStyle()
+	if style.Hidden {
+		return
+	}
+	var lastColumn int
+	for _, node := range layout {
+		lastColumn = MaxInt(lastColumn, node.Column)
+	}
+	for index, node := range layout {
+		label := sc.getLabel(nodes[index], node.Value)
+		tb := Draw.MeasureText(r, label, style)
+		x := int(node.X1) + DefaultSankeyLabelPadding
+		if node.Column == lastColumn && lastColumn > 0 {
+			x = int(node.X0) - DefaultSankeyLabelPadding - tb.Width()
+		}
+		Draw.Text(r, label, x, int(node.Center())+tb.Height()>>1, style)
+	}
+}
+
+func (sc SankeyChart) getLabel(node SankeyNode, value float64) string {
+	if sc.ValueFormatter == nil {
+		return node.Name
+	}
+	return fmt.Sprintf("%s (%s)", node.Name, sc.ValueFormatter(value))
+}
+
+func (sc SankeyChart) getCanvasBox(r Renderer) Box {
+	canvasBox := sc.Box()
+	if len(sc.Title) > 0 && !sc.TitleStyle.Hidden {
+		titleBox := Draw.MeasureText(r, sc.Title, sc.styleDefaultsTitle())
+		canvasBox.Top += titleBox.Height() + DefaultTitleTop
+	}
+	return canvasBox
+}
+
+func (sc SankeyChart) getNodeStyle(nodes []SankeyNode, index int) Style {
+	return nodes[index].Style.InheritFrom(sc.NodeStyle.InheritFrom(Style{
+		FillColor:   sc.GetColorPalette().GetSeriesColor(index),
+		StrokeColor: sc.GetColorPalette().GetSeriesColor(index),
+		StrokeWidth: DefaultStrokeWidth,
+	}))
+}
+
+// getLinkStyle returns the style of a link, which defaults to a translucent color of its source node.
+func (sc SankeyChart) getLinkStyle(nodes []SankeyNode, index, source int) Style {
+	return sc.Links[index].Style.InheritFrom(sc.LinkStyle.InheritFrom(Style{
+		FillColor: sc.getNodeStyle(nodes, source).GetFillColor().WithAlpha(DefaultSankeyLinkAlpha),
+	}))
+}
+
+func (sc SankeyChart) getLabelStyle() Style {
+	return sc.LabelStyle.InheritFrom(Style{
+		FontColor: sc.GetColorPalette().TextColor(),
+		FontSize:  DefaultAxisFontSize,
+		Font:      sc.GetFont(),
+	})
+}
+
+func (sc SankeyChart) getBackgroundStyle() Style {
+	return sc.Background.InheritFrom(sc.styleDefaultsBackground())
+}
+
+func (sc SankeyChart) getCanvasStyle() Style {
+	return sc.Canvas.InheritFrom(sc.styleDefaultsCanvas())
+}
+
+func (sc SankeyChart) styleDefaultsCanvas() Style {
+	return Style{
+		FillColor:   sc.GetColorPalette().CanvasColor(),
+		StrokeColor: sc.GetColorPalette().CanvasStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (sc SankeyChart) styleDefaultsBackground() Style {
+	return Style{
+		FillColor:   sc.GetColorPalette().BackgroundColor(),
+		StrokeColor: sc.GetColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (sc SankeyChart) styleDefaultsElements() Style {
+	return Style{
+		Font: sc.GetFont(),
+	}
+}
+
+func (sc SankeyChart) styleDefaultsTitle() Style {
+	return sc.TitleStyle.InheritFrom(Style{
+		FontColor:           sc.GetColorPalette().TextColor(),
+		Font:                sc.GetFont(),
+		FontSize:            sc.getTitleFontSize(),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+func (sc SankeyChart) getTitleFontSize() float64 {
+	effectiveDimension := MinInt(sc.GetWidth(), sc.GetHeight())
+	if effectiveDimension >= 2048 {
+		return 48
+	} else if effectiveDimension >= 1024 {
+		return 24
+	} else if effectiveDimension >= 512 {
+		return 18
+	} else if effectiveDimension >= 256 {
+		return 12
+	}
+	return 10
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (sc SankeyChart) GetColorPalette() ColorPalette {
+	if sc.ColorPalette != nil {
+		return sc.ColorPalette
+	}
+	return DefaultColorPalette
+}
+
+// Box returns the chart bounds as a box.
+func (sc SankeyChart) Box() Box {
+	dpr := sc.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
+	dpb := sc.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
+
+	return Box{
+		Top:    sc.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
+		Left:   sc.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
+		Right:  sc.GetWidth() - dpr,
+		Bottom: sc.GetHeight() - dpb,
+	}
+}
@@ -0,0 +1,158 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestSankeyChart(t *testing.T) {
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	sc := SankeyChart{
+		Title:          "Test Title",
+		Font:           f,
+		ValueFormatter: IntValueFormatter,
+		Links: []SankeyLink{
+			{Source: "gateway", Target: "auth", Value: 40},
+			{Source: "gateway", Target: "orders", Value: 60},
+			{Source: "auth", Target: "database", Value: 40},
+			{Source: "orders", Target: "database", Value: 60},
+		},
+	}
+	testutil.AssertNil(t, sc.Render(PNG, bytes.NewBuffer([]byte{})))
+	testutil.AssertNil(t, sc.Render(SVG, bytes.NewBuffer([]byte{})))
+
+	r, err := PNG(sc.GetWidth(), sc.GetHeight())
+	testutil.AssertNil(t, err)
+	canvasBox := sc.getCanvasBox(r)
+	testutil.AssertTrue(t, canvasBox.Top > sc.Box().Top)
+
+	// the nodes are laid out in three columns across the canvas; the middle column, with the padding between its
+	// nodes, fills the height.
+	nodes, links := sc.getGraph()
+	columns, err := sankeyColumns(len(nodes), links)
+	testutil.AssertNil(t, err)
+	testutil.AssertEqual(t, []int{0, 1, 1, 2}, columns)
+	bounds := treemapRect{
+		Left:   float64(canvasBox.Left),
+		Top:    float64(canvasBox.Top),
+		Width:  float64(canvasBox.Width()),
+		Height: float64(canvasBox.Height()),
+	}
+	layout, _ := layoutSankey(len(nodes), links, columns, bounds, float64(sc.GetNodeWidth()), float64(sc.GetNodePadding()), sc.GetIterations())
+	testutil.AssertEqual(t, bounds.Left, layout[0].X0)
+	testutil.AssertEqual(t, bounds.Left+bounds.Width, layout[3].X1)
+	testutil.AssertInDelta(t, bounds.Height, (layout[1].Y1-layout[1].Y0)+(layout[2].Y1-layout[2].Y0)+float64(sc.GetNodePadding()), 0.001)
+	testutil.AssertInDelta(t, layout[0].Y1-layout[0].Y0, layout[3].Y1-layout[3].Y0, 0.001)
+
+	testutil.AssertEqual(t, "gateway (100)", sc.getLabel(nodes[0], layout[0].Value))
+}
+
+func TestSankeyChartValidate(t *testing.T) {
+	sc := SankeyChart{
+		Links: []SankeyLink{
+			{Source: "gateway", Target: "auth", Value: 40},
+			{Source: "gateway", Target: "orders", Value: 60},
+			{Source: "auth", Target: "users", Value: 40},
+			{Source: "orders", Target: "users", Value: 10},
+			{Source: "orders", Target: "database", Value: 50},
+			{Source: "users", Target: "database", Value: 50},
+		},
+	}
+	testutil.AssertNil(t, sc.Validate())
+
+	sc.Links = append(sc.Links, SankeyLink{Source: "database", Target: "gateway", Value: 1})
+	testutil.AssertNotNil(t, sc.Validate())
+	sc.Links = sc.Links[:len(sc.Links)-1]
+
+	sc.Links[0].Value = 0
+	testutil.AssertNotNil(t, sc.Validate())
+	sc.Links[0].Value = 40
+
+	sc.Links[0].Target = sc.Links[0].Source
+	testutil.AssertNotNil(t, sc.Validate())
+	sc.Links[0].Target = "auth"
+	testutil.AssertNil(t, sc.Validate())
+
+	sc.Nodes = []SankeyNode{{Name: "auth"}, {Name: "auth"}}
+	testutil.AssertNotNil(t, sc.Validate())
+}
+
+func TestSankeyChartGetNodes(t *testing.T) {
+	sc := SankeyChart{
+		Links: []SankeyLink{
+			{Source: "gateway", Target: "auth", Value: 40},
+			{Source: "gateway", Target: "orders", Value: 60},
+			{Source: "auth", Target: "users", Value: 40},
+			{Source: "orders", Target: "users", Value: 10},
+			{Source: "orders", Target: "database", Value: 50},
+			{Source: "users", Target: "database", Value: 50},
+		},
+	}
+	sc.Nodes = []SankeyNode{{Name: "users"}}
+
+	nodes := sc.GetNodes()
+	testutil.AssertLen(t, nodes, 5)
+	testutil.AssertEqual(t, "users", nodes[0].Name)
+	testutil.AssertEqual(t, "gateway", nodes[1].Name)
+	testutil.AssertEqual(t, "database", nodes[4].Name)
+}
+
+func TestSankeyColumns(t *testing.T) {
+	sc := SankeyChart{
+		Links: []SankeyLink{
+			{Source: "gateway", Target: "auth", Value: 40},
+			{Source: "gateway", Target: "orders", Value: 60},
+			{Source: "auth", Target: "users", Value: 40},
+			{Source: "orders", Target: "users", Value: 10},
+			{Source: "orders", Target: "database", Value: 50},
+			{Source: "users", Target: "database", Value: 50},
+		},
+	}
+	sc.Links = append(sc.Links, SankeyLink{Source: "gateway", Target: "cache", Value: 5})
+	nodes, links := sc.getGraph()
+
+	columns, err := sankeyColumns(len(nodes), links)
+	testutil.AssertNil(t, err)
+	// users is after orders, which is after the gateway; the cache is a sink so it is in the last column.
+	testutil.AssertEqual(t, []int{0, 1, 1, 2, 3, 3}, columns)
+}
+
+func TestLayoutSankey(t *testing.T) {
+	sc := SankeyChart{
+		Links: []SankeyLink{
+			{Source: "gateway", Target: "auth", Value: 40},
+			{Source: "gateway", Target: "orders", Value: 60},
+			{Source: "auth", Target: "users", Value: 40},
+			{Source: "orders", Target: "users", Value: 10},
+			{Source: "orders", Target: "database", Value: 50},
+			{Source: "users", Target: "database", Value: 50},
+		},
+	}
+	nodes, links := sc.getGraph()
+	columns, err := sankeyColumns(len(nodes), links)
+	testutil.AssertNil(t, err)
+
+	bounds := treemapRect{Left: 0, Top: 0, Width: 400, Height: 200}
+	layout, linkLayout := layoutSankey(len(nodes), links, columns, bounds, 10, 10, DefaultSankeyIterations)
+	testutil.AssertLen(t, layout, len(nodes))
+	testutil.AssertLen(t, linkLayout, len(links))
+
+	// the fullest column, auth and orders with the padding between them, fills the height.
+	testutil.AssertEqual(t, 100.0, layout[0].Value)
+	testutil.AssertInDelta(t, bounds.Height, (layout[1].Y1-layout[1].Y0)+(layout[2].Y1-layout[2].Y0)+10, 0.001)
+	testutil.AssertEqual(t, 0.0, layout[0].X0)
+	testutil.AssertEqual(t, 390.0, layout[4].X0)
+
+	for index, node := range layout {
+		testutil.AssertTrue(t, node.Y0 >= bounds.Top, nodes[index].Name)
+		testutil.AssertTrue(t, node.Y1 <= bounds.Top+bounds.Height+0.001, nodes[index].Name)
+	}
+
+	// the links leaving the gateway stack on top of one another and fill the node.
+	gateway := layout[0]
+	testutil.AssertInDelta(t, gateway.Y1-gateway.Y0, linkLayout[0].Width+linkLayout[1].Width, 0.001)
+}
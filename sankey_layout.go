@@ -0,0 +1,230 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// sankeyNodeLayout is where a node of a sankey diagram is drawn.
+type sankeyNodeLayout struct {
+	Column int
+	Value  float64
+	X0, X1 float64
+	Y0, Y1 float64
+}
+
+// Center returns the vertical center of the node.
+func (snl sankeyNodeLayout) Center() float64 {
+	return (snl.Y0 + snl.Y1) / 2.0
+}
+
+// sankeyLinkLayout is where a link of a sankey diagram is drawn; the ribbon starts at the source with its top at SY
+// and ends at the target with its top at TY.
+type sankeyLinkLayout struct {
+	Source, Target int
+	Value          float64
+	Width          float64
+	SY, TY         float64
+}
+
+// sankeyColumns assigns each node to a column: sources start in the first column, every other node is one column
+// right of its furthest source and sinks, nodes with incoming but no outgoing links, are moved to the last column.
+// It returns an error if the links have a cycle.
+func sankeyColumns(nodes int, links []sankeyLinkLayout) ([]int, error) {
+	incoming := make([]int, nodes)
+	outgoing := make([][]int, nodes)
+	for _, link := range links {
+		incoming[link.Target]++
+		outgoing[link.Source] = append(outgoing[link.Source], link.Target)
+	}
+	sinks := make([]bool, nodes)
+	for node := 0; node < nodes; node++ {
+		sinks[node] = incoming[node] > 0 && len(outgoing[node]) == 0
+	}
+
+	columns := make([]int, nodes)
+	var queue []int
+	for node := 0; node < nodes; node++ {
+		if incoming[node] == 0 {
+			queue = append(queue, node)
+		}
+	}
+	var visited, last int
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		visited++
+		for _, target := range outgoing[node] {
+			columns[target] = MaxInt(columns[target], columns[node]+1)
+			last = MaxInt(last, columns[target])
+			if incoming[target]--; incoming[target] == 0 {
+				queue = append(queue, target)
+			}
+		}
+	}
+	if visited < nodes {
+		return nil, fmt.Errorf("sankey links cannot have cycles")
+	}
+
+	for node := 0; node < nodes; node++ {
+		if sinks[node] {
+			columns[node] = last
+		}
+	}
+	return columns, nil
+}
+
+// layoutSankey positions the nodes in columns across the bounds, stacks them by their value within each column
+// and then moves them towards the nodes they are linked to, which reduces how often the links cross.
+func layoutSankey(nodes int, links []sankeyLinkLayout, columns []int, bounds treemapRect, nodeWidth, nodePadding float64, iterations int) ([]sankeyNodeLayout, []sankeyLinkLayout) {
+	layout := make([]sankeyNodeLayout, nodes)
+	in, out := make([]float64, nodes), make([]float64, nodes)
+	for _, link := range links {
+		out[link.Source] += link.Value
+		in[link.Target] += link.Value
+	}
+
+	var lastColumn int
+	for node := range layout {
+		layout[node].Column = columns[node]
+		layout[node].Value = math.Max(in[node], out[node])
+		lastColumn = MaxInt(lastColumn, columns[node])
+	}
+	byColumn := make([][]int, lastColumn+1)
+	for node := range layout {
+		byColumn[columns[node]] = append(byColumn[columns[node]], node)
+	}
+
+	// the scale is set by the fullest column so every column fits.
+	scale := math.MaxFloat64
+	for _, column := range byColumn {
+		var total float64
+		for _, node := range column {
+			total += layout[node].Value
+		}
+		if total > 0 {
+			scale = math.Min(scale, (bounds.Height-nodePadding*float64(len(column)-1))/total)
+		}
+	}
+	if scale == math.MaxFloat64 || scale < 0 {
+		scale = 0
+	}
+
+	columnStep := 0.0
+	if lastColumn > 0 {
+		columnStep = (bounds.Width - nodeWidth) / float64(lastColumn)
+	}
+	for column, columnNodes := range byColumn {
+		y := bounds.Top
+		for _, node := range columnNodes {
+			layout[node].X0 = bounds.Left + columnStep*float64(column)
+			layout[node].X1 = layout[node].X0 + nodeWidth
+			layout[node].Y0 = y
+			layout[node].Y1 = y + layout[node].Value*scale
+			y = layout[node].Y1 + nodePadding
+		}
+	}
+
+	for iteration := 0; iteration < iterations; iteration++ {
+		alpha := math.Pow(0.99, float64(iteration))
+		for column := 1; column < len(byColumn); column++ {
+			sankeyRelax(layout, links, byColumn[column], alpha, true)
+			sankeyResolveCollisions(layout, byColumn[column], bounds, nodePadding)
+		}
+		for column := len(byColumn) - 2; column >= 0; column-- {
+			sankeyRelax(layout, links, byColumn[column], alpha, false)
+			sankeyResolveCollisions(layout, byColumn[column], bounds, nodePadding)
+		}
+	}
+
+	output := make([]sankeyLinkLayout, len(links))
+	copy(output, links)
+	for index := range output {
+		output[index].Width = output[index].Value * scale
+	}
+	sankeyLinkOffsets(layout, output)
+	return layout, output
+}
+
+// sankeyRelax moves each node towards the value weighted center of the nodes linked to it from the left,
+// or from the right when the pass goes backwards.
+func sankeyRelax(layout []sankeyNodeLayout, links []sankeyLinkLayout, column []int, alpha float64, forwards bool) {
+	for _, node := range column {
+		var weighted, total float64
+		for _, link := range links {
+			if forwards && link.Target == node {
+				weighted += layout[link.Source].Center() * link.Value
+				total += link.Value
+			} else if !forwards && link.Source == node {
+				weighted += layout[link.Target].Center() * link.Value
+				total += link.Value
+			}
+		}
+		if total == 0 {
+			continue
+		}
+		delta := (weighted/total - layout[node].Center()) * alpha
+		layout[node].Y0 += delta
+		layout[node].Y1 += delta
+	}
+}
+
+// sankeyResolveCollisions pushes apart the nodes of a column that overlap, keeping them within the bounds.
+func sankeyResolveCollisions(layout []sankeyNodeLayout, column []int, bounds treemapRect, nodePadding float64) {
+	sort.SliceStable(column, func(i, j int) bool {
+		return layout[column[i]].Y0 < layout[column[j]].Y0
+	})
+
+	y := bounds.Top
+	for _, node := range column {
+		if delta := y - layout[node].Y0; delta > 0 {
+			layout[node].Y0 += delta
+			layout[node].Y1 += delta
+		}
+		y = layout[node].Y1 + nodePadding
+	}
+
+	y = bounds.Top + bounds.Height
+	for index := len(column) - 1; index >= 0; index-- {
+		node := column[index]
+		if delta := layout[node].Y1 - y; delta > 0 {
+			layout[node].Y0 -= delta
+			layout[node].Y1 -= delta
+		}
+		y = layout[node].Y0 - nodePadding
+	}
+}
+
+// sankeyLinkOffsets stacks the links leaving and entering each node, ordered by where their other end is
+// so the ribbons don't cross at the node.
+func sankeyLinkOffsets(layout []sankeyNodeLayout, links []sankeyLinkLayout) {
+	order := make([]int, len(links))
+	for index := range order {
+		order[index] = index
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return layout[links[order[i]].Target].Center() < layout[links[order[j]].Target].Center()
+	})
+	sourceY := make([]float64, len(layout))
+	for node := range layout {
+		sourceY[node] = layout[node].Y0
+	}
+	for _, index := range order {
+		links[index].SY = sourceY[links[index].Source]
+		sourceY[links[index].Source] += links[index].Width
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return layout[links[order[i]].Source].Center() < layout[links[order[j]].Source].Center()
+	})
+	targetY := make([]float64, len(layout))
+	for node := range layout {
+		targetY[node] = layout[node].Y0
+	}
+	for _, index := range order {
+		links[index].TY = targetY[links[index].Target]
+		targetY[links[index].Target] += links[index].Width
+	}
+}
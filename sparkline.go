@@ -0,0 +1,350 @@
+package chart
+
+import (
+	"fmt"
+	"io"
+	"math"
+)
+
+// SparklineType is an enum for how a sparkline draws its values.
+type SparklineType int
+
+const (
+	// SparklineTypeUnset is the unset state for the sparkline type; it behaves like `SparklineTypeLine`.
+	SparklineTypeUnset SparklineType = 0
+	// SparklineTypeLine draws the values as a line.
+	SparklineTypeLine SparklineType = 1
+	// SparklineTypeArea draws the values as a line with the area under it filled.
+	SparklineTypeArea SparklineType = 2
+	// SparklineTypeBar draws the values as bars from zero.
+	SparklineTypeBar SparklineType = 3
+	// SparklineTypeWinLoss draws positive values as bars up from the middle and negative values as bars down from it,
+	// all the same height.
+	SparklineTypeWinLoss SparklineType = 4
+)
+
+// SparklineMarker is a set of flags for the values a sparkline marks.
+type SparklineMarker int
+
+const (
+	// SparklineMarkerMin marks the smallest value.
+	SparklineMarkerMin SparklineMarker = 1 << iota
+	// SparklineMarkerMax marks the largest value.
+	SparklineMarkerMax
+	// SparklineMarkerLast marks the last value.
+	SparklineMarkerLast
+)
+
+// Sparkline is a tiny chart of a series of values without axes, labels or padding, meant to be embedded in text,
+// such as a table or an email.
+type Sparkline struct {
+	Type SparklineType
+
+	ColorPalette ColorPalette
+
+	Width  int
+	Height int
+	DPI    float64
+
+	// Background is the style of the background; unlike the other charts it has no padding by default.
+	Background Style
+
+	// Style is the style of the line, the area or the bars.
+	Style Style
+	// NegativeStyle is the style of the bars of negative values.
+	NegativeStyle Style
+
+	// Markers are the values that are marked; with a dot on lines and areas, or by the color of the bar.
+	Markers         SparklineMarker
+	MinMarkerStyle  Style
+	MaxMarkerStyle  Style
+	LastMarkerStyle Style
+
+	Values []float64
+}
+
+// GetDPI returns the dpi for the chart.
+func (s Sparkline) GetDPI(defaults ...float64) float64 {
+	if s.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return s.DPI
+}
+
+// GetWidth returns the chart width or the default value.
+func (s Sparkline) GetWidth() int {
+	if s.Width == 0 {
+		return DefaultSparklineWidth
+	}
+	return s.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (s Sparkline) GetHeight() int {
+	if s.Height == 0 {
+		return DefaultSparklineHeight
+	}
+	return s.Height
+}
+
+// Validate validates the chart.
+func (s Sparkline) Validate() error {
+	if len(s.Values) == 0 {
+		return fmt.Errorf("please provide at least one value")
+	}
+	for index, value := range s.Values {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return fmt.Errorf("sparkline values must be finite; index: %d value: %v", index, value)
+		}
+	}
+	return nil
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (s Sparkline) Render(rp RendererProvider, w io.Writer) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	r, err := rp(s.GetWidth(), s.GetHeight())
+	if err != nil {
+		return err
+	}
+	r.SetDPI(s.GetDPI(DefaultDPI))
+
+	Draw.Box(r, Box{
+		Right:  s.GetWidth(),
+		Bottom: s.GetHeight(),
+	}, s.getBackgroundStyle())
+
+	canvasBox := s.getCanvasBox()
+	switch s.Type {
+	case SparklineTypeBar, SparklineTypeWinLoss:
+		s.drawBars(r, canvasBox)
+	default:
+		s.drawLine(r, canvasBox)
+	}
+
+	return r.Save(w)
+}
+
+// getCanvasBox returns the box the values are drawn in; line and area sparklines are inset so their markers
+// and the stroke of the line aren't clipped at the edges.
+func (s Sparkline) getCanvasBox() Box {
+	canvasBox := s.Box()
+	if s.Type == SparklineTypeBar || s.Type == SparklineTypeWinLoss {
+		return canvasBox
+	}
+
+	inset := s.getStyle().GetStrokeWidth() / 2.0
+	for _, marker := range []SparklineMarker{SparklineMarkerMin, SparklineMarkerMax, SparklineMarkerLast} {
+		if s.Markers&marker != 0 {
+			inset = math.Max(inset, s.getMarkerStyle(marker).GetDotWidth())
+		}
+	}
+	pad := int(math.Ceil(inset))
+	return Box{
+		Top:    canvasBox.Top + pad,
+		Left:   canvasBox.Left + pad,
+		Right:  canvasBox.Right - pad,
+		Bottom: canvasBox.Bottom - pad,
+	}
+}
+
+// getRange returns the range of the values; bars are drawn from zero so their range always includes it.
+func (s Sparkline) getRange(canvasBox Box) Range {
+	min, max := math.MaxFloat64, -math.MaxFloat64
+	for _, value := range s.Values {
+		min = math.Min(min, value)
+		max = math.Max(max, value)
+	}
+	if s.Type == SparklineTypeBar {
+		min = math.Min(min, 0)
+		max = math.Max(max, 0)
+	}
+	if min == max {
+		min, max = min-1, max+1
+	}
+	return &ContinuousRange{
+		Min:    min,
+		Max:    max,
+		Domain: canvasBox.Height(),
+	}
+}
+
+// getX returns the horizontal position of the value at the index on a line.
+func (s Sparkline) getX(canvasBox Box, index int) int {
+	if len(s.Values) == 1 {
+		return canvasBox.Left + canvasBox.Width()>>1
+	}
+	return canvasBox.Left + int(math.Round(float64(index*canvasBox.Width())/float64(len(s.Values)-1)))
+}
+
+func (s Sparkline) drawLine(r Renderer, canvasBox Box) {
+	yr := s.getRange(canvasBox)
+	style := s.getStyle()
+	if s.Type == SparklineTypeArea && !style.Hidden {
+		style.GetFillOptions().WriteToRenderer(r)
+		r.MoveTo(s.getX(canvasBox, 0), canvasBox.Bottom)
+		for index, value := range s.Values {
+			r.LineTo(s.getX(canvasBox, index), canvasBox.Bottom-yr.Translate(value))
+		}
+		r.LineTo(s.getX(canvasBox, len(s.Values)-1), canvasBox.Bottom)
+		r.Close()
+		r.Fill()
+		r.ResetStyle()
+	}
+
+	if !style.Hidden && len(s.Values) > 1 {
+		style.GetStrokeOptions().WriteToRenderer(r)
+		for index, value := range s.Values {
+			if index == 0 {
+				r.MoveTo(s.getX(canvasBox, index), canvasBox.Bottom-yr.Translate(value))
+			} else {
+				r.LineTo(s.getX(canvasBox, index), canvasBox.Bottom-yr.Translate(value))
+			}
+		}
+		r.Stroke()
+		r.ResetStyle()
+	}
+
+	for _, marker := range []SparklineMarker{SparklineMarkerMin, SparklineMarkerMax, SparklineMarkerLast} {
+		markerStyle := s.getMarkerStyle(marker)
+		if s.Markers&marker == 0 || markerStyle.Hidden {
+			continue
+		}
+		index := s.getMarkerIndex(marker)
+		x, y := s.getX(canvasBox, index), canvasBox.Bottom-yr.Translate(s.Values[index])
+		r.SetFillColor(markerStyle.GetDotColor())
+		r.Circle(markerStyle.GetDotWidth(), x, y)
+		r.Fill()
+		r.ResetStyle()
+	}
+}
+
+// drawBars draws a bar for each value; the bars of marked values take the color of their marker.
+func (s Sparkline) drawBars(r Renderer, canvasBox Box) {
+	yr := s.getRange(canvasBox)
+	slot := float64(canvasBox.Width()) / float64(len(s.Values))
+	spacing := 0
+	if slot >= 3 {
+		spacing = 1
+	}
+
+	middle := canvasBox.Top + canvasBox.Height()>>1
+	for index, value := range s.Values {
+		left := canvasBox.Left + int(math.Round(slot*float64(index)))
+		right := canvasBox.Left + int(math.Round(slot*float64(index+1))) - spacing
+
+		var top, bottom int
+		if s.Type == SparklineTypeWinLoss {
+			switch {
+			case value > 0:
+				top, bottom = canvasBox.Top, middle-spacing
+			case value < 0:
+				top, bottom = middle+spacing, canvasBox.Bottom
+			default:
+				continue
+			}
+		} else {
+			zero := canvasBox.Bottom - yr.Translate(0)
+			y := canvasBox.Bottom - yr.Translate(value)
+			top, bottom = MinInt(zero, y), MaxInt(zero, y)
+			if top == bottom {
+				continue
+			}
+		}
+
+		style := s.getBarStyle(index)
+		if style.Hidden {
+			continue
+		}
+		Draw.Box(r, Box{Top: top, Left: left, Right: MaxInt(right, left+1), Bottom: bottom}, style)
+	}
+}
+
+// getMarkerIndex returns the index of the value the marker marks; the first of the smallest or largest values,
+// or the last value.
+func (s Sparkline) getMarkerIndex(marker SparklineMarker) int {
+	var markerIndex int
+	for index, value := range s.Values {
+		switch marker {
+		case SparklineMarkerMin:
+			if value < s.Values[markerIndex] {
+				markerIndex = index
+			}
+		case SparklineMarkerMax:
+			if value > s.Values[markerIndex] {
+				markerIndex = index
+			}
+		default:
+			markerIndex = index
+		}
+	}
+	return markerIndex
+}
+
+func (s Sparkline) getBarStyle(index int) Style {
+	style := s.getStyle()
+	if s.Values[index] < 0 {
+		style = s.NegativeStyle.InheritFrom(Style{FillColor: ColorRed})
+	}
+	// the last marker is applied after the others, so it takes precedence.
+	for _, marker := range []SparklineMarker{SparklineMarkerMin, SparklineMarkerMax, SparklineMarkerLast} {
+		if s.Markers&marker != 0 && s.getMarkerIndex(marker) == index {
+			style = Style{FillColor: s.getMarkerStyle(marker).GetDotColor()}
+		}
+	}
+	return style
+}
+
+func (s Sparkline) getStyle() Style {
+	color := s.GetColorPalette().GetSeriesColor(0)
+	defaults := Style{
+		StrokeColor: color,
+		StrokeWidth: DefaultSparklineStrokeWidth,
+		FillColor:   color.WithAlpha(64),
+	}
+	if s.Type == SparklineTypeBar || s.Type == SparklineTypeWinLoss {
+		defaults.FillColor = color
+	}
+	return s.Style.InheritFrom(defaults)
+}
+
+func (s Sparkline) getMarkerStyle(marker SparklineMarker) Style {
+	switch marker {
+	case SparklineMarkerMin:
+		return s.MinMarkerStyle.InheritFrom(Style{DotColor: ColorRed, DotWidth: DefaultSparklineMarkerRadius})
+	case SparklineMarkerMax:
+		return s.MaxMarkerStyle.InheritFrom(Style{DotColor: ColorGreen, DotWidth: DefaultSparklineMarkerRadius})
+	default:
+		return s.LastMarkerStyle.InheritFrom(Style{DotColor: ColorBlack, DotWidth: DefaultSparklineMarkerRadius})
+	}
+}
+
+func (s Sparkline) getBackgroundStyle() Style {
+	return s.Background.InheritFrom(Style{
+		FillColor: s.GetColorPalette().BackgroundColor(),
+	})
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (s Sparkline) GetColorPalette() ColorPalette {
+	if s.ColorPalette != nil {
+		return s.ColorPalette
+	}
+	return DefaultColorPalette
+}
+
+// Box returns the chart bounds as a box.
+func (s Sparkline) Box() Box {
+	return Box{
+		Top:    s.Background.Padding.GetTop(),
+		Left:   s.Background.Padding.GetLeft(),
+		Right:  s.GetWidth() - s.Background.Padding.GetRight(),
+		Bottom: s.GetHeight() - s.Background.Padding.GetBottom(),
+	}
+}
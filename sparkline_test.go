@@ -0,0 +1,82 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestSparkline(t *testing.T) {
+	for _, sparklineType := range []SparklineType{SparklineTypeLine, SparklineTypeArea, SparklineTypeBar, SparklineTypeWinLoss} {
+		s := Sparkline{
+			Type:    sparklineType,
+			Markers: SparklineMarkerMin | SparklineMarkerMax | SparklineMarkerLast,
+			Values:  []float64{1, 3, -2, 4, 0, 2},
+		}
+
+		testutil.AssertNil(t, s.Render(PNG, bytes.NewBuffer([]byte{})))
+		testutil.AssertNil(t, s.Render(SVG, bytes.NewBuffer([]byte{})))
+	}
+}
+
+func TestSparklineGetX(t *testing.T) {
+	canvasBox := Box{Left: 2, Right: 102, Bottom: 20}
+
+	// the values span the canvas, and a single value is in the middle.
+	s := Sparkline{Values: []float64{1, 3, -2, 4, 0}}
+	testutil.AssertEqual(t, 2, s.getX(canvasBox, 0))
+	testutil.AssertEqual(t, 27, s.getX(canvasBox, 1))
+	testutil.AssertEqual(t, 102, s.getX(canvasBox, 4))
+
+	s.Values = []float64{1}
+	testutil.AssertEqual(t, 52, s.getX(canvasBox, 0))
+}
+
+func TestSparklineValidate(t *testing.T) {
+	s := Sparkline{}
+	testutil.AssertNotNil(t, s.Validate())
+
+	s.Values = []float64{1, math.NaN()}
+	testutil.AssertNotNil(t, s.Validate())
+
+	s.Values = []float64{1, 2}
+	testutil.AssertNil(t, s.Validate())
+}
+
+func TestSparklineCanvasBox(t *testing.T) {
+	// there is no padding by default, and lines are only inset to fit their markers.
+	s := Sparkline{Type: SparklineTypeBar}
+	testutil.AssertEqual(t, Box{Right: DefaultSparklineWidth, Bottom: DefaultSparklineHeight}, s.getCanvasBox())
+
+	s = Sparkline{Markers: SparklineMarkerLast}
+	testutil.AssertEqual(t, Box{Top: 2, Left: 2, Right: DefaultSparklineWidth - 2, Bottom: DefaultSparklineHeight - 2}, s.getCanvasBox())
+}
+
+func TestSparklineGetMarkerIndex(t *testing.T) {
+	s := Sparkline{Values: []float64{3, 1, 5, 1, 5, 2}}
+	testutil.AssertEqual(t, 1, s.getMarkerIndex(SparklineMarkerMin))
+	testutil.AssertEqual(t, 2, s.getMarkerIndex(SparklineMarkerMax))
+	testutil.AssertEqual(t, 5, s.getMarkerIndex(SparklineMarkerLast))
+}
+
+func TestSparklineGetRange(t *testing.T) {
+	canvasBox := Box{Right: 100, Bottom: 20}
+
+	s := Sparkline{Values: []float64{10, 12, 11}}
+	yr := s.getRange(canvasBox)
+	testutil.AssertEqual(t, 10.0, yr.GetMin())
+	testutil.AssertEqual(t, 12.0, yr.GetMax())
+
+	// bars are drawn from zero.
+	s.Type = SparklineTypeBar
+	yr = s.getRange(canvasBox)
+	testutil.AssertEqual(t, 0.0, yr.GetMin())
+
+	// a flat line is drawn through the middle.
+	s = Sparkline{Values: []float64{4, 4}}
+	yr = s.getRange(canvasBox)
+	testutil.AssertEqual(t, 3.0, yr.GetMin())
+	testutil.AssertEqual(t, 5.0, yr.GetMax())
+}
@@ -41,6 +41,9 @@ type StackedBarChart struct {
 	XAxis Style
 	YAxis Style
 
+	// XAxisLabelLayout determines how overlapping bar names are handled, i.e. wrapped, rotated or thinned.
+	XAxisLabelLayout TickLabelLayout
+
 	BarSpacing int
 
 	Font        *truetype.Font
@@ -277,25 +280,39 @@ func (sbc StackedBarChart) drawXAxis(r Renderer, canvasBox Box) {
 
 		cursor := canvasBox.Left
 		for _, bar := range sbc.Bars {
+			cursor += bar.GetWidth() + sbc.GetBarSpacing()
+			r.MoveTo(cursor, canvasBox.Bottom)
+			r.LineTo(cursor, canvasBox.Bottom+DefaultVerticalTickHeight)
+			r.Stroke()
+		}
 
-			barLabelBox := Box{
-				Top:    canvasBox.Bottom + DefaultXAxisMargin,
-				Left:   cursor,
-				Right:  cursor + bar.GetWidth() + sbc.GetBarSpacing(),
-				Bottom: sbc.GetHeight(),
-			}
-			if len(bar.Name) > 0 {
-				Draw.TextWithin(r, bar.Name, barLabelBox, axisStyle)
+		labels := sbc.getXAxisLabels(canvasBox)
+		plan := layoutTickLabels(r, labels, axisStyle, sbc.XAxisLabelLayout)
+		for index, label := range labels {
+			if plan.ShouldDraw(index) {
+				plan.Render(r, label, canvasBox.Bottom+DefaultXAxisMargin, axisStyle)
 			}
-			axisStyle.WriteToRenderer(r)
-			r.MoveTo(barLabelBox.Right, canvasBox.Bottom)
-			r.LineTo(barLabelBox.Right, canvasBox.Bottom+DefaultVerticalTickHeight)
-			r.Stroke()
-			cursor += bar.GetWidth() + sbc.GetBarSpacing()
 		}
 	}
 }
 
+// getXAxisLabels returns the bar names positioned under each bar.
+func (sbc StackedBarChart) getXAxisLabels(canvasBox Box) []tickLabel {
+	var labels []tickLabel
+	cursor := canvasBox.Left
+	for _, bar := range sbc.Bars {
+		if len(bar.Name) > 0 {
+			labels = append(labels, tickLabel{
+				Label: bar.Name,
+				Left:  cursor,
+				Right: cursor + bar.GetWidth() + sbc.GetBarSpacing(),
+			})
+		}
+		cursor += bar.GetWidth() + sbc.GetBarSpacing()
+	}
+	return labels
+}
+
 func (sbc StackedBarChart) drawHorizontalXAxis(r Renderer, canvasBox Box) {
 	if !sbc.XAxis.Hidden {
 		axisStyle := sbc.XAxis.InheritFrom(sbc.styleDefaultsAxes())
@@ -449,19 +466,12 @@ func (sbc StackedBarChart) getAdjustedCanvasBox(r Renderer, canvasBox Box) Box {
 		axisStyle := sbc.XAxis.InheritFrom(sbc.styleDefaultsAxes())
 		axisStyle.WriteToRenderer(r)
 
-		cursor := canvasBox.Left
-		for _, bar := range sbc.Bars {
-			if len(bar.Name) > 0 {
-				barLabelBox := Box{
-					Top:    canvasBox.Bottom + DefaultXAxisMargin,
-					Left:   cursor,
-					Right:  cursor + bar.GetWidth() + sbc.GetBarSpacing(),
-					Bottom: sbc.GetHeight(),
-				}
-				lines := Text.WrapFit(r, bar.Name, barLabelBox.Width(), axisStyle)
-				linesBox := Text.MeasureLines(r, lines, axisStyle)
-
-				xaxisHeight = MaxInt(linesBox.Height()+(2*DefaultXAxisMargin), xaxisHeight)
+		labels := sbc.getXAxisLabels(canvasBox)
+		plan := layoutTickLabels(r, labels, axisStyle, sbc.XAxisLabelLayout)
+		for index, label := range labels {
+			if plan.ShouldDraw(index) {
+				labelBox := plan.Measure(r, label, canvasBox.Bottom+DefaultXAxisMargin, axisStyle)
+				xaxisHeight = MaxInt(labelBox.Height()+(2*DefaultXAxisMargin), xaxisHeight)
 			}
 		}
 		return Box{
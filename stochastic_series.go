@@ -0,0 +1,99 @@
+package chart
+
+const (
+	// DefaultStochasticPeriod is the default number of periods the stochastic oscillator looks back over.
+	DefaultStochasticPeriod = 14
+)
+
+// Interface Assertions.
+var (
+	_ Series              = (*StochasticSeries)(nil)
+	_ FirstValuesProvider = (*StochasticSeries)(nil)
+	_ LastValuesProvider  = (*StochasticSeries)(nil)
+)
+
+// StochasticSeries computes the stochastic oscillator (%K) of a price series, where the close of each period is
+// between the lowest low and the highest high of the last periods, scaled to 0-100.
+//
+// The close is the value of the inner series; the high and low are the values of their series, or the close if
+// they aren't set. The signal line (%D) is a `SMASeries` of this series with a period of 3.
+type StochasticSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Period      int
+	InnerSeries ValuesProvider
+	HighSeries  ValuesProvider
+	LowSeries   ValuesProvider
+}
+
+// GetName returns the name of the time series.
+func (ss StochasticSeries) GetName() string {
+	return ss.Name
+}
+
+// GetStyle returns the line style.
+func (ss StochasticSeries) GetStyle() Style {
+	return ss.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ss StochasticSeries) GetYAxis() YAxisType {
+	return ss.YAxis
+}
+
+// GetPeriod returns the window size.
+func (ss StochasticSeries) GetPeriod() int {
+	if ss.Period == 0 {
+		return DefaultStochasticPeriod
+	}
+	return ss.Period
+}
+
+// Len returns the number of elements in the series.
+func (ss StochasticSeries) Len() int {
+	return ss.InnerSeries.Len()
+}
+
+// GetValues gets a value at a given index.
+func (ss StochasticSeries) GetValues(index int) (x, y float64) {
+	if ss.InnerSeries == nil {
+		return
+	}
+	x, _, _, close := getHighLowClose(ss.InnerSeries, ss.HighSeries, ss.LowSeries, index)
+	highest, lowest := getHighestLowest(ss.InnerSeries, ss.HighSeries, ss.LowSeries, index, ss.GetPeriod())
+	if highest == lowest {
+		y = 50
+		return
+	}
+	y = 100 * (close - lowest) / (highest - lowest)
+	return
+}
+
+// GetFirstValues returns the first value of the series.
+func (ss StochasticSeries) GetFirstValues() (x, y float64) {
+	if ss.InnerSeries == nil || ss.InnerSeries.Len() == 0 {
+		return
+	}
+	return ss.GetValues(0)
+}
+
+// GetLastValues returns the last value of the series.
+func (ss StochasticSeries) GetLastValues() (x, y float64) {
+	if ss.InnerSeries == nil || ss.InnerSeries.Len() == 0 {
+		return
+	}
+	return ss.GetValues(ss.InnerSeries.Len() - 1)
+}
+
+// Render renders the series.
+func (ss StochasticSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := ss.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, ss)
+}
+
+// Validate validates the series.
+func (ss StochasticSeries) Validate() error {
+	return validateIndicatorSeries("stochastic", ss.InnerSeries, ss.HighSeries, ss.LowSeries)
+}
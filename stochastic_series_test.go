@@ -0,0 +1,32 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestStochasticSeries(t *testing.T) {
+	ss := StochasticSeries{
+		InnerSeries: mockValuesProvider{X: []float64{1, 2, 3}, Y: []float64{10, 12, 11}},
+		HighSeries:  mockValuesProvider{X: []float64{1, 2, 3}, Y: []float64{11, 14, 12}},
+		LowSeries:   mockValuesProvider{X: []float64{1, 2, 3}, Y: []float64{9, 10, 10}},
+		Period:      2,
+	}
+
+	_, y := ss.GetValues(0)
+	testutil.AssertInDelta(t, 50.0, y, 0.0000001)
+	_, y = ss.GetValues(1)
+	testutil.AssertInDelta(t, 60.0, y, 0.0000001)
+	x, y := ss.GetLastValues()
+	testutil.AssertEqual(t, 3.0, x)
+	testutil.AssertInDelta(t, 25.0, y, 0.0000001)
+}
+
+func TestStochasticSeriesValidate(t *testing.T) {
+	testutil.AssertNotNil(t, StochasticSeries{}.Validate())
+	testutil.AssertNotNil(t, StochasticSeries{
+		InnerSeries: mockValuesProvider{X: []float64{1, 2, 3}, Y: []float64{10, 12, 11}},
+		HighSeries:  mockValuesProvider{X: []float64{1, 2}, Y: []float64{11, 14}},
+	}.Validate())
+}
@@ -0,0 +1,192 @@
+package chart
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Interface Assertions.
+var (
+	_ Series                 = (*StreamingSeries)(nil)
+	_ FirstValuesProvider    = (*StreamingSeries)(nil)
+	_ LastValuesProvider     = (*StreamingSeries)(nil)
+	_ ValueFormatterProvider = (*StreamingSeries)(nil)
+)
+
+// StreamingSeries is a time series backed by a pair of value buffers that
+// can be appended to by one goroutine while it is rendered from another.
+//
+// The window is bounded by `MaxLen` (the maximum number of values retained)
+// and / or `MaxAge` (the maximum age of a value relative to the most recently
+// added value); if neither is set the series grows without bound.
+//
+// Values must be added in ascending time order.
+type StreamingSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	MaxLen int
+	MaxAge time.Duration
+
+	lock    sync.RWMutex
+	xvalues *ValueBuffer
+	yvalues *ValueBuffer
+}
+
+// GetName returns the name of the time series.
+func (ss *StreamingSeries) GetName() string {
+	return ss.Name
+}
+
+// GetStyle returns the line style.
+func (ss *StreamingSeries) GetStyle() Style {
+	return ss.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (ss *StreamingSeries) GetYAxis() YAxisType {
+	return ss.YAxis
+}
+
+// Add appends a value to the series, trimming any values that fall outside the window.
+func (ss *StreamingSeries) Add(t time.Time, value float64) {
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+
+	ss.ensureBuffers()
+	ss.xvalues.Enqueue(TimeToFloat64(t))
+	ss.yvalues.Enqueue(value)
+	ss.trim()
+}
+
+// Clear removes all values from the series.
+func (ss *StreamingSeries) Clear() {
+	ss.lock.Lock()
+	defer ss.lock.Unlock()
+
+	ss.xvalues = nil
+	ss.yvalues = nil
+}
+
+// Len returns the number of elements in the series.
+func (ss *StreamingSeries) Len() int {
+	ss.lock.RLock()
+	defer ss.lock.RUnlock()
+
+	if ss.xvalues == nil {
+		return 0
+	}
+	return ss.xvalues.Len()
+}
+
+// GetValues gets x, y values at a given index.
+// Because the window can move between calls, the index is clamped to the current length;
+// use `Snapshot` when you need a consistent view across calls.
+func (ss *StreamingSeries) GetValues(index int) (x, y float64) {
+	ss.lock.RLock()
+	defer ss.lock.RUnlock()
+
+	if ss.xvalues == nil || ss.xvalues.Len() == 0 {
+		return
+	}
+	index = MaxInt(0, MinInt(index, ss.xvalues.Len()-1))
+	x = ss.xvalues.GetValue(index)
+	y = ss.yvalues.GetValue(index)
+	return
+}
+
+// GetFirstValues gets the first values.
+func (ss *StreamingSeries) GetFirstValues() (x, y float64) {
+	ss.lock.RLock()
+	defer ss.lock.RUnlock()
+
+	if ss.xvalues == nil || ss.xvalues.Len() == 0 {
+		return
+	}
+	x = ss.xvalues.Peek()
+	y = ss.yvalues.Peek()
+	return
+}
+
+// GetLastValues gets the last values.
+func (ss *StreamingSeries) GetLastValues() (x, y float64) {
+	ss.lock.RLock()
+	defer ss.lock.RUnlock()
+
+	if ss.xvalues == nil || ss.xvalues.Len() == 0 {
+		return
+	}
+	x = ss.xvalues.PeekBack()
+	y = ss.yvalues.PeekBack()
+	return
+}
+
+// GetValueFormatters returns value formatter defaults for the series.
+func (ss *StreamingSeries) GetValueFormatters() (x, y ValueFormatter) {
+	x = TimeValueFormatter
+	y = FloatValueFormatter
+	return
+}
+
+// Snapshot returns a copy of the current window as a time series.
+// The snapshot is not affected by subsequent calls to `Add` and can be used
+// as the `InnerSeries` for computed series like `SMASeries` or `BollingerBandsSeries`.
+func (ss *StreamingSeries) Snapshot() TimeSeries {
+	ss.lock.RLock()
+	defer ss.lock.RUnlock()
+
+	ts := TimeSeries{
+		Name:  ss.Name,
+		Style: ss.Style,
+		YAxis: ss.YAxis,
+	}
+	if ss.xvalues == nil {
+		return ts
+	}
+
+	ts.XValues = make([]time.Time, ss.xvalues.Len())
+	ss.xvalues.Each(func(index int, v float64) {
+		ts.XValues[index] = TimeFromFloat64(v)
+	})
+	ts.YValues = ss.yvalues.Array()
+	return ts
+}
+
+// Render renders the series.
+func (ss *StreamingSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	ss.Snapshot().Render(r, canvasBox, xrange, yrange, defaults)
+}
+
+// Validate validates the series.
+func (ss *StreamingSeries) Validate() error {
+	if ss.Len() == 0 {
+		return fmt.Errorf("streaming series must have values added")
+	}
+	return nil
+}
+
+func (ss *StreamingSeries) ensureBuffers() {
+	if ss.xvalues == nil {
+		ss.xvalues = NewValueBufferWithCapacity(ss.MaxLen)
+		ss.yvalues = NewValueBufferWithCapacity(ss.MaxLen)
+	}
+}
+
+// trim drops values that fall outside the window; it must be called with the lock held.
+func (ss *StreamingSeries) trim() {
+	if ss.MaxLen > 0 {
+		for ss.xvalues.Len() > ss.MaxLen {
+			ss.xvalues.Dequeue()
+			ss.yvalues.Dequeue()
+		}
+	}
+	if ss.MaxAge > 0 {
+		cutoff := ss.xvalues.PeekBack() - float64(ss.MaxAge)
+		for ss.xvalues.Len() > 0 && ss.xvalues.Peek() < cutoff {
+			ss.xvalues.Dequeue()
+			ss.yvalues.Dequeue()
+		}
+	}
+}
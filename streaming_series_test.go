@@ -0,0 +1,101 @@
+package chart
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestStreamingSeriesMaxLen(t *testing.T) {
+	ss := &StreamingSeries{MaxLen: 5}
+	start := time.Date(2020, 01, 01, 12, 00, 00, 00, time.UTC)
+	for x := 0; x < 10; x++ {
+		ss.Add(start.Add(time.Duration(x)*time.Second), float64(x))
+	}
+	testutil.AssertEqual(t, 5, ss.Len())
+
+	_, y0 := ss.GetFirstValues()
+	testutil.AssertEqual(t, 5.0, y0)
+	_, yn := ss.GetLastValues()
+	testutil.AssertEqual(t, 9.0, yn)
+}
+
+func TestStreamingSeriesMaxAge(t *testing.T) {
+	ss := &StreamingSeries{MaxAge: 3 * time.Second}
+	start := time.Date(2020, 01, 01, 12, 00, 00, 00, time.UTC)
+	for x := 0; x < 10; x++ {
+		ss.Add(start.Add(time.Duration(x)*time.Second), float64(x))
+	}
+	testutil.AssertEqual(t, 4, ss.Len())
+
+	_, y0 := ss.GetFirstValues()
+	testutil.AssertEqual(t, 6.0, y0)
+}
+
+func TestStreamingSeriesSnapshot(t *testing.T) {
+	ss := &StreamingSeries{Name: "test", MaxLen: 3}
+	start := time.Date(2020, 01, 01, 12, 00, 00, 00, time.UTC)
+	for x := 0; x < 4; x++ {
+		ss.Add(start.Add(time.Duration(x)*time.Second), float64(x))
+	}
+
+	snapshot := ss.Snapshot()
+	testutil.AssertEqual(t, "test", snapshot.Name)
+	testutil.AssertLen(t, snapshot.XValues, 3)
+	testutil.AssertEqual(t, []float64{1, 2, 3}, snapshot.YValues)
+	testutil.AssertTrue(t, snapshot.XValues[0].Equal(start.Add(time.Second)))
+
+	ss.Add(start.Add(4*time.Second), 4)
+	testutil.AssertEqual(t, []float64{1, 2, 3}, snapshot.YValues)
+}
+
+func TestStreamingSeriesEmpty(t *testing.T) {
+	ss := &StreamingSeries{}
+	testutil.AssertZero(t, ss.Len())
+	testutil.AssertNotNil(t, ss.Validate())
+
+	x, y := ss.GetValues(0)
+	testutil.AssertZero(t, x)
+	testutil.AssertZero(t, y)
+}
+
+func TestStreamingSeriesConcurrentRender(t *testing.T) {
+	ss := &StreamingSeries{MaxLen: 64}
+	start := time.Now()
+	for x := 0; x < 32; x++ {
+		ss.Add(start.Add(time.Duration(x)*time.Second), float64(x%7))
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for x := 32; ; x++ {
+			select {
+			case <-done:
+				return
+			default:
+				ss.Add(start.Add(time.Duration(x)*time.Second), float64(x%7))
+			}
+		}
+	}()
+
+	for x := 0; x < 5; x++ {
+		snapshot := ss.Snapshot()
+		graph := Chart{
+			Series: []Series{
+				ss,
+				&SMASeries{InnerSeries: snapshot},
+				&BollingerBandsSeries{InnerSeries: snapshot},
+			},
+		}
+		buffer := bytes.NewBuffer([]byte{})
+		testutil.AssertNil(t, graph.Render(PNG, buffer))
+	}
+	close(done)
+	wg.Wait()
+}
@@ -138,6 +138,65 @@ func (t text) WrapFitRune(r Renderer, value string, width int, style Style) []st
 	return t.appendLast(output, line)
 }
 
+// WrapFitTruncate wraps the value to the width like WrapFit and keeps as many lines as fit within the height;
+// lines that are still too wide, and the last line kept when lines are dropped, are truncated with an ellipsis.
+func (t text) WrapFitTruncate(r Renderer, value string, width, height int, style Style) []string {
+	lines := t.WrapFit(r, value, width, style)
+	style.WriteTextOptionsToRenderer(r)
+
+	var output []string
+	var total int
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		lineHeight := r.MeasureText(line).Height()
+		if len(output) > 0 {
+			lineHeight += style.GetTextLineSpacing()
+		}
+		if total+lineHeight > height {
+			if len(output) > 0 {
+				output[len(output)-1] = t.ellipsize(r, output[len(output)-1], width)
+			}
+			break
+		}
+		total += lineHeight
+		output = append(output, t.Truncate(r, line, width, style))
+	}
+
+	// drop trailing lines that were truncated away entirely.
+	for len(output) > 0 && output[len(output)-1] == "" {
+		output = output[:len(output)-1]
+	}
+	return output
+}
+
+// Truncate shortens the value until it is narrower than the width, ending it with an ellipsis;
+// it returns an empty string if not even the ellipsis fits.
+func (t text) Truncate(r Renderer, value string, width int, style Style) string {
+	style.WriteTextOptionsToRenderer(r)
+	if r.MeasureText(value).Width() < width {
+		return value
+	}
+	return t.ellipsize(r, value, width)
+}
+
+// ellipsize removes characters from the end of the value until it and an ellipsis are narrower than the width;
+// it assumes the text options are already written to the renderer.
+func (t text) ellipsize(r Renderer, value string, width int) string {
+	runes := []rune(value)
+	for {
+		candidate := strings.TrimRight(string(runes), " \t") + DefaultTextEllipsis
+		if r.MeasureText(candidate).Width() < width {
+			return candidate
+		}
+		if len(runes) == 0 {
+			return ""
+		}
+		runes = runes[:len(runes)-1]
+	}
+}
+
 func (t text) Trim(value string) string {
 	return strings.Trim(value, " \t\n\r")
 }
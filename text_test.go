@@ -1,6 +1,7 @@
 package chart
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/wcharczuk/go-chart/v2/testutil"
@@ -58,3 +59,42 @@ func TestTextWrapRune(t *testing.T) {
 	testutil.AssertEqual(t, "this is a t", output[0])
 	testutil.AssertEqual(t, "est string", output[1])
 }
+
+func TestTextTruncate(t *testing.T) {
+	r, err := PNG(1024, 1024)
+	testutil.AssertNil(t, err)
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	basicTextStyle := Style{Font: f, FontSize: 24}
+
+	testutil.AssertEqual(t, "foo", Text.Truncate(r, "foo", 100, basicTextStyle))
+
+	output := Text.Truncate(r, "this is a test string", 100, basicTextStyle)
+	testutil.AssertTrue(t, strings.HasSuffix(output, DefaultTextEllipsis))
+	basicTextStyle.WriteToRenderer(r)
+	testutil.AssertTrue(t, r.MeasureText(output).Width() < 100)
+
+	testutil.AssertEqual(t, "", Text.Truncate(r, "this is a test string", 1, basicTextStyle))
+}
+
+func TestTextWrapFitTruncate(t *testing.T) {
+	r, err := PNG(1024, 1024)
+	testutil.AssertNil(t, err)
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	basicTextStyle := Style{Font: f, FontSize: 24, TextWrap: TextWrapWord}
+
+	output := Text.WrapFitTruncate(r, "this is a test string", 100, 1000, basicTextStyle)
+	testutil.AssertLen(t, output, 3)
+	testutil.AssertEqual(t, "string", output[2])
+
+	// only two lines fit, so the second is truncated.
+	lineHeight := Text.MeasureLines(r, []string{"this is"}, basicTextStyle).Height()
+	output = Text.WrapFitTruncate(r, "this is a test string", 100, 2*lineHeight+basicTextStyle.GetTextLineSpacing(), basicTextStyle)
+	testutil.AssertLen(t, output, 2)
+	testutil.AssertTrue(t, strings.HasSuffix(output[1], DefaultTextEllipsis))
+
+	testutil.AssertEmpty(t, Text.WrapFitTruncate(r, "this is a test string", 100, 1, basicTextStyle))
+}
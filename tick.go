@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 )
 
 // TicksProvider is a type that provides ticks.
@@ -113,3 +114,123 @@ func GenerateContinuousTicks(r Renderer, ra Range, isVertical bool, style Style,
 
 	return ticks
 }
+
+// timeTickStep is a step between time ticks; steps of a month or more are in calendar months.
+type timeTickStep struct {
+	Duration time.Duration
+	Months   int
+	Format   string
+}
+
+// timeTickSteps are the steps time ticks can be generated at, from smallest to largest.
+var timeTickSteps = []timeTickStep{
+	{Duration: time.Second, Format: "15:04:05"},
+	{Duration: 5 * time.Second, Format: "15:04:05"},
+	{Duration: 15 * time.Second, Format: "15:04:05"},
+	{Duration: 30 * time.Second, Format: "15:04:05"},
+	{Duration: time.Minute, Format: "15:04"},
+	{Duration: 5 * time.Minute, Format: "15:04"},
+	{Duration: 15 * time.Minute, Format: "15:04"},
+	{Duration: 30 * time.Minute, Format: "15:04"},
+	{Duration: time.Hour, Format: "15:04"},
+	{Duration: 3 * time.Hour, Format: "Jan 2 15:04"},
+	{Duration: 6 * time.Hour, Format: "Jan 2 15:04"},
+	{Duration: 12 * time.Hour, Format: "Jan 2 15:04"},
+	{Duration: 24 * time.Hour, Format: "Jan 2"},
+	{Duration: 2 * 24 * time.Hour, Format: "Jan 2"},
+	{Duration: 7 * 24 * time.Hour, Format: "Jan 2"},
+	{Months: 1, Format: "Jan 2006"},
+	{Months: 3, Format: "Jan 2006"},
+	{Months: 6, Format: "Jan 2006"},
+	{Months: 12, Format: "2006"},
+	{Months: 24, Format: "2006"},
+	{Months: 60, Format: "2006"},
+	{Months: 120, Format: "2006"},
+}
+
+// GenerateTimeTicks generates ticks for a range of times, as nanoseconds, on round times such as
+// every 15 minutes, at midnight or on the first of the month; the step is the smallest that leaves room for the labels.
+// If the value formatter is nil the labels are formatted for the step, i.e. with the time of day for steps under a day.
+func GenerateTimeTicks(r Renderer, ra Range, style Style, vf ValueFormatter) []Tick {
+	min, max := TimeFromFloat64(ra.GetMin()), TimeFromFloat64(ra.GetMax())
+	if !max.After(min) {
+		return nil
+	}
+	style.GetTextOptions().WriteToRenderer(r)
+
+	step := timeTickSteps[len(timeTickSteps)-1]
+	for _, candidate := range timeTickSteps {
+		format := candidate.getFormatter(vf)
+		tickSize := float64(r.MeasureText(format(ra.GetMin())).Width() + DefaultMinimumTickHorizontalSpacing)
+		if candidate.count(min, max) <= int(math.Floor(float64(ra.GetDomain())/tickSize)) {
+			step = candidate
+			break
+		}
+	}
+
+	format := step.getFormatter(vf)
+	var ticks []Tick
+	for t := step.start(min); !t.After(max) && len(ticks) < DefaultTickCountSanityCheck; t = step.next(t) {
+		value := TimeToFloat64(t)
+		ticks = append(ticks, Tick{Value: value, Label: format(value)})
+	}
+	return ticks
+}
+
+func (ts timeTickStep) getFormatter(vf ValueFormatter) ValueFormatter {
+	if vf != nil {
+		return vf
+	}
+	return TimeValueFormatterWithFormat(ts.Format)
+}
+
+// count returns roughly how many ticks the step puts between two times.
+func (ts timeTickStep) count(min, max time.Time) int {
+	if ts.Months > 0 {
+		months := (max.Year()-min.Year())*12 + int(max.Month()-min.Month())
+		return months/ts.Months + 1
+	}
+	return int(max.Sub(min)/ts.Duration) + 1
+}
+
+// start returns the first round time for the step at or after a time.
+func (ts timeTickStep) start(t time.Time) time.Time {
+	if ts.Months > 0 {
+		month := ((int(t.Month())-1)/MinInt(ts.Months, 12))*MinInt(ts.Months, 12) + 1
+		start := time.Date(t.Year(), time.Month(month), 1, 0, 0, 0, 0, t.Location())
+		if ts.Months > 12 {
+			years := ts.Months / 12
+			start = time.Date((t.Year()/years)*years, time.January, 1, 0, 0, 0, 0, t.Location())
+		}
+		for start.Before(t) {
+			start = ts.next(start)
+		}
+		return start
+	}
+
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	if ts.Duration >= 7*24*time.Hour {
+		// weeks start on monday.
+		midnight = midnight.AddDate(0, 0, -((int(midnight.Weekday()) + 6) % 7))
+	}
+	if ts.Duration >= 24*time.Hour {
+		start := midnight
+		for start.Before(t) {
+			start = ts.next(start)
+		}
+		return start
+	}
+	steps := (t.Sub(midnight) + ts.Duration - 1) / ts.Duration
+	return midnight.Add(steps * ts.Duration)
+}
+
+// next returns the round time a step after a time.
+func (ts timeTickStep) next(t time.Time) time.Time {
+	if ts.Months > 0 {
+		return t.AddDate(0, ts.Months, 0)
+	}
+	if ts.Duration >= 24*time.Hour {
+		return t.AddDate(0, 0, int(ts.Duration/(24*time.Hour)))
+	}
+	return t.Add(ts.Duration)
+}
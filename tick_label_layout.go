@@ -0,0 +1,257 @@
+package chart
+
+import (
+	"math"
+	"strings"
+)
+
+// TickLabelLayout is an enum for how an axis resolves overlapping tick labels.
+type TickLabelLayout int
+
+const (
+	// TickLabelLayoutUnset is the unset state for tick label layout; it behaves like `TickLabelLayoutAuto`.
+	TickLabelLayoutUnset TickLabelLayout = 0
+	// TickLabelLayoutNone draws every label as is, even if labels overlap.
+	TickLabelLayoutNone TickLabelLayout = 1
+	// TickLabelLayoutAuto wraps labels that sit between ticks, then rotates labels 45 and then 90 degrees,
+	// then draws only every nth label, stopping at the first option where labels don't overlap.
+	TickLabelLayoutAuto TickLabelLayout = 2
+	// TickLabelLayoutRotate rotates labels 45 or 90 degrees, drawing only every nth label if they still overlap.
+	TickLabelLayoutRotate TickLabelLayout = 3
+	// TickLabelLayoutThin draws only every nth label, leaving labels horizontal.
+	TickLabelLayoutThin TickLabelLayout = 4
+	// TickLabelLayoutWrap wraps labels to the space between ticks, drawing only every nth label if they still overlap.
+	TickLabelLayoutWrap TickLabelLayout = 5
+)
+
+// tickLabel is a label positioned along a horizontal axis.
+// Labels centered under a tick have `Left == Right`, labels drawn between ticks span the slot from `Left` to `Right`.
+type tickLabel struct {
+	Label       string
+	Left, Right int
+}
+
+func (tl tickLabel) center() int {
+	return tl.Left + ((tl.Right - tl.Left) >> 1)
+}
+
+func (tl tickLabel) isSlotted() bool {
+	return tl.Right > tl.Left
+}
+
+// tickLabelPlan is the result of laying out a set of tick labels.
+type tickLabelPlan struct {
+	// RotationDegrees is the text rotation for the labels; auto rotated labels turn counter-clockwise.
+	RotationDegrees float64
+	// Wrap is set if slotted labels should be wrapped to their slot.
+	Wrap bool
+	// Step is the interval of labels to draw, i.e. 2 draws every other label.
+	Step int
+}
+
+// ShouldDraw returns if the label at a given index (amongst the labels laid out) should be drawn.
+func (tlp tickLabelPlan) ShouldDraw(index int) bool {
+	return tlp.Step <= 1 || index%tlp.Step == 0
+}
+
+// Measure returns the bounds of a label drawn with its top edge at a given y.
+func (tlp tickLabelPlan) Measure(r Renderer, tl tickLabel, top int, style Style) Box {
+	if tlp.RotationDegrees != 0 {
+		tb := Draw.MeasureText(r, tl.Label, tlp.textStyle(style))
+		x, y := tlp.rotatedAnchor(tl, top, tb)
+		return tlp.rotatedBox(x, y, tb)
+	}
+
+	var tb Box
+	if tl.isSlotted() {
+		finalStyle := tlp.textStyle(style)
+		tb = Text.MeasureLines(r, Text.WrapFit(r, tl.Label, tl.Right-tl.Left, finalStyle), finalStyle)
+	} else {
+		tb = Draw.MeasureText(r, tl.Label, style)
+	}
+	left := tl.center() - tb.Width()>>1
+	return Box{
+		Top:    top,
+		Left:   left,
+		Right:  left + tb.Width(),
+		Bottom: top + tb.Height(),
+	}
+}
+
+// Render draws a label with its top edge at a given y and returns the label bounds.
+func (tlp tickLabelPlan) Render(r Renderer, tl tickLabel, top int, style Style) Box {
+	bounds := tlp.Measure(r, tl, top, style)
+	if tlp.RotationDegrees != 0 {
+		textStyle := tlp.textStyle(style)
+		x, y := tlp.rotatedAnchor(tl, top, Draw.MeasureText(r, tl.Label, textStyle))
+		Draw.Text(r, tl.Label, x, y, textStyle.InheritFrom(Style{TextRotationDegrees: tlp.RotationDegrees}))
+		return bounds
+	}
+	if tl.isSlotted() {
+		Draw.TextWithin(r, tl.Label, Box{
+			Top:    top,
+			Left:   tl.Left,
+			Right:  tl.Right,
+			Bottom: top,
+		}, tlp.textStyle(style).InheritFrom(Style{TextHorizontalAlign: TextHorizontalAlignCenter}))
+		return bounds
+	}
+	if style.TextRotationDegrees != 0 {
+		Draw.Text(r, tl.Label, tl.center(), top+DefaultXAxisMargin, style)
+		return bounds
+	}
+	Draw.Text(r, tl.Label, bounds.Left, bounds.Bottom, style)
+	return bounds
+}
+
+// textStyle returns the style used to measure and draw the (unrotated) label text.
+func (tlp tickLabelPlan) textStyle(style Style) Style {
+	if tlp.Wrap && (style.TextWrap == TextWrapUnset || style.TextWrap == TextWrapNone) {
+		style.TextWrap = TextWrapWord
+	}
+	if tlp.RotationDegrees != 0 {
+		style.TextRotationDegrees = 0
+	}
+	return style
+}
+
+// rotatedAnchor returns the baseline start of a counter-clockwise rotated label so that
+// the end of the label sits under the tick (or slot center) and its highest point is at `top`.
+func (tlp tickLabelPlan) rotatedAnchor(tl tickLabel, top int, tb Box) (x, y int) {
+	theta := DegreesToRadians(-tlp.RotationDegrees)
+	w, h := float64(tb.Width()), float64(tb.Height())
+	x = tl.center() - int(math.Round(w*math.Cos(theta)-(h/2)*math.Sin(theta)))
+	y = top + int(math.Round(w*math.Sin(theta)+h*math.Cos(theta)))
+	return
+}
+
+// rotatedBox returns the bounds of a rotated label drawn from a given anchor.
+func (tlp tickLabelPlan) rotatedBox(x, y int, tb Box) Box {
+	theta := DegreesToRadians(tlp.RotationDegrees)
+	w, h := float64(tb.Width()), float64(tb.Height())
+	dx, dy := w*math.Cos(theta), w*math.Sin(theta)
+	ux, uy := h*math.Sin(theta), -h*math.Cos(theta)
+
+	xs := []float64{0, dx, ux, dx + ux}
+	ys := []float64{0, dy, uy, dy + uy}
+	minx, maxx := MinMax(xs...)
+	miny, maxy := MinMax(ys...)
+	return Box{
+		Top:    y + int(math.Floor(miny)),
+		Left:   x + int(math.Floor(minx)),
+		Right:  x + int(math.Ceil(maxx)),
+		Bottom: y + int(math.Ceil(maxy)),
+	}
+}
+
+// layoutTickLabels picks how to draw a set of labels, ordered left to right, so they don't overlap.
+func layoutTickLabels(r Renderer, labels []tickLabel, style Style, layout TickLabelLayout) tickLabelPlan {
+	plan := tickLabelPlan{Step: 1}
+	if layout == TickLabelLayoutNone || len(labels) < 2 {
+		return plan
+	}
+
+	canWrap := true
+	for _, tl := range labels {
+		canWrap = canWrap && tl.isSlotted()
+	}
+	canRotate := style.TextRotationDegrees == 0
+
+	wrapped := canWrap && style.GetTextWrap() != TextWrapUnset && style.GetTextWrap() != TextWrapNone
+	widths := measureTickLabelWidths(r, labels, style, wrapped)
+	gap := DefaultMinimumTickLabelSpacing
+	if wrapped {
+		gap = 0
+	}
+	if (!wrapped || tickLabelWordsFit(r, labels, style)) && tickLabelsStep(labels, widths, gap) == 1 {
+		plan.Wrap = wrapped
+		return plan
+	}
+
+	if canWrap && !wrapped && (layout == TickLabelLayoutAuto || layout == TickLabelLayoutUnset || layout == TickLabelLayoutWrap) {
+		wrappedWidths := measureTickLabelWidths(r, labels, style, true)
+		if layout == TickLabelLayoutWrap || (tickLabelWordsFit(r, labels, style) && tickLabelsStep(labels, wrappedWidths, 0) == 1) {
+			plan.Wrap = true
+			plan.Step = tickLabelsStep(labels, wrappedWidths, 0)
+			return plan
+		}
+	}
+
+	if canRotate && layout != TickLabelLayoutThin && layout != TickLabelLayoutWrap {
+		var height int
+		for _, tl := range labels {
+			height = MaxInt(height, Draw.MeasureText(r, tl.Label, style).Height())
+		}
+		for _, degrees := range []float64{45, 90} {
+			plan.RotationDegrees = -degrees
+			plan.Step = tickLabelsRotatedStep(labels, height, degrees)
+			if plan.Step == 1 {
+				return plan
+			}
+		}
+		return plan
+	}
+
+	plan.Wrap = wrapped
+	plan.Step = tickLabelsStep(labels, widths, gap)
+	return plan
+}
+
+// measureTickLabelWidths returns the drawn width of each label.
+func measureTickLabelWidths(r Renderer, labels []tickLabel, style Style, wrap bool) []int {
+	plan := tickLabelPlan{Wrap: wrap}
+	widths := make([]int, len(labels))
+	for index, tl := range labels {
+		widths[index] = plan.Measure(r, tl, 0, style).Width()
+	}
+	return widths
+}
+
+// tickLabelWordsFit returns if every word of every label fits within the label's slot,
+// i.e. if word wrapping the labels won't split words.
+func tickLabelWordsFit(r Renderer, labels []tickLabel, style Style) bool {
+	if style.TextWrap == TextWrapRune {
+		return true
+	}
+	for _, tl := range labels {
+		for _, word := range strings.Fields(tl.Label) {
+			if Draw.MeasureText(r, word, style).Width() >= tl.Right-tl.Left {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// tickLabelsStep returns the smallest step between drawn labels such that no two drawn labels
+// are closer than `gap` pixels.
+func tickLabelsStep(labels []tickLabel, widths []int, gap int) int {
+	return tickLabelsFindStep(labels, func(a, b int) bool {
+		return labels[b].center()-labels[a].center() >= ((widths[a]+widths[b])>>1)+gap
+	})
+}
+
+// tickLabelsRotatedStep returns the smallest step between drawn labels such that labels
+// of a given line height rotated a given number of degrees don't overlap.
+func tickLabelsRotatedStep(labels []tickLabel, height int, degrees float64) int {
+	sin := math.Sin(DegreesToRadians(degrees))
+	return tickLabelsFindStep(labels, func(a, b int) bool {
+		return float64(labels[b].center()-labels[a].center())*sin >= float64(height+DefaultMinimumTickLabelSpacing)
+	})
+}
+
+func tickLabelsFindStep(labels []tickLabel, fits func(a, b int) bool) int {
+	for step := 1; step < len(labels); step++ {
+		ok := true
+		for index := 0; index+step < len(labels); index += step {
+			if !fits(index, index+step) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return step
+		}
+	}
+	return len(labels)
+}
@@ -0,0 +1,107 @@
+package chart
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func testTickLabels(count, spacing, slotWidth int, format string) []tickLabel {
+	var labels []tickLabel
+	for index := 0; index < count; index++ {
+		center := 50 + index*spacing
+		labels = append(labels, tickLabel{
+			Label: fmt.Sprintf(format, index),
+			Left:  center - slotWidth>>1,
+			Right: center + slotWidth>>1,
+		})
+	}
+	return labels
+}
+
+func testTickLabelStyle(t *testing.T) (Renderer, Style) {
+	r, err := PNG(1024, 1024)
+	testutil.AssertNil(t, err)
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	return r, Style{Font: f, FontSize: 10.0}
+}
+
+func TestLayoutTickLabelsNoCollision(t *testing.T) {
+	r, style := testTickLabelStyle(t)
+
+	plan := layoutTickLabels(r, testTickLabels(5, 100, 0, "label %d"), style, TickLabelLayoutUnset)
+	testutil.AssertEqual(t, 0.0, plan.RotationDegrees)
+	testutil.AssertEqual(t, 1, plan.Step)
+	testutil.AssertFalse(t, plan.Wrap)
+}
+
+func TestLayoutTickLabelsRotate(t *testing.T) {
+	r, style := testTickLabelStyle(t)
+
+	plan := layoutTickLabels(r, testTickLabels(10, 30, 0, "a long label %d"), style, TickLabelLayoutAuto)
+	testutil.AssertEqual(t, -45.0, plan.RotationDegrees)
+	testutil.AssertEqual(t, 1, plan.Step)
+
+	plan = layoutTickLabels(r, testTickLabels(10, 22, 0, "a long label %d"), style, TickLabelLayoutRotate)
+	testutil.AssertEqual(t, -90.0, plan.RotationDegrees)
+	testutil.AssertEqual(t, 1, plan.Step)
+
+	plan = layoutTickLabels(r, testTickLabels(10, 5, 0, "a long label %d"), style, TickLabelLayoutRotate)
+	testutil.AssertEqual(t, -90.0, plan.RotationDegrees)
+	testutil.AssertTrue(t, plan.Step > 1)
+	testutil.AssertTrue(t, plan.ShouldDraw(0))
+	testutil.AssertFalse(t, plan.ShouldDraw(1))
+}
+
+func TestLayoutTickLabelsThin(t *testing.T) {
+	r, style := testTickLabelStyle(t)
+
+	labels := testTickLabels(10, 30, 0, "a long label %d")
+	plan := layoutTickLabels(r, labels, style, TickLabelLayoutThin)
+	testutil.AssertEqual(t, 0.0, plan.RotationDegrees)
+	testutil.AssertTrue(t, plan.Step > 1)
+
+	widths := measureTickLabelWidths(r, labels, style, false)
+	for index := 0; index+plan.Step < len(labels); index += plan.Step {
+		a, b := labels[index], labels[index+plan.Step]
+		testutil.AssertTrue(t, b.center()-a.center() >= (widths[index]+widths[index+plan.Step])>>1)
+	}
+}
+
+func TestLayoutTickLabelsWrap(t *testing.T) {
+	r, style := testTickLabelStyle(t)
+
+	plan := layoutTickLabels(r, testTickLabels(6, 60, 60, "word word %d"), style, TickLabelLayoutAuto)
+	testutil.AssertTrue(t, plan.Wrap)
+	testutil.AssertEqual(t, 0.0, plan.RotationDegrees)
+	testutil.AssertEqual(t, 1, plan.Step)
+
+	// words that don't fit the slot can't be wrapped, so fall back to rotating.
+	plan = layoutTickLabels(r, testTickLabels(6, 60, 60, "longcategoryname%d"), style, TickLabelLayoutAuto)
+	testutil.AssertFalse(t, plan.Wrap)
+	testutil.AssertNotEqual(t, 0.0, plan.RotationDegrees)
+}
+
+func TestLayoutTickLabelsNone(t *testing.T) {
+	r, style := testTickLabelStyle(t)
+
+	plan := layoutTickLabels(r, testTickLabels(10, 5, 0, "a long label %d"), style, TickLabelLayoutNone)
+	testutil.AssertEqual(t, 0.0, plan.RotationDegrees)
+	testutil.AssertEqual(t, 1, plan.Step)
+}
+
+func TestTickLabelPlanMeasureRotated(t *testing.T) {
+	r, style := testTickLabelStyle(t)
+
+	tl := tickLabel{Label: "a long label", Left: 200, Right: 200}
+	horizontal := tickLabelPlan{}.Measure(r, tl, 100, style)
+	vertical := tickLabelPlan{RotationDegrees: -90}.Measure(r, tl, 100, style)
+
+	testutil.AssertEqual(t, 100, vertical.Top)
+	testutil.AssertInDelta(t, float64(horizontal.Width()), float64(vertical.Height()), 1)
+	testutil.AssertInDelta(t, float64(horizontal.Height()), float64(vertical.Width()), 1)
+	cx, _ := vertical.Center()
+	testutil.AssertInDelta(t, 200, float64(cx), 1)
+}
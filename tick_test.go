@@ -2,6 +2,7 @@ package chart
 
 import (
 	"testing"
+	"time"
 
 	"github.com/wcharczuk/go-chart/v2/testutil"
 )
@@ -58,3 +59,61 @@ func TestGenerateContinuousTicksDescending(t *testing.T) {
 	testutil.AssertEqual(t, 1.0, ticks[len(ticks)-2].Value)
 	testutil.AssertEqual(t, 0.0, ticks[len(ticks)-1].Value)
 }
+
+func TestGenerateTimeTicks(t *testing.T) {
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	r, err := PNG(1024, 1024)
+	testutil.AssertNil(t, err)
+	r.SetFont(f)
+
+	start := time.Date(2024, time.March, 12, 9, 7, 0, 0, time.Local)
+	ra := &ContinuousRange{
+		Min:    TimeToFloat64(start),
+		Max:    TimeToFloat64(start.Add(2*time.Hour + 33*time.Minute)),
+		Domain: 800,
+	}
+
+	// the ticks fall on round times after the start of the range, labeled with the time of day.
+	ticks := GenerateTimeTicks(r, ra, Style{Font: f, FontSize: DefaultAxisFontSize}, nil)
+	testutil.AssertNotEmpty(t, ticks)
+	first := TimeFromFloat64(ticks[0].Value)
+	testutil.AssertEqual(t, 9, first.Hour())
+	testutil.AssertEqual(t, 15, first.Minute())
+	testutil.AssertEqual(t, "09:15", ticks[0].Label)
+
+	step := ticks[1].Value - ticks[0].Value
+	for index := 1; index < len(ticks); index++ {
+		testutil.AssertEqual(t, step, ticks[index].Value-ticks[index-1].Value)
+	}
+	testutil.AssertTrue(t, ticks[len(ticks)-1].Value <= ra.Max)
+
+	// a narrower domain takes a bigger step.
+	ra.Domain = 200
+	testutil.AssertTrue(t, len(GenerateTimeTicks(r, ra, Style{Font: f, FontSize: DefaultAxisFontSize}, nil)) < len(ticks))
+}
+
+func TestGenerateTimeTicksMonths(t *testing.T) {
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	r, err := PNG(1024, 1024)
+	testutil.AssertNil(t, err)
+	r.SetFont(f)
+
+	ra := &ContinuousRange{
+		Min:    TimeToFloat64(time.Date(2024, time.January, 15, 0, 0, 0, 0, time.Local)),
+		Max:    TimeToFloat64(time.Date(2024, time.December, 20, 0, 0, 0, 0, time.Local)),
+		Domain: 400,
+	}
+
+	ticks := GenerateTimeTicks(r, ra, Style{Font: f, FontSize: DefaultAxisFontSize}, TimeDateValueFormatter)
+	testutil.AssertNotEmpty(t, ticks)
+	for _, tick := range ticks {
+		tt := TimeFromFloat64(tick.Value)
+		testutil.AssertEqual(t, 1, tt.Day())
+		testutil.AssertEqual(t, tt.Format("2006-01-02"), tick.Label)
+	}
+	testutil.AssertEqual(t, time.April, TimeFromFloat64(ticks[0].Value).Month())
+}
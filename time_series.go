@@ -72,6 +72,14 @@ func (ts TimeSeries) GetYAxis() YAxisType {
 	return ts.YAxis
 }
 
+// Resample returns the series with its values bucketed into intervals and combined by an aggregator, with the
+// empty intervals filled by a fill; see `Resample`.
+func (ts TimeSeries) Resample(interval ResampleInterval, aggregator ResampleAggregator, fill ResampleFill) TimeSeries {
+	resampled := ts
+	resampled.XValues, resampled.YValues = Resample(ts.XValues, ts.YValues, interval, aggregator, fill)
+	return resampled
+}
+
 // Render renders the series.
 func (ts TimeSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
 	style := ts.Style.InheritFrom(defaults)
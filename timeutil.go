@@ -133,18 +133,7 @@ func Hours(start time.Time, totalHours int) []time.Time {
 }
 
 // HoursFilled adds zero values for the data bounded by the start and end of the xdata array.
+// It resamples the data by the hour, so the times are on the hour and the last value of each hour is kept.
 func HoursFilled(xdata []time.Time, ydata []float64) ([]time.Time, []float64) {
-	start, end := TimeMinMax(xdata...)
-	totalHours := DiffHours(start, end)
-
-	finalTimes := Hours(start, totalHours+1)
-	finalValues := make([]float64, totalHours+1)
-
-	var hoursFromStart int
-	for i, xd := range xdata {
-		hoursFromStart = DiffHours(start, xd)
-		finalValues[hoursFromStart] = ydata[i]
-	}
-
-	return finalTimes, finalValues
+	return Resample(xdata, ydata, ResampleHour, ResampleLast, ResampleFillZero)
 }
@@ -0,0 +1,445 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// TreemapValue is a node in a treemap; nodes with children are drawn as groups that contain their children.
+type TreemapValue struct {
+	Style    Style
+	Label    string
+	Value    float64
+	Children []TreemapValue
+}
+
+// GetValue returns the value of the node; the value of a node with children is the sum of its children's values.
+func (tv TreemapValue) GetValue() float64 {
+	if len(tv.Children) == 0 {
+		return tv.Value
+	}
+	var total float64
+	for _, child := range tv.Children {
+		if value := child.GetValue(); value > 0 {
+			total += value
+		}
+	}
+	return total
+}
+
+// Validate validates the node and its children; values must be finite and cannot be negative.
+func (tv TreemapValue) Validate() error {
+	if tv.Value < 0 || math.IsNaN(tv.Value) || math.IsInf(tv.Value, 0) {
+		return fmt.Errorf("treemap values must be finite and cannot be negative; label: %q value: %v", tv.Label, tv.Value)
+	}
+	for _, child := range tv.Children {
+		if err := child.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TreemapChart is a chart that draws hierarchical values as nested rectangles with areas proportional to their values.
+type TreemapChart struct {
+	Title      string
+	TitleStyle Style
+
+	ColorPalette ColorPalette
+
+	Width  int
+	Height int
+	DPI    float64
+
+	Background Style
+	Canvas     Style
+
+	// NodeStyle is the default style of the rectangles without children, GroupStyle of the rectangles with children.
+	NodeStyle  Style
+	GroupStyle Style
+
+	// GroupPadding is the space in pixels between a group's rectangle and the rectangles of its children.
+	GroupPadding int
+
+	// ColorProvider, if set, colors the rectangles without children by value instead of by top-level group.
+	ColorProvider ColorProvider
+	// ValueFormatter, if set, formats a value shown below each label.
+	ValueFormatter ValueFormatter
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	Values   []TreemapValue
+	Elements []Renderable
+}
+
+// treemapCell is a node of a treemap laid out as a rectangle.
+type treemapCell struct {
+	Value TreemapValue
+	Box   Box
+	// LabelBox is where the label is drawn; it is empty if there isn't room for the label.
+	LabelBox Box
+	// Group is the index of the top-level node the cell belongs to.
+	Group int
+	Depth int
+}
+
+// IsGroup returns if the cell has children.
+func (tc treemapCell) IsGroup() bool {
+	return len(tc.Value.Children) > 0
+}
+
+// GetDPI returns the dpi for the chart.
+func (tc TreemapChart) GetDPI(defaults ...float64) float64 {
+	if tc.DPI == 0 {
+		if len(defaults) > 0 {
+			return defaults[0]
+		}
+		return DefaultDPI
+	}
+	return tc.DPI
+}
+
+// GetFont returns the text font.
+func (tc TreemapChart) GetFont() *truetype.Font {
+	if tc.Font == nil {
+		return tc.defaultFont
+	}
+	return tc.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (tc TreemapChart) GetWidth() int {
+	if tc.Width == 0 {
+		return DefaultChartWidth
+	}
+	return tc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (tc TreemapChart) GetHeight() int {
+	if tc.Height == 0 {
+		return DefaultChartHeight
+	}
+	return tc.Height
+}
+
+// GetGroupPadding returns the group padding or the default.
+func (tc TreemapChart) GetGroupPadding() int {
+	if tc.GroupPadding == 0 {
+		return DefaultTreemapGroupPadding
+	}
+	return tc.GroupPadding
+}
+
+// Validate validates the chart.
+func (tc TreemapChart) Validate() error {
+	if len(tc.Values) == 0 {
+		return errors.New("please provide at least one value")
+	}
+	for _, value := range tc.Values {
+		if err := value.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (tc TreemapChart) Render(rp RendererProvider, w io.Writer) error {
+	if err := tc.Validate(); err != nil {
+		return err
+	}
+
+	r, err := rp(tc.GetWidth(), tc.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if tc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		tc.defaultFont = defaultFont
+	}
+	r.SetDPI(tc.GetDPI(DefaultDPI))
+
+	canvasBox := tc.getCanvasBox(r)
+	tc.drawBackground(r)
+	tc.drawCanvas(r, canvasBox)
+
+	cells := tc.getCells(r, canvasBox)
+	if len(cells) == 0 {
+		return fmt.Errorf("treemap chart must contain at least (1) positive value")
+	}
+	tc.drawCells(r, cells)
+	tc.drawTitle(r)
+	for _, a := range tc.Elements {
+		a(r, canvasBox, tc.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+func (tc TreemapChart) drawBackground(r Renderer) {
+	Draw.Box(r, Box{
+		Right:  tc.GetWidth(),
+		Bottom: tc.GetHeight(),
+	}, tc.getBackgroundStyle())
+}
+
+func (tc TreemapChart) drawCanvas(r Renderer, canvasBox Box) {
+	Draw.Box(r, canvasBox, tc.getCanvasStyle())
+}
+
+func (tc TreemapChart) drawTitle(r Renderer) {
+	if len(tc.Title) > 0 && !tc.TitleStyle.Hidden {
+		Draw.TextWithin(r, tc.Title, tc.Box(), tc.styleDefaultsTitle())
+	}
+}
+
+func (tc TreemapChart) drawCells(r Renderer, cells []treemapCell) {
+	vmin, vmax := tc.getLeafRange(cells)
+	for _, cell := range cells {
+		style := tc.getCellStyle(cell, vmin, vmax)
+		Draw.Box(r, cell.Box, style)
+
+		if cell.LabelBox.Width() <= 0 || cell.LabelBox.Height() <= 0 {
+			continue
+		}
+		lines := Text.WrapFitTruncate(r, tc.getLabel(cell), cell.LabelBox.Width(), cell.LabelBox.Height(), style)
+		if len(lines) > 0 {
+			Draw.TextWithin(r, strings.Join(lines, "\n"), cell.LabelBox, style)
+		}
+	}
+}
+
+// getCells lays out the values, parents before their children.
+func (tc TreemapChart) getCells(r Renderer, canvasBox Box) []treemapCell {
+	bounds := treemapRect{
+		Left:   float64(canvasBox.Left),
+		Top:    float64(canvasBox.Top),
+		Width:  float64(canvasBox.Width()),
+		Height: float64(canvasBox.Height()),
+	}
+	return tc.layoutCells(r, nil, tc.Values, bounds, -1, 0)
+}
+
+func (tc TreemapChart) layoutCells(r Renderer, cells []treemapCell, nodes []TreemapValue, bounds treemapRect, group, depth int) []treemapCell {
+	values := make([]float64, len(nodes))
+	for index, node := range nodes {
+		values[index] = node.GetValue()
+	}
+
+	padding := float64(tc.GetGroupPadding())
+	for index, rect := range squarify(values, bounds) {
+		if values[index] <= 0 {
+			continue
+		}
+		cell := treemapCell{
+			Value: nodes[index],
+			Box:   rect.Box(),
+			Group: group,
+			Depth: depth,
+		}
+		if depth == 0 {
+			cell.Group = index
+		}
+
+		if !cell.IsGroup() {
+			cell.LabelBox = Box{
+				Top:    cell.Box.Top + DefaultTreemapLabelPadding,
+				Left:   cell.Box.Left + DefaultTreemapLabelPadding,
+				Right:  cell.Box.Right - DefaultTreemapLabelPadding,
+				Bottom: cell.Box.Bottom - DefaultTreemapLabelPadding,
+			}
+			cells = append(cells, cell)
+			continue
+		}
+
+		// groups get a header for their label if there's room for it and their children.
+		inner := treemapRect{
+			Left:   rect.Left + padding,
+			Top:    rect.Top + padding,
+			Width:  rect.Width - 2*padding,
+			Height: rect.Height - 2*padding,
+		}
+		style := tc.getCellStyle(cell, 0, 0)
+		header := float64(Draw.MeasureText(r, tc.getLabel(cell), style.GetTextOptions()).Height()) + 2*DefaultTreemapLabelPadding
+		if inner.Height > 2*header {
+			cell.LabelBox = Box{
+				Top:    cell.Box.Top + int(padding) + DefaultTreemapLabelPadding,
+				Left:   cell.Box.Left + int(padding) + DefaultTreemapLabelPadding,
+				Right:  cell.Box.Right - int(padding) - DefaultTreemapLabelPadding,
+				Bottom: cell.Box.Top + int(padding+header) - DefaultTreemapLabelPadding,
+			}
+			inner.Top += header
+			inner.Height -= header
+		}
+		cells = append(cells, cell)
+		cells = tc.layoutCells(r, cells, cell.Value.Children, inner, cell.Group, depth+1)
+	}
+	return cells
+}
+
+// getLabel returns the label of a cell; the value is shown below the label, or beside it for groups
+// so their label fits on a single line.
+func (tc TreemapChart) getLabel(cell treemapCell) string {
+	if tc.ValueFormatter == nil {
+		return cell.Value.Label
+	}
+	formatted := tc.ValueFormatter(cell.Value.GetValue())
+	if cell.Value.Label == "" {
+		return formatted
+	}
+	if cell.IsGroup() {
+		return fmt.Sprintf("%s (%s)", cell.Value.Label, formatted)
+	}
+	return cell.Value.Label + "\n" + formatted
+}
+
+// getLeafRange returns the range of the values of the cells without children, used with the color provider.
+func (tc TreemapChart) getLeafRange(cells []treemapCell) (vmin, vmax float64) {
+	vmin, vmax = math.MaxFloat64, -math.MaxFloat64
+	for _, cell := range cells {
+		if !cell.IsGroup() {
+			vmin = math.Min(vmin, cell.Value.Value)
+			vmax = math.Max(vmax, cell.Value.Value)
+		}
+	}
+	return
+}
+
+func (tc TreemapChart) getCellStyle(cell treemapCell, vmin, vmax float64) Style {
+	color := tc.GetColorPalette().GetSeriesColor(cell.Group)
+	if cell.IsGroup() {
+		return cell.Value.Style.InheritFrom(tc.GroupStyle.InheritFrom(tc.styleDefaultsCell(color.Lighten(DefaultTreemapGroupLighten))))
+	}
+	if tc.ColorProvider != nil {
+		color = tc.ColorProvider(cell.Value.Value, vmin, vmax)
+	}
+	return cell.Value.Style.InheritFrom(tc.NodeStyle.InheritFrom(tc.styleDefaultsCell(color)))
+}
+
+func (tc TreemapChart) styleDefaultsCell(fillColor drawing.Color) Style {
+	// labels on dark rectangles are drawn in white so they stay readable.
+	fontColor := tc.GetColorPalette().TextColor()
+	if 0.299*float64(fillColor.R)+0.587*float64(fillColor.G)+0.114*float64(fillColor.B) < 150 {
+		fontColor = ColorWhite
+	}
+	return Style{
+		StrokeColor:         tc.GetColorPalette().BackgroundColor(),
+		StrokeWidth:         1.0,
+		FillColor:           fillColor,
+		FontSize:            tc.getScaledFontSize(),
+		FontColor:           fontColor,
+		Font:                tc.GetFont(),
+		TextHorizontalAlign: TextHorizontalAlignLeft,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	}
+}
+
+func (tc TreemapChart) getCanvasBox(r Renderer) Box {
+	canvasBox := tc.Box()
+	if len(tc.Title) > 0 && !tc.TitleStyle.Hidden {
+		titleBox := Draw.MeasureText(r, tc.Title, tc.styleDefaultsTitle())
+		canvasBox.Top += titleBox.Height() + DefaultTitleTop
+	}
+	return canvasBox
+}
+
+func (tc TreemapChart) getBackgroundStyle() Style {
+	return tc.Background.InheritFrom(tc.styleDefaultsBackground())
+}
+
+func (tc TreemapChart) getCanvasStyle() Style {
+	return tc.Canvas.InheritFrom(tc.styleDefaultsCanvas())
+}
+
+func (tc TreemapChart) styleDefaultsCanvas() Style {
+	return Style{
+		FillColor:   tc.GetColorPalette().CanvasColor(),
+		StrokeColor: tc.GetColorPalette().CanvasStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (tc TreemapChart) getScaledFontSize() float64 {
+	effectiveDimension := MinInt(tc.GetWidth(), tc.GetHeight())
+	if effectiveDimension >= 2048 {
+		return 24.0
+	} else if effectiveDimension >= 1024 {
+		return 16.0
+	} else if effectiveDimension > 512 {
+		return 12.0
+	}
+	return 10.0
+}
+
+func (tc TreemapChart) styleDefaultsBackground() Style {
+	return Style{
+		FillColor:   tc.GetColorPalette().BackgroundColor(),
+		StrokeColor: tc.GetColorPalette().BackgroundStrokeColor(),
+		StrokeWidth: DefaultStrokeWidth,
+	}
+}
+
+func (tc TreemapChart) styleDefaultsElements() Style {
+	return Style{
+		Font: tc.GetFont(),
+	}
+}
+
+func (tc TreemapChart) styleDefaultsTitle() Style {
+	return tc.TitleStyle.InheritFrom(Style{
+		FontColor:           tc.GetColorPalette().TextColor(),
+		Font:                tc.GetFont(),
+		FontSize:            tc.getTitleFontSize(),
+		TextHorizontalAlign: TextHorizontalAlignCenter,
+		TextVerticalAlign:   TextVerticalAlignTop,
+		TextWrap:            TextWrapWord,
+	})
+}
+
+func (tc TreemapChart) getTitleFontSize() float64 {
+	effectiveDimension := MinInt(tc.GetWidth(), tc.GetHeight())
+	if effectiveDimension >= 2048 {
+		return 48
+	} else if effectiveDimension >= 1024 {
+		return 24
+	} else if effectiveDimension >= 512 {
+		return 18
+	} else if effectiveDimension >= 256 {
+		return 12
+	}
+	return 10
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (tc TreemapChart) GetColorPalette() ColorPalette {
+	if tc.ColorPalette != nil {
+		return tc.ColorPalette
+	}
+	return DefaultColorPalette
+}
+
+// Box returns the chart bounds as a box.
+func (tc TreemapChart) Box() Box {
+	dpr := tc.Background.Padding.GetRight(DefaultBackgroundPadding.Right)
+	dpb := tc.Background.Padding.GetBottom(DefaultBackgroundPadding.Bottom)
+
+	return Box{
+		Top:    tc.Background.Padding.GetTop(DefaultBackgroundPadding.Top),
+		Left:   tc.Background.Padding.GetLeft(DefaultBackgroundPadding.Left),
+		Right:  tc.GetWidth() - dpr,
+		Bottom: tc.GetHeight() - dpb,
+	}
+}
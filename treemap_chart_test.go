@@ -0,0 +1,119 @@
+package chart
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/drawing"
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestTreemapChart(t *testing.T) {
+	tc := TreemapChart{
+		Title:          "Test Title",
+		ValueFormatter: FloatValueFormatter,
+		Values: []TreemapValue{
+			{Label: "A", Children: []TreemapValue{
+				{Label: "A1", Value: 5},
+				{Label: "A2 with a very long label that will not fit", Value: 0.1},
+			}},
+			{Label: "B", Value: 3},
+		},
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	err := tc.Render(PNG, b)
+	testutil.AssertNil(t, err)
+	testutil.AssertNotZero(t, b.Len())
+}
+
+func TestTreemapChartNoValues(t *testing.T) {
+	b := bytes.NewBuffer([]byte{})
+	err := TreemapChart{}.Render(PNG, b)
+	testutil.AssertNotNil(t, err)
+
+	err = TreemapChart{Values: []TreemapValue{{Label: "A", Value: 0}, {Label: "B", Children: []TreemapValue{{Value: -1}}}}}.Render(PNG, b)
+	testutil.AssertNotNil(t, err)
+}
+
+func TestTreemapChartValidate(t *testing.T) {
+	tc := TreemapChart{
+		Values: []TreemapValue{
+			{Label: "A", Value: 3},
+			{Label: "B", Children: []TreemapValue{{Label: "B1", Value: 1}, {Label: "B2", Value: 0}}},
+		},
+	}
+	testutil.AssertNil(t, tc.Validate())
+
+	for _, value := range []float64{-1, math.NaN(), math.Inf(1)} {
+		tc.Values[0].Value = value
+		testutil.AssertNotNil(t, tc.Validate())
+		testutil.AssertNotNil(t, tc.Render(PNG, bytes.NewBuffer([]byte{})))
+	}
+
+	// the values of children are validated too.
+	tc.Values[0].Value = 3
+	tc.Values[1].Children[1].Value = -2
+	testutil.AssertNotNil(t, tc.Validate())
+
+	tc.Values = nil
+	testutil.AssertNotNil(t, tc.Validate())
+}
+
+func TestTreemapValueGetValue(t *testing.T) {
+	tv := TreemapValue{Value: 100, Children: []TreemapValue{
+		{Value: 1},
+		{Value: -1},
+		{Children: []TreemapValue{{Value: 2}, {Value: 3}}},
+	}}
+	testutil.AssertEqual(t, 6.0, tv.GetValue())
+}
+
+func TestTreemapChartCells(t *testing.T) {
+	r, err := PNG(400, 300)
+	testutil.AssertNil(t, err)
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	tc := TreemapChart{
+		Font: f,
+		Values: []TreemapValue{
+			{Label: "A", Children: []TreemapValue{
+				{Label: "A1", Value: 3},
+				{Label: "A2", Value: 1},
+			}},
+			{Label: "B", Value: 4},
+		},
+	}
+	canvasBox := NewBox(0, 0, 400, 300)
+	cells := tc.getCells(r, canvasBox)
+	testutil.AssertLen(t, cells, 4)
+
+	// groups come before their children, which are inside the group and below its header.
+	testutil.AssertEqual(t, "A", cells[0].Value.Label)
+	testutil.AssertTrue(t, cells[0].IsGroup())
+	testutil.AssertFalse(t, cells[0].LabelBox.IsZero())
+	for _, child := range cells[1:3] {
+		testutil.AssertEqual(t, 0, child.Group)
+		testutil.AssertEqual(t, 1, child.Depth)
+		testutil.AssertTrue(t, child.Box.Top >= cells[0].LabelBox.Bottom)
+		testutil.AssertTrue(t, child.Box.Left >= cells[0].Box.Left)
+		testutil.AssertTrue(t, child.Box.Right <= cells[0].Box.Right)
+		testutil.AssertTrue(t, child.Box.Bottom <= cells[0].Box.Bottom)
+	}
+	testutil.AssertEqual(t, "B", cells[3].Value.Label)
+	testutil.AssertEqual(t, 1, cells[3].Group)
+
+	// leaves are colored by group unless there is a color provider.
+	vmin, vmax := tc.getLeafRange(cells)
+	testutil.AssertEqual(t, 1.0, vmin)
+	testutil.AssertEqual(t, 4.0, vmax)
+	testutil.AssertEqual(t, tc.GetColorPalette().GetSeriesColor(0), tc.getCellStyle(cells[1], vmin, vmax).FillColor)
+	testutil.AssertEqual(t, tc.GetColorPalette().GetSeriesColor(0), tc.getCellStyle(cells[2], vmin, vmax).FillColor)
+
+	tc.ColorProvider = func(v, vmin, vmax float64) drawing.Color {
+		return drawing.Color{R: uint8(255 * (v - vmin) / (vmax - vmin)), A: 255}
+	}
+	testutil.AssertEqual(t, drawing.Color{R: 255, A: 255}, tc.getCellStyle(cells[3], vmin, vmax).FillColor)
+}
@@ -0,0 +1,104 @@
+package chart
+
+import (
+	"math"
+	"sort"
+)
+
+// treemapRect is a rectangle with fractional bounds, used while laying out a treemap
+// so rounding doesn't accumulate across rows.
+type treemapRect struct {
+	Left, Top, Width, Height float64
+}
+
+// Box returns the rectangle rounded to a box.
+func (tr treemapRect) Box() Box {
+	return Box{
+		Top:    int(math.Round(tr.Top)),
+		Left:   int(math.Round(tr.Left)),
+		Right:  int(math.Round(tr.Left + tr.Width)),
+		Bottom: int(math.Round(tr.Top + tr.Height)),
+	}
+}
+
+// squarify lays the values out as rectangles that fill the bounds with the squarified algorithm
+// (Bruls, Huizing and van Wijk), which keeps the rectangles as close to square as it can.
+// The rectangles are returned in the order of the values; non-positive values get an empty rectangle.
+func squarify(values []float64, bounds treemapRect) []treemapRect {
+	output := make([]treemapRect, len(values))
+
+	var order []int
+	var total float64
+	for index, v := range values {
+		if v > 0 {
+			order = append(order, index)
+			total += v
+		}
+	}
+	if total == 0 || bounds.Width <= 0 || bounds.Height <= 0 {
+		return output
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return values[order[i]] > values[order[j]]
+	})
+
+	scale := (bounds.Width * bounds.Height) / total
+	areas := make([]float64, len(order))
+	for index, source := range order {
+		areas[index] = values[source] * scale
+	}
+
+	rect := bounds
+	for start := 0; start < len(areas); {
+		side := math.Min(rect.Width, rect.Height)
+
+		// grow the row while it makes the worst aspect ratio in the row better.
+		end := start + 1
+		for end < len(areas) && squarifyWorst(areas[start:end+1], side) <= squarifyWorst(areas[start:end], side) {
+			end++
+		}
+
+		var rowArea float64
+		for _, area := range areas[start:end] {
+			rowArea += area
+		}
+
+		// the row is laid along the shorter side, then removed from the remaining space.
+		if rect.Width >= rect.Height {
+			rowWidth := rowArea / rect.Height
+			top := rect.Top
+			for index := start; index < end; index++ {
+				height := areas[index] / rowWidth
+				output[order[index]] = treemapRect{Left: rect.Left, Top: top, Width: rowWidth, Height: height}
+				top += height
+			}
+			rect.Left += rowWidth
+			rect.Width -= rowWidth
+		} else {
+			rowHeight := rowArea / rect.Width
+			left := rect.Left
+			for index := start; index < end; index++ {
+				width := areas[index] / rowHeight
+				output[order[index]] = treemapRect{Left: left, Top: rect.Top, Width: width, Height: rowHeight}
+				left += width
+			}
+			rect.Top += rowHeight
+			rect.Height -= rowHeight
+		}
+		start = end
+	}
+	return output
+}
+
+// squarifyWorst returns the worst aspect ratio of a row of areas laid along a side.
+func squarifyWorst(row []float64, side float64) float64 {
+	var sum float64
+	min, max := math.MaxFloat64, 0.0
+	for _, area := range row {
+		sum += area
+		min = math.Min(min, area)
+		max = math.Max(max, area)
+	}
+	side2, sum2 := side*side, sum*sum
+	return math.Max((side2*max)/sum2, sum2/(side2*min))
+}
@@ -0,0 +1,45 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestSquarify(t *testing.T) {
+	// the example from the squarified treemaps paper.
+	values := []float64{6, 6, 4, 3, 2, 2, 1}
+	bounds := treemapRect{Left: 0, Top: 0, Width: 6, Height: 4}
+
+	rects := squarify(values, bounds)
+	testutil.AssertLen(t, rects, len(values))
+
+	for index, rect := range rects {
+		testutil.AssertInDelta(t, values[index], rect.Width*rect.Height, 0.0001)
+		testutil.AssertTrue(t, rect.Left >= -0.0001 && rect.Left+rect.Width <= 6.0001)
+		testutil.AssertTrue(t, rect.Top >= -0.0001 && rect.Top+rect.Height <= 4.0001)
+	}
+
+	// the first row is the two largest values stacked on the left.
+	testutil.AssertInDelta(t, 3, rects[0].Width, 0.0001)
+	testutil.AssertInDelta(t, 2, rects[0].Height, 0.0001)
+	testutil.AssertInDelta(t, 0, rects[1].Left, 0.0001)
+	testutil.AssertInDelta(t, 2, rects[1].Top, 0.0001)
+}
+
+func TestSquarifySkipsNonPositiveValues(t *testing.T) {
+	rects := squarify([]float64{1, 0, -1, 1}, treemapRect{Width: 2, Height: 1})
+	testutil.AssertLen(t, rects, 4)
+	testutil.AssertInDelta(t, 1, rects[0].Width*rects[0].Height, 0.0001)
+	testutil.AssertZero(t, rects[1].Width)
+	testutil.AssertZero(t, rects[2].Width)
+	testutil.AssertInDelta(t, 1, rects[3].Width*rects[3].Height, 0.0001)
+
+	rects = squarify([]float64{0, 0}, treemapRect{Width: 2, Height: 1})
+	testutil.AssertZero(t, rects[0].Width)
+}
+
+func TestTreemapRectBox(t *testing.T) {
+	b := treemapRect{Left: 1.4, Top: 2.6, Width: 10.2, Height: 5}.Box()
+	testutil.AssertEqual(t, Box{Top: 3, Left: 1, Right: 12, Bottom: 8}, b)
+}
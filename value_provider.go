@@ -14,6 +14,12 @@ type BoundedValuesProvider interface {
 	GetBoundedValues(index int) (x, y1, y2 float64)
 }
 
+// xBoundsProvider is a series that occupies more of the x-axis than its values, like the bands of categories,
+// and extends the x-range to cover it.
+type xBoundsProvider interface {
+	getXBounds() (min, max float64)
+}
+
 // FirstValuesProvider is a special type of value provider that can return it's (potentially computed) first value.
 type FirstValuesProvider interface {
 	GetFirstValues() (x, y float64)
@@ -49,3 +55,20 @@ type ColorProvider func(v, vmin, vmax float64) drawing.Color
 
 // DotColorProvider is a provider for dot color.
 type DotColorProvider func(xrange, yrange Range, index int, x, y float64) drawing.Color
+
+// getExtrapolatedXValue returns the x-value of an index of a values provider, extrapolated past its end with the
+// average spacing of its x-values.
+func getExtrapolatedXValue(vp ValuesProvider, index int) float64 {
+	length := vp.Len()
+	if index < length {
+		x, _ := vp.GetValues(index)
+		return x
+	}
+	first, _ := vp.GetValues(0)
+	last, _ := vp.GetValues(length - 1)
+	var spacing float64
+	if length > 1 {
+		spacing = (last - first) / float64(length-1)
+	}
+	return last + float64(index-length+1)*spacing
+}
@@ -131,11 +131,15 @@ func (vr *vectorRenderer) ArcTo(cx, cy int, rx, ry, startAngle, delta float64) {
 	dd := RadiansToDegrees(delta)
 
 	largeArcFlag := 0
-	if delta > _pi {
+	if math.Abs(delta) > _pi {
 		largeArcFlag = 1
 	}
+	sweepFlag := 1
+	if delta < 0 {
+		sweepFlag = 0
+	}
 
-	vr.p = append(vr.p, fmt.Sprintf("A %d %d %0.2f %d 1 %d %d", int(rx), int(ry), dd, largeArcFlag, endx, endy))
+	vr.p = append(vr.p, fmt.Sprintf("A %d %d %0.2f %d %d %d %d", int(rx), int(ry), dd, largeArcFlag, sweepFlag, endx, endy))
 }
 
 // Close closes a shape.
@@ -35,6 +35,35 @@ func TestVectorRendererPath(t *testing.T) {
 	testutil.AssertTrue(t, strings.HasSuffix(raw, "</svg>"))
 }
 
+func TestVectorRendererArcToCounterClockwise(t *testing.T) {
+	vr, err := SVG(100, 100)
+	testutil.AssertNil(t, err)
+
+	typed, isTyped := vr.(*vectorRenderer)
+	testutil.AssertTrue(t, isTyped)
+
+	typed.ArcTo(50, 50, 10, 10, 0, _pi2)
+	typed.ArcTo(50, 50, 10, 10, _pi2, -_3pi2)
+	testutil.AssertLen(t, typed.p, 4)
+	testutil.AssertEqual(t, "A 10 10 90.00 0 1 50 60", typed.p[1])
+	testutil.AssertEqual(t, "A 10 10 -270.00 1 0 40 50", typed.p[3])
+}
+
+func TestVectorRendererArcToClockwise(t *testing.T) {
+	vr, err := SVG(100, 100)
+	testutil.AssertNil(t, err)
+
+	typed, isTyped := vr.(*vectorRenderer)
+	testutil.AssertTrue(t, isTyped)
+
+	// arcs of a positive delta always sweep clockwise, as they did before arcs of a negative delta were drawn.
+	typed.ArcTo(50, 50, 10, 10, 0, _pi2)
+	typed.ArcTo(50, 50, 10, 10, _pi2, _3pi2)
+	testutil.AssertLen(t, typed.p, 4)
+	testutil.AssertEqual(t, "A 10 10 90.00 0 1 50 60", typed.p[1])
+	testutil.AssertEqual(t, "A 10 10 270.00 1 1 60 50", typed.p[3])
+}
+
 func TestVectorRendererMeasureText(t *testing.T) {
 	// replaced new assertions helper
 
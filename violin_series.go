@@ -0,0 +1,247 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interface Assertions.
+var (
+	_ Series                = (*ViolinSeries)(nil)
+	_ ValuesProvider        = (*ViolinSeries)(nil)
+	_ BoundedValuesProvider = (*ViolinSeries)(nil)
+	_ xBoundsProvider       = (*ViolinSeries)(nil)
+)
+
+// ViolinInner is an enum for what is drawn inside the violins of a violin series.
+type ViolinInner int
+
+const (
+	// ViolinInnerNone draws nothing inside the violins.
+	ViolinInnerNone ViolinInner = 0
+	// ViolinInnerBox draws a narrow box plot inside the violins; the box spans the quartiles, the whiskers
+	// the samples within 1.5 times the interquartile range of it and a dot marks the median.
+	ViolinInnerBox ViolinInner = 1
+	// ViolinInnerQuartiles draws a line across the violins at the median and dashed lines at the quartiles.
+	ViolinInnerQuartiles ViolinInner = 2
+)
+
+// Violin is the samples of a category of a violin series.
+type Violin struct {
+	Label   string
+	Style   Style
+	Samples []float64
+}
+
+// ViolinSeries draws the distribution of the samples of each category as a violin, a kernel density estimate
+// mirrored around the category.
+//
+// The x-value of each violin is its index in `Violins`, which lines up with the categories of a `CategoricalRange`
+// x-axis like a `BarSeries`; the widest point of each violin fills the band.
+type ViolinSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	// Kernel, Bandwidth, BandwidthSelector and Points configure the density estimate of each violin
+	// as for a `KernelDensity`.
+	Kernel            Kernel
+	Bandwidth         float64
+	BandwidthSelector BandwidthSelector
+	Points            int
+
+	Inner      ViolinInner
+	InnerStyle Style
+
+	Violins []Violin
+}
+
+// GetName returns the name of the series.
+func (vs ViolinSeries) GetName() string {
+	return vs.Name
+}
+
+// GetStyle returns the series style.
+func (vs ViolinSeries) GetStyle() Style {
+	return vs.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (vs ViolinSeries) GetYAxis() YAxisType {
+	return vs.YAxis
+}
+
+// Len returns the number of violins.
+func (vs ViolinSeries) Len() int {
+	return len(vs.Violins)
+}
+
+// GetValues returns the index and the median of a violin.
+func (vs ViolinSeries) GetValues(index int) (x, y float64) {
+	x = float64(index)
+	y = ValueSequence(vs.Violins[index].Samples...).Median()
+	return
+}
+
+// GetBoundedValues returns the index of a violin and the range of its density estimate, so the y-range includes
+// the whole violin.
+func (vs ViolinSeries) GetBoundedValues(index int) (x, y1, y2 float64) {
+	x = float64(index)
+	y1, y2 = vs.getDensity(index).GetBounds()
+	return
+}
+
+// getXBounds returns the edges of the bands of the first and last violins, so a single violin has an x-range.
+func (vs ViolinSeries) getXBounds() (min, max float64) {
+	return -0.5, float64(len(vs.Violins)) - 0.5
+}
+
+// Render renders the series.
+func (vs ViolinSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	if len(vs.Violins) == 0 {
+		return
+	}
+
+	style := vs.Style.InheritFrom(defaults.InheritFrom(Style{
+		FillColor: defaults.GetStrokeColor().WithAlpha(DefaultViolinFillAlpha),
+	}))
+	innerStyle := vs.InnerStyle.InheritFrom(Style{
+		StrokeColor: ColorBlack,
+		StrokeWidth: DefaultAxisLineWidth,
+		FillColor:   ColorBlack,
+		DotColor:    ColorWhite,
+		DotWidth:    DefaultViolinMedianRadius,
+	})
+	halfWidth := float64(getBandWidth(xrange, len(vs.Violins))) / 2.0
+
+	for index, violin := range vs.Violins {
+		if len(violin.Samples) == 0 {
+			continue
+		}
+		density := vs.getDensity(index)
+		cx := canvasBox.Left + xrange.Translate(float64(index))
+
+		// the densities are scaled so the widest point of the violin fills the band.
+		ys := make([]int, density.Len())
+		widths := make([]float64, density.Len())
+		var maxDensity float64
+		for point := range ys {
+			y, d := density.GetValues(point)
+			ys[point] = canvasBox.Bottom - yrange.Translate(y)
+			widths[point] = d
+			maxDensity = math.Max(maxDensity, d)
+		}
+		if maxDensity == 0 {
+			continue
+		}
+		scale := halfWidth / maxDensity
+
+		violinStyle := violin.Style.InheritFrom(style)
+		violinStyle.GetFillAndStrokeOptions().WriteToRenderer(r)
+		for point, y := range ys {
+			x := cx + int(widths[point]*scale)
+			if point == 0 {
+				r.MoveTo(x, y)
+			} else {
+				r.LineTo(x, y)
+			}
+		}
+		for point := len(ys) - 1; point >= 0; point-- {
+			r.LineTo(cx-int(widths[point]*scale), ys[point])
+		}
+		r.Close()
+		r.FillStroke()
+		r.ResetStyle()
+
+		switch vs.Inner {
+		case ViolinInnerBox:
+			vs.drawInnerBox(r, canvasBox, yrange, cx, halfWidth, violin, innerStyle)
+		case ViolinInnerQuartiles:
+			vs.drawInnerQuartiles(r, canvasBox, yrange, cx, scale, density, violin, innerStyle)
+		}
+	}
+}
+
+// drawInnerBox draws a narrow box plot of the samples of the violin.
+func (vs ViolinSeries) drawInnerBox(r Renderer, canvasBox Box, yrange Range, cx int, halfWidth float64, violin Violin, style Style) {
+	samples := ValueSequence(violin.Samples...)
+	q1, median, q3 := samples.Percentile(0.25), samples.Median(), samples.Percentile(0.75)
+	low, high := q1-1.5*(q3-q1), q3+1.5*(q3-q1)
+
+	// the whiskers end at the furthest samples within the fences.
+	lowWhisker, highWhisker := q1, q3
+	for _, sample := range violin.Samples {
+		if sample >= low {
+			lowWhisker = math.Min(lowWhisker, sample)
+		}
+		if sample <= high {
+			highWhisker = math.Max(highWhisker, sample)
+		}
+	}
+
+	style.GetStrokeOptions().WriteToRenderer(r)
+	r.MoveTo(cx, canvasBox.Bottom-yrange.Translate(lowWhisker))
+	r.LineTo(cx, canvasBox.Bottom-yrange.Translate(highWhisker))
+	r.Stroke()
+	r.ResetStyle()
+
+	boxWidth2 := MaxInt(int(halfWidth*DefaultViolinBoxWidth), 1)
+	Draw.Box(r, Box{
+		Top:    canvasBox.Bottom - yrange.Translate(q3),
+		Left:   cx - boxWidth2,
+		Right:  cx + boxWidth2,
+		Bottom: canvasBox.Bottom - yrange.Translate(q1),
+	}, style)
+
+	r.SetFillColor(style.GetDotColor())
+	r.Circle(style.GetDotWidth(), cx, canvasBox.Bottom-yrange.Translate(median))
+	r.Fill()
+	r.ResetStyle()
+}
+
+// drawInnerQuartiles draws lines across the violin at the quartiles, dashed except for the median.
+func (vs ViolinSeries) drawInnerQuartiles(r Renderer, canvasBox Box, yrange Range, cx int, scale float64, density KernelDensity, violin Violin, style Style) {
+	samples := ValueSequence(violin.Samples...)
+	for _, percent := range []float64{0.25, 0.5, 0.75} {
+		value := samples.Percentile(percent)
+		if percent == 0.5 {
+			value = samples.Median()
+		}
+		lineStyle := style
+		if percent != 0.5 && len(style.StrokeDashArray) == 0 {
+			lineStyle.StrokeDashArray = []float64{3.0, 3.0}
+		}
+
+		width := int(density.Density(value) * scale)
+		y := canvasBox.Bottom - yrange.Translate(value)
+		lineStyle.GetStrokeOptions().WriteToRenderer(r)
+		r.MoveTo(cx-width, y)
+		r.LineTo(cx+width, y)
+		r.Stroke()
+		r.ResetStyle()
+	}
+}
+
+// getDensity returns the density estimate of the samples of a violin.
+func (vs ViolinSeries) getDensity(index int) KernelDensity {
+	return KernelDensity{
+		Samples:           vs.Violins[index].Samples,
+		Kernel:            vs.Kernel,
+		Bandwidth:         vs.Bandwidth,
+		BandwidthSelector: vs.BandwidthSelector,
+		Points:            vs.Points,
+	}
+}
+
+// Validate validates the series.
+func (vs ViolinSeries) Validate() error {
+	if len(vs.Violins) == 0 {
+		return fmt.Errorf("violin series must have violins set")
+	}
+	for _, violin := range vs.Violins {
+		if len(violin.Samples) == 0 {
+			return fmt.Errorf("violin series; violin %q must have samples set", violin.Label)
+		}
+	}
+	return nil
+}
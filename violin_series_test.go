@@ -0,0 +1,112 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestViolinSeries(t *testing.T) {
+	for _, inner := range []ViolinInner{ViolinInnerNone, ViolinInnerBox, ViolinInnerQuartiles} {
+		graph := Chart{
+			XAxis: XAxis{
+				Range: &CategoricalRange{Categories: []string{"a", "b"}},
+			},
+			Series: []Series{
+				ViolinSeries{
+					Inner: inner,
+					Violins: []Violin{
+						{Label: "a", Samples: []float64{1, 2, 2, 3, 3, 3, 4, 4, 5}},
+						{Label: "b", Samples: []float64{4, 5, 5, 6, 9, 10}},
+					},
+				},
+			},
+		}
+		testutil.AssertNil(t, graph.Render(PNG, bytes.NewBuffer([]byte{})))
+		testutil.AssertNil(t, graph.Render(SVG, bytes.NewBuffer([]byte{})))
+
+		// the y-axis covers the tails of both violins.
+		_, yrange, _ := graph.getRanges()
+		_, y1, _ := graph.Series[0].(ViolinSeries).GetBoundedValues(0)
+		_, _, y2 := graph.Series[0].(ViolinSeries).GetBoundedValues(1)
+		testutil.AssertTrue(t, yrange.GetMin() <= y1)
+		testutil.AssertTrue(t, yrange.GetMax() >= y2)
+	}
+}
+
+func TestViolinSeriesValues(t *testing.T) {
+	vs := ViolinSeries{
+		Violins: []Violin{
+			{Label: "a", Samples: []float64{1, 2, 2, 3, 3, 3, 4, 4, 5}},
+			{Label: "b", Samples: []float64{4, 5, 5, 6, 9, 10}},
+		},
+	}
+	testutil.AssertEqual(t, 2, vs.Len())
+
+	x, y := vs.GetValues(0)
+	testutil.AssertEqual(t, 0.0, x)
+	testutil.AssertEqual(t, 3.0, y)
+
+	// the bounds include the tails of the density estimate beyond the samples.
+	x, y1, y2 := vs.GetBoundedValues(1)
+	testutil.AssertEqual(t, 1.0, x)
+	testutil.AssertTrue(t, y1 < 4)
+	testutil.AssertTrue(t, y2 > 10)
+
+	// the violin is widest at the mode of its samples.
+	density := vs.getDensity(0)
+	testutil.AssertTrue(t, density.Density(3) > density.Density(1))
+	testutil.AssertTrue(t, density.Density(3) > density.Density(5))
+}
+
+func TestViolinSeriesTwoSamples(t *testing.T) {
+	vs := ViolinSeries{
+		Violins: []Violin{
+			{Label: "a", Samples: []float64{1, 2, 3}},
+			{Label: "b", Samples: []float64{4, 6}},
+		},
+	}
+	x, y1, y2 := vs.GetBoundedValues(1)
+	testutil.AssertEqual(t, 1.0, x)
+	testutil.AssertTrue(t, y1 < 4)
+	testutil.AssertTrue(t, y2 > 6)
+
+	graph := Chart{
+		XAxis:  XAxis{Range: &CategoricalRange{Categories: []string{"a", "b"}}},
+		Series: []Series{vs},
+	}
+	testutil.AssertNil(t, graph.Render(PNG, bytes.NewBuffer([]byte{})))
+}
+
+func TestViolinSeriesSingleViolin(t *testing.T) {
+	graph := Chart{
+		Series: []Series{
+			ViolinSeries{
+				Violins: []Violin{{Label: "a", Samples: []float64{1, 2, 2, 3, 5}}},
+			},
+		},
+	}
+	testutil.AssertNil(t, graph.Render(PNG, bytes.NewBuffer([]byte{})))
+
+	// the x-range covers the band of the violin, as a categorical range would.
+	xrange, _, _ := graph.getRanges()
+	testutil.AssertEqual(t, -0.5, xrange.GetMin())
+	testutil.AssertEqual(t, 0.5, xrange.GetMax())
+}
+
+func TestViolinSeriesValidate(t *testing.T) {
+	vs := ViolinSeries{
+		Violins: []Violin{
+			{Label: "a", Samples: []float64{1, 2, 3}},
+			{Label: "b", Samples: []float64{4, 5, 6}},
+		},
+	}
+	testutil.AssertNil(t, vs.Validate())
+
+	vs.Violins[1].Samples = nil
+	testutil.AssertNotNil(t, vs.Validate())
+
+	vs = ViolinSeries{}
+	testutil.AssertNotNil(t, vs.Validate())
+}
@@ -0,0 +1,129 @@
+package chart
+
+import "fmt"
+
+// Interface Assertions.
+var (
+	_ Series              = (*VWAPSeries)(nil)
+	_ FirstValuesProvider = (*VWAPSeries)(nil)
+	_ LastValuesProvider  = (*VWAPSeries)(nil)
+)
+
+// VWAPSeries computes the volume weighted average price of a price series, the average of the typical prices of
+// the periods weighted by how much was traded in them.
+//
+// The typical price is the average of the high, low and close; the close is the value of the inner series and the
+// high and low are the values of their series, or the close if they aren't set. The volumes are the values of the
+// volume series. The average is over the last `Period` periods, or all of the periods so far if it's unset.
+type VWAPSeries struct {
+	Name  string
+	Style Style
+	YAxis YAxisType
+
+	Period       int
+	InnerSeries  ValuesProvider
+	HighSeries   ValuesProvider
+	LowSeries    ValuesProvider
+	VolumeSeries ValuesProvider
+
+	cache []float64
+}
+
+// GetName returns the name of the time series.
+func (vwap VWAPSeries) GetName() string {
+	return vwap.Name
+}
+
+// GetStyle returns the line style.
+func (vwap VWAPSeries) GetStyle() Style {
+	return vwap.Style
+}
+
+// GetYAxis returns which YAxis the series draws on.
+func (vwap VWAPSeries) GetYAxis() YAxisType {
+	return vwap.YAxis
+}
+
+// GetPeriod returns the window size, or the length of the series if it's unset.
+func (vwap VWAPSeries) GetPeriod() int {
+	if vwap.Period == 0 {
+		return vwap.InnerSeries.Len()
+	}
+	return vwap.Period
+}
+
+// Len returns the number of elements in the series.
+func (vwap VWAPSeries) Len() int {
+	return vwap.InnerSeries.Len()
+}
+
+// GetValues gets a value at a given index.
+func (vwap *VWAPSeries) GetValues(index int) (x, y float64) {
+	if vwap.InnerSeries == nil || vwap.VolumeSeries == nil {
+		return
+	}
+	if len(vwap.cache) == 0 {
+		vwap.ensureCachedValues()
+	}
+	x, _ = vwap.InnerSeries.GetValues(index)
+	y = vwap.cache[index]
+	return
+}
+
+// GetFirstValues returns the first value of the series.
+func (vwap *VWAPSeries) GetFirstValues() (x, y float64) {
+	if vwap.InnerSeries == nil || vwap.InnerSeries.Len() == 0 {
+		return
+	}
+	return vwap.GetValues(0)
+}
+
+// GetLastValues returns the last value of the series.
+func (vwap *VWAPSeries) GetLastValues() (x, y float64) {
+	if vwap.InnerSeries == nil || vwap.InnerSeries.Len() == 0 {
+		return
+	}
+	return vwap.GetValues(vwap.InnerSeries.Len() - 1)
+}
+
+func (vwap *VWAPSeries) ensureCachedValues() {
+	seriesLength := vwap.InnerSeries.Len()
+	vwap.cache = make([]float64, seriesLength)
+
+	// the sums of the traded values and volumes so far, so each window is the difference of two sums.
+	prices := make([]float64, seriesLength)
+	tradedSums := make([]float64, seriesLength+1)
+	volumeSums := make([]float64, seriesLength+1)
+	for index := 0; index < seriesLength; index++ {
+		_, high, low, close := getHighLowClose(vwap.InnerSeries, vwap.HighSeries, vwap.LowSeries, index)
+		_, volume := vwap.VolumeSeries.GetValues(index)
+		prices[index] = (high + low + close) / 3
+		tradedSums[index+1] = tradedSums[index] + prices[index]*volume
+		volumeSums[index+1] = volumeSums[index] + volume
+	}
+
+	period := vwap.GetPeriod()
+	for index := 0; index < seriesLength; index++ {
+		start := MaxInt(0, index+1-period)
+		volume := volumeSums[index+1] - volumeSums[start]
+		if volume == 0 {
+			vwap.cache[index] = prices[index]
+			continue
+		}
+		vwap.cache[index] = (tradedSums[index+1] - tradedSums[start]) / volume
+	}
+}
+
+// Render renders the series.
+func (vwap *VWAPSeries) Render(r Renderer, canvasBox Box, xrange, yrange Range, defaults Style) {
+	style := vwap.Style.InheritFrom(defaults)
+	Draw.LineSeries(r, canvasBox, xrange, yrange, style, vwap)
+}
+
+// Validate validates the series.
+func (vwap *VWAPSeries) Validate() error {
+	if vwap.VolumeSeries == nil {
+		return fmt.Errorf("vwap series requires VolumeSeries to be set")
+	}
+	return validateIndicatorSeries("vwap", vwap.InnerSeries, vwap.HighSeries, vwap.LowSeries, vwap.VolumeSeries)
+}
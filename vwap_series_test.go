@@ -0,0 +1,27 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestVWAPSeries(t *testing.T) {
+	prices := mockValuesProvider{X: []float64{1, 2, 3}, Y: []float64{10, 20, 30}}
+	volumes := mockValuesProvider{X: []float64{1, 2, 3}, Y: []float64{1, 3, 0}}
+
+	vwap := &VWAPSeries{InnerSeries: prices, VolumeSeries: volumes}
+	_, y := vwap.GetValues(1)
+	testutil.AssertInDelta(t, 17.5, y, 0.0000001)
+	x, y := vwap.GetLastValues()
+	testutil.AssertEqual(t, 3.0, x)
+	testutil.AssertInDelta(t, 17.5, y, 0.0000001)
+
+	// a window without volume is at the price.
+	vwap = &VWAPSeries{InnerSeries: prices, VolumeSeries: volumes, Period: 1}
+	_, y = vwap.GetLastValues()
+	testutil.AssertInDelta(t, 30.0, y, 0.0000001)
+
+	testutil.AssertNotNil(t, (&VWAPSeries{InnerSeries: prices}).Validate())
+	testutil.AssertNil(t, vwap.Validate())
+}
@@ -0,0 +1,360 @@
+package chart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// WaterfallBarType is an enum for how a waterfall bar relates to the running total.
+type WaterfallBarType int
+
+const (
+	// WaterfallBarTypeUnset is the unset state for the bar type; it behaves like `WaterfallBarTypeChange`.
+	WaterfallBarTypeUnset WaterfallBarType = 0
+	// WaterfallBarTypeChange adds the value to the running total and floats between the totals before and after it.
+	WaterfallBarTypeChange WaterfallBarType = 1
+	// WaterfallBarTypeTotal ignores the value and draws the running total, i.e. a subtotal or the final total.
+	WaterfallBarTypeTotal WaterfallBarType = 2
+	// WaterfallBarTypeStart sets the running total to the value and draws it like a total, i.e. an opening balance.
+	WaterfallBarTypeStart WaterfallBarType = 3
+)
+
+// WaterfallBar is a bar of a waterfall chart.
+type WaterfallBar struct {
+	Style Style
+	Label string
+	Value float64
+	Type  WaterfallBarType
+}
+
+// IsTotal returns if the bar is drawn from the base value, as opposed to floating.
+func (wb WaterfallBar) IsTotal() bool {
+	return wb.Type == WaterfallBarTypeTotal || wb.Type == WaterfallBarTypeStart
+}
+
+// waterfallLevel is the span of a waterfall bar, from the level it starts at to the level it ends at.
+type waterfallLevel struct {
+	From, To float64
+}
+
+// WaterfallChart is a chart that shows how a starting value changes through positive and negative contributions
+// to a total; it is laid out like a `BarChart`.
+type WaterfallChart struct {
+	Title      string
+	TitleStyle Style
+
+	ColorPalette ColorPalette
+
+	Width  int
+	Height int
+	DPI    float64
+
+	BarWidth int
+
+	Background Style
+	Canvas     Style
+
+	XAxis Style
+	YAxis YAxis
+
+	// XAxisLabelLayout determines how overlapping bar labels are handled, i.e. wrapped, rotated or thinned.
+	XAxisLabelLayout TickLabelLayout
+
+	BarSpacing int
+
+	// BaseValue is the value totals are drawn from and the running total starts at, typically zero.
+	BaseValue float64
+
+	IncreaseStyle  Style
+	DecreaseStyle  Style
+	TotalStyle     Style
+	ConnectorStyle Style
+	// ValueLabelStyle is the style of the labels above the bars; the labels use the value formatter of the y-axis.
+	ValueLabelStyle Style
+
+	Font        *truetype.Font
+	defaultFont *truetype.Font
+
+	Bars     []WaterfallBar
+	Elements []Renderable
+}
+
+// GetDPI returns the dpi for the chart.
+func (wc WaterfallChart) GetDPI() float64 {
+	if wc.DPI == 0 {
+		return DefaultDPI
+	}
+	return wc.DPI
+}
+
+// GetFont returns the text font.
+func (wc WaterfallChart) GetFont() *truetype.Font {
+	if wc.Font == nil {
+		return wc.defaultFont
+	}
+	return wc.Font
+}
+
+// GetWidth returns the chart width or the default value.
+func (wc WaterfallChart) GetWidth() int {
+	if wc.Width == 0 {
+		return DefaultChartWidth
+	}
+	return wc.Width
+}
+
+// GetHeight returns the chart height or the default value.
+func (wc WaterfallChart) GetHeight() int {
+	if wc.Height == 0 {
+		return DefaultChartHeight
+	}
+	return wc.Height
+}
+
+// Render renders the chart with the given renderer to the given io.Writer.
+func (wc WaterfallChart) Render(rp RendererProvider, w io.Writer) error {
+	if len(wc.Bars) == 0 {
+		return errors.New("please provide at least one bar")
+	}
+
+	r, err := rp(wc.GetWidth(), wc.GetHeight())
+	if err != nil {
+		return err
+	}
+
+	if wc.Font == nil {
+		defaultFont, err := GetDefaultFont()
+		if err != nil {
+			return err
+		}
+		wc.defaultFont = defaultFont
+	}
+	r.SetDPI(wc.GetDPI())
+
+	// the layout, the axes and the title are the same as a bar chart with a bar for each waterfall bar.
+	bc := wc.getBarChart()
+	bc.drawBackground(r)
+
+	levels := wc.getLevels()
+	canvasBox := bc.getDefaultCanvasBox()
+	yr := wc.getRanges(levels)
+	if yr.GetMax()-yr.GetMin() == 0 {
+		return fmt.Errorf("invalid data range; cannot be zero")
+	}
+	yr = bc.setRangeDomains(canvasBox, yr)
+	yf := bc.getValueFormatters()
+
+	var yt []Tick
+	if bc.hasAxes() {
+		yt = bc.getAxesTicks(r, yr, yf)
+		canvasBox = bc.getAdjustedCanvasBox(r, canvasBox, yr, yt)
+	}
+	canvasBox = wc.getValueLabelAdjustedCanvasBox(r, canvasBox, levels, yf)
+	yr = bc.setRangeDomains(canvasBox, yr)
+
+	bc.drawCanvas(r, canvasBox)
+	wc.drawBars(r, bc, canvasBox, yr, levels, yf)
+	bc.drawXAxis(r, canvasBox)
+	bc.drawYAxis(r, canvasBox, yr, yt)
+
+	bc.drawTitle(r)
+	for _, a := range wc.Elements {
+		a(r, canvasBox, bc.styleDefaultsElements())
+	}
+
+	return r.Save(w)
+}
+
+// getBarChart returns the bar chart the waterfall chart is laid out as.
+func (wc WaterfallChart) getBarChart() BarChart {
+	bars := make([]Value, len(wc.Bars))
+	for index, bar := range wc.Bars {
+		bars[index] = Value{Label: bar.Label, Value: bar.Value}
+	}
+	return BarChart{
+		Title:            wc.Title,
+		TitleStyle:       wc.TitleStyle,
+		ColorPalette:     wc.ColorPalette,
+		Width:            wc.Width,
+		Height:           wc.Height,
+		DPI:              wc.DPI,
+		BarWidth:         wc.BarWidth,
+		Background:       wc.Background,
+		Canvas:           wc.Canvas,
+		XAxis:            wc.XAxis,
+		YAxis:            wc.YAxis,
+		XAxisLabelLayout: wc.XAxisLabelLayout,
+		BarSpacing:       wc.BarSpacing,
+		Font:             wc.Font,
+		defaultFont:      wc.defaultFont,
+		Bars:             bars,
+	}
+}
+
+// getLevels returns where each bar starts and ends given the running total before it.
+func (wc WaterfallChart) getLevels() []waterfallLevel {
+	levels := make([]waterfallLevel, len(wc.Bars))
+	total := wc.BaseValue
+	for index, bar := range wc.Bars {
+		switch bar.Type {
+		case WaterfallBarTypeStart:
+			total = bar.Value
+			levels[index] = waterfallLevel{From: wc.BaseValue, To: total}
+		case WaterfallBarTypeTotal:
+			levels[index] = waterfallLevel{From: wc.BaseValue, To: total}
+		default:
+			levels[index] = waterfallLevel{From: total, To: total + bar.Value}
+			total += bar.Value
+		}
+	}
+	return levels
+}
+
+func (wc WaterfallChart) getRanges(levels []waterfallLevel) Range {
+	if wc.YAxis.Range != nil && !wc.YAxis.Range.IsZero() {
+		return wc.YAxis.Range
+	}
+
+	yrange := &ContinuousRange{}
+	if len(wc.YAxis.Ticks) > 0 {
+		tickMin, tickMax := math.MaxFloat64, -math.MaxFloat64
+		for _, t := range wc.YAxis.Ticks {
+			tickMin = math.Min(tickMin, t.Value)
+			tickMax = math.Max(tickMax, t.Value)
+		}
+		yrange.SetMin(tickMin)
+		yrange.SetMax(tickMax)
+		return yrange
+	}
+
+	min, max := wc.BaseValue, wc.BaseValue
+	for _, level := range levels {
+		min = math.Min(min, math.Min(level.From, level.To))
+		max = math.Max(max, math.Max(level.From, level.To))
+	}
+	yrange.SetMin(min)
+	yrange.SetMax(max)
+	return yrange
+}
+
+// getValueLabelAdjustedCanvasBox makes room above the canvas for the label of the tallest bar.
+func (wc WaterfallChart) getValueLabelAdjustedCanvasBox(r Renderer, canvasBox Box, levels []waterfallLevel, yf ValueFormatter) Box {
+	style := wc.getValueLabelStyle()
+	if style.Hidden {
+		return canvasBox
+	}
+	var height int
+	for index := range wc.Bars {
+		height = MaxInt(height, Draw.MeasureText(r, wc.getValueLabel(index, levels[index], yf), style).Height())
+	}
+	canvasBox.Top += height + DefaultWaterfallLabelPadding
+	return canvasBox
+}
+
+// getBarBoxes returns the box of each bar on the canvas, spaced as the bars of the bar chart.
+func (wc WaterfallChart) getBarBoxes(bc BarChart, canvasBox Box, yr Range, levels []waterfallLevel) []Box {
+	width, spacing, _ := bc.calculateScaledTotalWidth(canvasBox)
+	bs2 := spacing >> 1
+
+	boxes := make([]Box, len(levels))
+	xoffset := canvasBox.Left
+	for index, level := range levels {
+		from := canvasBox.Bottom - yr.Translate(level.From)
+		to := canvasBox.Bottom - yr.Translate(level.To)
+		boxes[index] = Box{
+			Top:    MinInt(from, to),
+			Left:   xoffset + bs2,
+			Right:  xoffset + bs2 + width,
+			Bottom: MaxInt(from, to),
+		}
+		xoffset += width + spacing
+	}
+	return boxes
+}
+
+func (wc WaterfallChart) drawBars(r Renderer, bc BarChart, canvasBox Box, yr Range, levels []waterfallLevel, yf ValueFormatter) {
+	labelStyle := wc.getValueLabelStyle()
+	connectorStyle := wc.getConnectorStyle()
+
+	boxes := wc.getBarBoxes(bc, canvasBox, yr, levels)
+	for index, bar := range wc.Bars {
+		barBox := boxes[index]
+		Draw.Box(r, barBox, bar.Style.InheritFrom(wc.getBarStyle(index, levels[index])))
+
+		// the connector runs to the next bar at the running total, which is where every bar ends.
+		if index < len(wc.Bars)-1 && !connectorStyle.Hidden {
+			cy := canvasBox.Bottom - yr.Translate(levels[index].To)
+			connectorStyle.GetStrokeOptions().WriteToRenderer(r)
+			r.MoveTo(barBox.Right, cy)
+			r.LineTo(boxes[index+1].Left, cy)
+			r.Stroke()
+			r.ResetStyle()
+		}
+
+		if !labelStyle.Hidden {
+			label := wc.getValueLabel(index, levels[index], yf)
+			tb := Draw.MeasureText(r, label, labelStyle)
+			Draw.Text(r, label, barBox.Left+(barBox.Width()-tb.Width())>>1, barBox.Top-DefaultWaterfallLabelPadding, labelStyle)
+		}
+	}
+}
+
+// getValueLabel returns the label of a bar; changes are shown with their sign, totals as the total.
+func (wc WaterfallChart) getValueLabel(index int, level waterfallLevel, yf ValueFormatter) string {
+	bar := wc.Bars[index]
+	if bar.IsTotal() {
+		return yf(level.To)
+	}
+	if bar.Value > 0 {
+		return "+" + yf(bar.Value)
+	}
+	return yf(bar.Value)
+}
+
+func (wc WaterfallChart) getBarStyle(index int, level waterfallLevel) Style {
+	switch {
+	case wc.Bars[index].IsTotal():
+		return wc.TotalStyle.InheritFrom(wc.styleDefaultsBar(ColorBlue))
+	case level.To < level.From:
+		return wc.DecreaseStyle.InheritFrom(wc.styleDefaultsBar(ColorRed))
+	default:
+		return wc.IncreaseStyle.InheritFrom(wc.styleDefaultsBar(ColorGreen))
+	}
+}
+
+func (wc WaterfallChart) styleDefaultsBar(color drawing.Color) Style {
+	return Style{
+		StrokeColor: color,
+		StrokeWidth: DefaultStrokeWidth,
+		FillColor:   color,
+	}
+}
+
+func (wc WaterfallChart) getConnectorStyle() Style {
+	return wc.ConnectorStyle.InheritFrom(Style{
+		StrokeColor:     wc.GetColorPalette().AxisStrokeColor(),
+		StrokeWidth:     DefaultAxisLineWidth,
+		StrokeDashArray: []float64{3.0, 3.0},
+	})
+}
+
+func (wc WaterfallChart) getValueLabelStyle() Style {
+	return wc.ValueLabelStyle.InheritFrom(Style{
+		FontColor: wc.GetColorPalette().TextColor(),
+		FontSize:  DefaultAxisFontSize,
+		Font:      wc.GetFont(),
+	})
+}
+
+// GetColorPalette returns the color palette for the chart.
+func (wc WaterfallChart) GetColorPalette() ColorPalette {
+	if wc.ColorPalette != nil {
+		return wc.ColorPalette
+	}
+	return AlternateColorPalette
+}
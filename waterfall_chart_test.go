@@ -0,0 +1,110 @@
+package chart
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/testutil"
+)
+
+func TestWaterfallChart(t *testing.T) {
+	wc := WaterfallChart{
+		Title: "Test Title",
+		Bars: []WaterfallBar{
+			{Label: "Start", Value: 100, Type: WaterfallBarTypeStart},
+			{Label: "Up", Value: 50},
+			{Label: "Down", Value: -80},
+			{Label: "Total", Type: WaterfallBarTypeTotal},
+		},
+	}
+	testutil.AssertNil(t, wc.Render(PNG, bytes.NewBuffer([]byte{})))
+	testutil.AssertNil(t, wc.Render(SVG, bytes.NewBuffer([]byte{})))
+
+	canvasBox := Box{Top: 0, Left: 0, Right: 400, Bottom: 150}
+	levels := wc.getLevels()
+	yr := &ContinuousRange{Min: 0, Max: 150, Domain: 150}
+	boxes := wc.getBarBoxes(wc.getBarChart(), canvasBox, yr, levels)
+	testutil.AssertLen(t, boxes, 4)
+
+	// each bar starts where the one before it ends, and the totals stand on the base value.
+	testutil.AssertEqual(t, 50, boxes[0].Top)
+	testutil.AssertEqual(t, 150, boxes[0].Bottom)
+	testutil.AssertEqual(t, 0, boxes[1].Top)
+	testutil.AssertEqual(t, 50, boxes[1].Bottom)
+	testutil.AssertEqual(t, 0, boxes[2].Top)
+	testutil.AssertEqual(t, 80, boxes[2].Bottom)
+	testutil.AssertEqual(t, 80, boxes[3].Top)
+	testutil.AssertEqual(t, 150, boxes[3].Bottom)
+	for index := 1; index < len(boxes); index++ {
+		testutil.AssertEqual(t, boxes[0].Width(), boxes[index].Width())
+		testutil.AssertTrue(t, boxes[index].Left > boxes[index-1].Right)
+	}
+}
+
+func TestWaterfallChartNoBars(t *testing.T) {
+	wc := WaterfallChart{}
+	err := wc.Render(PNG, bytes.NewBuffer([]byte{}))
+	testutil.AssertNotNil(t, err)
+}
+
+func TestWaterfallChartGetLevels(t *testing.T) {
+	wc := WaterfallChart{
+		Bars: []WaterfallBar{
+			{Label: "Start", Value: 100, Type: WaterfallBarTypeStart},
+			{Label: "Up", Value: 50},
+			{Label: "Down", Value: -80},
+			{Label: "Subtotal", Type: WaterfallBarTypeTotal},
+			{Label: "Down", Value: -30},
+			{Label: "Total", Type: WaterfallBarTypeTotal},
+		},
+	}
+	levels := wc.getLevels()
+
+	testutil.AssertLen(t, levels, 6)
+	testutil.AssertEqual(t, waterfallLevel{From: 0, To: 100}, levels[0])
+	testutil.AssertEqual(t, waterfallLevel{From: 100, To: 150}, levels[1])
+	testutil.AssertEqual(t, waterfallLevel{From: 150, To: 70}, levels[2])
+	testutil.AssertEqual(t, waterfallLevel{From: 0, To: 70}, levels[3])
+	testutil.AssertEqual(t, waterfallLevel{From: 70, To: 40}, levels[4])
+	testutil.AssertEqual(t, waterfallLevel{From: 0, To: 40}, levels[5])
+
+	yr := wc.getRanges(levels)
+	testutil.AssertEqual(t, 0.0, yr.GetMin())
+	testutil.AssertEqual(t, 150.0, yr.GetMax())
+}
+
+func TestWaterfallChartGetValueLabel(t *testing.T) {
+	wc := WaterfallChart{
+		Bars: []WaterfallBar{
+			{Label: "Start", Value: 100, Type: WaterfallBarTypeStart},
+			{Label: "Up", Value: 50},
+			{Label: "Down", Value: -80},
+			{Label: "Subtotal", Type: WaterfallBarTypeTotal},
+		},
+	}
+	levels := wc.getLevels()
+
+	testutil.AssertEqual(t, "100", wc.getValueLabel(0, levels[0], IntValueFormatter))
+	testutil.AssertEqual(t, "+50", wc.getValueLabel(1, levels[1], IntValueFormatter))
+	testutil.AssertEqual(t, "-80", wc.getValueLabel(2, levels[2], IntValueFormatter))
+	testutil.AssertEqual(t, "70", wc.getValueLabel(3, levels[3], IntValueFormatter))
+}
+
+func TestWaterfallChartGetBarStyle(t *testing.T) {
+	wc := WaterfallChart{
+		Bars: []WaterfallBar{
+			{Label: "Start", Value: 100, Type: WaterfallBarTypeStart},
+			{Label: "Up", Value: 50},
+			{Label: "Down", Value: -80},
+			{Label: "Subtotal", Type: WaterfallBarTypeTotal},
+		},
+	}
+	levels := wc.getLevels()
+
+	testutil.AssertEqual(t, ColorBlue, wc.getBarStyle(0, levels[0]).FillColor)
+	testutil.AssertEqual(t, ColorGreen, wc.getBarStyle(1, levels[1]).FillColor)
+	testutil.AssertEqual(t, ColorRed, wc.getBarStyle(2, levels[2]).FillColor)
+
+	wc.DecreaseStyle = Style{FillColor: ColorOrange}
+	testutil.AssertEqual(t, ColorOrange, wc.getBarStyle(2, levels[2]).FillColor)
+}
@@ -23,6 +23,8 @@ type XAxis struct {
 	TickStyle    Style
 	Ticks        []Tick
 	TickPosition TickPosition
+	// TickLabelLayout determines how overlapping tick labels are handled, i.e. rotated, thinned or wrapped.
+	TickLabelLayout TickLabelLayout
 
 	GridLines      []GridLine
 	GridMajorStyle Style
@@ -74,6 +76,33 @@ func (xa XAxis) GetTicks(r Renderer, ra Range, defaults Style, vf ValueFormatter
 	return GenerateContinuousTicks(r, ra, false, tickStyle, vf)
 }
 
+// getTickLabels returns the tick labels positioned according to the tick position.
+// Labels between ticks are placed in the slot to the left of their tick, so the first tick's label is skipped.
+func (xa XAxis) getTickLabels(canvasBox Box, ra Range, ticks []Tick) []tickLabel {
+	tp := xa.GetTickPosition()
+
+	var labels []tickLabel
+	var tx int
+	for index, t := range ticks {
+		tx = canvasBox.Left + ra.Translate(t.Value)
+		switch tp {
+		case TickPositionUnderTick, TickPositionUnset:
+			if len(t.Label) > 0 {
+				labels = append(labels, tickLabel{Label: t.Label, Left: tx, Right: tx})
+			}
+		case TickPositionBetweenTicks:
+			if index > 0 && len(t.Label) > 0 {
+				labels = append(labels, tickLabel{
+					Label: t.Label,
+					Left:  canvasBox.Left + ra.Translate(ticks[index-1].Value),
+					Right: tx,
+				})
+			}
+		}
+	}
+	return labels
+}
+
 // GetGridLines returns the gridlines for the axis.
 func (xa XAxis) GetGridLines(ticks []Tick) []GridLine {
 	if len(xa.GridLines) > 0 {
@@ -86,33 +115,24 @@ func (xa XAxis) GetGridLines(ticks []Tick) []GridLine {
 func (xa XAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, ticks []Tick) Box {
 	tickStyle := xa.TickStyle.InheritFrom(xa.Style.InheritFrom(defaults))
 
-	tp := xa.GetTickPosition()
-
-	var ltx, rtx int
-	var tx, ty int
-	var left, right, bottom = math.MaxInt32, 0, 0
-	for index, t := range ticks {
-		v := t.Value
-		tb := Draw.MeasureText(r, t.Label, tickStyle.GetTextOptions())
+	labels := xa.getTickLabels(canvasBox, ra, ticks)
+	plan := layoutTickLabels(r, labels, tickStyle, xa.TickLabelLayout)
 
-		tx = canvasBox.Left + ra.Translate(v)
-		ty = canvasBox.Bottom + DefaultXAxisMargin + tb.Height()
-		switch tp {
-		case TickPositionUnderTick, TickPositionUnset:
-			ltx = tx - tb.Width()>>1
-			rtx = tx + tb.Width()>>1
-			break
-		case TickPositionBetweenTicks:
-			if index > 0 {
-				ltx = ra.Translate(ticks[index-1].Value)
-				rtx = tx
-			}
-			break
+	var tx int
+	var left, right, bottom = math.MaxInt32, 0, canvasBox.Bottom + DefaultXAxisMargin
+	for _, t := range ticks {
+		tx = canvasBox.Left + ra.Translate(t.Value)
+		left = MinInt(left, tx)
+		right = MaxInt(right, tx)
+	}
+	for index, tl := range labels {
+		if !plan.ShouldDraw(index) {
+			continue
 		}
-
-		left = MinInt(left, ltx)
-		right = MaxInt(right, rtx)
-		bottom = MaxInt(bottom, ty)
+		tb := plan.Measure(r, tl, canvasBox.Bottom+DefaultXAxisMargin, tickStyle)
+		left = MinInt(left, tb.Left)
+		right = MaxInt(right, tb.Right)
+		bottom = MaxInt(bottom, tb.Bottom)
 	}
 
 	if !xa.NameStyle.Hidden && len(xa.Name) > 0 {
@@ -137,53 +157,26 @@ func (xa XAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 	r.LineTo(canvasBox.Right, canvasBox.Bottom)
 	r.Stroke()
 
-	tp := xa.GetTickPosition()
-
-	var tx, ty int
-	var maxTextHeight int
-	for index, t := range ticks {
-		v := t.Value
-		lx := ra.Translate(v)
-
-		tx = canvasBox.Left + lx
+	var tx int
+	for _, t := range ticks {
+		tx = canvasBox.Left + ra.Translate(t.Value)
 
 		tickStyle.GetStrokeOptions().WriteToRenderer(r)
 		r.MoveTo(tx, canvasBox.Bottom)
 		r.LineTo(tx, canvasBox.Bottom+DefaultVerticalTickHeight)
 		r.Stroke()
+	}
 
-		tickWithAxisStyle := xa.TickStyle.InheritFrom(xa.Style.InheritFrom(defaults))
-		tb := Draw.MeasureText(r, t.Label, tickWithAxisStyle)
+	labels := xa.getTickLabels(canvasBox, ra, ticks)
+	plan := layoutTickLabels(r, labels, tickStyle, xa.TickLabelLayout)
 
-		switch tp {
-		case TickPositionUnderTick, TickPositionUnset:
-			if tickStyle.TextRotationDegrees == 0 {
-				tx = tx - tb.Width()>>1
-				ty = canvasBox.Bottom + DefaultXAxisMargin + tb.Height()
-			} else {
-				ty = canvasBox.Bottom + (2 * DefaultXAxisMargin)
-			}
-			Draw.Text(r, t.Label, tx, ty, tickWithAxisStyle)
-			maxTextHeight = MaxInt(maxTextHeight, tb.Height())
-			break
-		case TickPositionBetweenTicks:
-			if index > 0 {
-				llx := ra.Translate(ticks[index-1].Value)
-				ltx := canvasBox.Left + llx
-				finalTickStyle := tickWithAxisStyle.InheritFrom(Style{TextHorizontalAlign: TextHorizontalAlignCenter})
-
-				Draw.TextWithin(r, t.Label, Box{
-					Left:   ltx,
-					Right:  tx,
-					Top:    canvasBox.Bottom + DefaultXAxisMargin,
-					Bottom: canvasBox.Bottom + DefaultXAxisMargin,
-				}, finalTickStyle)
-
-				ftb := Text.MeasureLines(r, Text.WrapFit(r, t.Label, tx-ltx, finalTickStyle), finalTickStyle)
-				maxTextHeight = MaxInt(maxTextHeight, ftb.Height())
-			}
-			break
+	var maxTextHeight int
+	for index, tl := range labels {
+		if !plan.ShouldDraw(index) {
+			continue
 		}
+		tb := plan.Render(r, tl, canvasBox.Bottom+DefaultXAxisMargin, tickStyle)
+		maxTextHeight = MaxInt(maxTextHeight, tb.Height())
 	}
 
 	nameStyle := xa.NameStyle.InheritFrom(defaults)
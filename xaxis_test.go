@@ -65,3 +65,26 @@ func TestXAxisMeasure(t *testing.T) {
 	testutil.AssertEqual(t, 122, xab.Width())
 	testutil.AssertEqual(t, 21, xab.Height())
 }
+
+func TestXAxisMeasureTickLabelLayout(t *testing.T) {
+	r, err := PNG(1024, 1024)
+	testutil.AssertNil(t, err)
+
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+
+	var ticks []Tick
+	for index := 0; index < 20; index++ {
+		ticks = append(ticks, Tick{Value: float64(index), Label: "a long tick label"})
+	}
+	xr := &ContinuousRange{Min: 0, Max: 19, Domain: 400}
+	canvasBox := Box{Top: 0, Left: 0, Right: 400, Bottom: 400}
+	styleDefaults := Style{Font: f, FontSize: 10.0}
+
+	unrotated := XAxis{TickLabelLayout: TickLabelLayoutNone}.Measure(r, canvasBox, xr, styleDefaults, ticks)
+	rotated := XAxis{}.Measure(r, canvasBox, xr, styleDefaults, ticks)
+	testutil.AssertTrue(t, rotated.Height() > unrotated.Height())
+
+	thinned := XAxis{TickLabelLayout: TickLabelLayoutThin}.Measure(r, canvasBox, xr, styleDefaults, ticks)
+	testutil.AssertEqual(t, unrotated.Height(), thinned.Height())
+}
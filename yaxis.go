@@ -12,7 +12,8 @@ func HideYAxis() YAxis {
 }
 
 // YAxis is a veritcal rule of the range.
-// There can be (2) y-axes; a primary and secondary.
+// There can be (2) y-axes; a primary and secondary, plus any number of
+// additional y-axes set on the chart.
 type YAxis struct {
 	Name      string
 	NameStyle Style
@@ -22,6 +23,7 @@ type YAxis struct {
 	Zero GridLine
 
 	AxisType  YAxisType
+	Side      YAxisSide
 	Ascending bool
 
 	ValueFormatter ValueFormatter
@@ -33,6 +35,10 @@ type YAxis struct {
 	GridLines      []GridLine
 	GridMajorStyle Style
 	GridMinorStyle Style
+
+	// offset is the distance from the canvas the axis is pushed out by
+	// when it shares a side with other axes.
+	offset int
 }
 
 // GetName returns the name.
@@ -50,6 +56,17 @@ func (ya YAxis) GetStyle() Style {
 	return ya.Style
 }
 
+// GetSide returns the side of the canvas the axis is drawn on.
+func (ya YAxis) GetSide() YAxisSide {
+	if ya.Side == YAxisSideUnset {
+		if ya.AxisType == YAxisSecondary {
+			return YAxisSideLeft
+		}
+		return YAxisSideRight
+	}
+	return ya.Side
+}
+
 // GetValueFormatter returns the value formatter for the axis.
 func (ya YAxis) GetValueFormatter() ValueFormatter {
 	if ya.ValueFormatter != nil {
@@ -89,11 +106,13 @@ func (ya YAxis) GetGridLines(ticks []Tick) []GridLine {
 
 // Measure returns the bounds of the axis.
 func (ya YAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, ticks []Tick) Box {
+	side := ya.GetSide()
+
 	var tx int
-	if ya.AxisType == YAxisPrimary {
-		tx = canvasBox.Right + DefaultYAxisMargin
-	} else if ya.AxisType == YAxisSecondary {
-		tx = canvasBox.Left - DefaultYAxisMargin
+	if side == YAxisSideRight {
+		tx = canvasBox.Right + ya.offset + DefaultYAxisMargin
+	} else {
+		tx = canvasBox.Left - (ya.offset + DefaultYAxisMargin)
 	}
 
 	ya.TickStyle.InheritFrom(ya.Style.InheritFrom(defaults)).WriteToRenderer(r)
@@ -106,16 +125,16 @@ func (ya YAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 		tb := r.MeasureText(t.Label)
 		tbh2 := tb.Height() >> 1
 		finalTextX := tx
-		if ya.AxisType == YAxisSecondary {
+		if side == YAxisSideLeft {
 			finalTextX = tx - tb.Width()
 		}
 
 		maxTextHeight = MaxInt(tb.Height(), maxTextHeight)
 
-		if ya.AxisType == YAxisPrimary {
+		if side == YAxisSideRight {
 			minx = canvasBox.Right
 			maxx = MaxInt(maxx, tx+tb.Width())
-		} else if ya.AxisType == YAxisSecondary {
+		} else {
 			minx = MinInt(minx, finalTextX)
 			maxx = MaxInt(maxx, tx)
 		}
@@ -125,7 +144,11 @@ func (ya YAxis) Measure(r Renderer, canvasBox Box, ra Range, defaults Style, tic
 	}
 
 	if !ya.NameStyle.Hidden && len(ya.Name) > 0 {
-		maxx += (DefaultYAxisMargin + maxTextHeight)
+		if side == YAxisSideRight {
+			maxx += (DefaultYAxisMargin + maxTextHeight)
+		} else {
+			minx -= (DefaultYAxisMargin + maxTextHeight)
+		}
 	}
 
 	return Box{
@@ -143,13 +166,15 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 
 	sw := tickStyle.GetStrokeWidth(defaults.StrokeWidth)
 
+	side := ya.GetSide()
+
 	var lx int
 	var tx int
-	if ya.AxisType == YAxisPrimary {
-		lx = canvasBox.Right + int(sw)
+	if side == YAxisSideRight {
+		lx = canvasBox.Right + ya.offset + int(sw)
 		tx = lx + DefaultYAxisMargin
-	} else if ya.AxisType == YAxisSecondary {
-		lx = canvasBox.Left - int(sw)
+	} else {
+		lx = canvasBox.Left - (ya.offset + int(sw))
 		tx = lx - DefaultYAxisMargin
 	}
 
@@ -169,7 +194,7 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 			maxTextWidth = tb.Width()
 		}
 
-		if ya.AxisType == YAxisSecondary {
+		if side == YAxisSideLeft {
 			finalTextX = tx - tb.Width()
 		} else {
 			finalTextX = tx
@@ -184,9 +209,9 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 		tickStyle.WriteToRenderer(r)
 
 		r.MoveTo(lx, ly)
-		if ya.AxisType == YAxisPrimary {
+		if side == YAxisSideRight {
 			r.LineTo(lx+DefaultHorizontalTickWidth, ly)
-		} else if ya.AxisType == YAxisSecondary {
+		} else {
 			r.LineTo(lx-DefaultHorizontalTickWidth, ly)
 		}
 		r.Stroke()
@@ -200,10 +225,10 @@ func (ya YAxis) Render(r Renderer, canvasBox Box, ra Range, defaults Style, tick
 		tb := Draw.MeasureText(r, ya.Name, nameStyle)
 
 		var tx int
-		if ya.AxisType == YAxisPrimary {
-			tx = canvasBox.Right + int(sw) + DefaultYAxisMargin + maxTextWidth + DefaultYAxisMargin
-		} else if ya.AxisType == YAxisSecondary {
-			tx = canvasBox.Left - (DefaultYAxisMargin + int(sw) + maxTextWidth + DefaultYAxisMargin)
+		if side == YAxisSideRight {
+			tx = canvasBox.Right + ya.offset + int(sw) + DefaultYAxisMargin + maxTextWidth + DefaultYAxisMargin
+		} else {
+			tx = canvasBox.Left - (ya.offset + DefaultYAxisMargin + int(sw) + maxTextWidth + DefaultYAxisMargin)
 		}
 
 		var ty int
@@ -83,3 +83,31 @@ func TestYAxisSecondaryMeasure(t *testing.T) {
 	testutil.AssertEqual(t, 32, yab.Width())
 	testutil.AssertEqual(t, 110, yab.Height())
 }
+
+func TestYAxisGetSide(t *testing.T) {
+	testutil.AssertEqual(t, YAxisSideRight, YAxis{}.GetSide())
+	testutil.AssertEqual(t, YAxisSideLeft, YAxis{AxisType: YAxisSecondary}.GetSide())
+	testutil.AssertEqual(t, YAxisSideRight, YAxis{AxisType: YAxisAdditional(0)}.GetSide())
+	testutil.AssertEqual(t, YAxisSideLeft, YAxis{AxisType: YAxisAdditional(0), Side: YAxisSideLeft}.GetSide())
+	testutil.AssertEqual(t, YAxisSideRight, YAxis{AxisType: YAxisSecondary, Side: YAxisSideRight}.GetSide())
+}
+
+func TestYAxisMeasureOffset(t *testing.T) {
+	f, err := GetDefaultFont()
+	testutil.AssertNil(t, err)
+	style := Style{
+		Font:     f,
+		FontSize: 10.0,
+	}
+	r, err := PNG(100, 100)
+	testutil.AssertNil(t, err)
+	ticks := []Tick{{Value: 1.0, Label: "1.0"}, {Value: 2.0, Label: "2.0"}, {Value: 3.0, Label: "3.0"}}
+	ra := &ContinuousRange{Min: 1.0, Max: 3.0, Domain: 100}
+
+	ya := YAxis{}
+	yab := ya.Measure(r, NewBox(0, 0, 100, 100), ra, style, ticks)
+
+	ya.offset = 20
+	yabOffset := ya.Measure(r, NewBox(0, 0, 100, 100), ra, style, ticks)
+	testutil.AssertEqual(t, yab.Right+20, yabOffset.Right)
+}